@@ -1,31 +1,108 @@
 package wmutil
 
 import (
-	"log"
+	"errors"
+	"log/slog"
+	"os"
 
 	"github.com/BurntSushi/xgb/xproto"
 )
 
+// icccm and ewmh atoms used by the library, interned at New
+var atomNames = []string{
+	"UTF8_STRING",
+	"WM_DELETE_WINDOW",
+	"WM_PROTOCOLS",
+	"WM_STATE",
+	"WM_TAKE_FOCUS",
+	"WM_WINDOW_ROLE",
+	"_NET_SUPPORTED",
+	"_NET_WM_ICON_NAME",
+	"_NET_WM_NAME",
+	"_NET_WM_STATE",
+	"_NET_WM_STATE_FULLSCREEN",
+	"_NET_WM_STRUT",
+	"_NET_WM_STRUT_PARTIAL",
+	"_NET_WM_WINDOW_OPACITY",
+	"_NET_WM_WINDOW_TYPE",
+	// for matching rules by window type
+	"_NET_WM_WINDOW_TYPE_DESKTOP",
+	"_NET_WM_WINDOW_TYPE_DOCK",
+	"_NET_WM_WINDOW_TYPE_TOOLBAR",
+	"_NET_WM_WINDOW_TYPE_MENU",
+	"_NET_WM_WINDOW_TYPE_UTILITY",
+	"_NET_WM_WINDOW_TYPE_SPLASH",
+	"_NET_WM_WINDOW_TYPE_DIALOG",
+	"_NET_WM_WINDOW_TYPE_DROPDOWN_MENU",
+	"_NET_WM_WINDOW_TYPE_POPUP_MENU",
+	"_NET_WM_WINDOW_TYPE_TOOLTIP",
+	"_NET_WM_WINDOW_TYPE_NOTIFICATION",
+	"_NET_WM_WINDOW_TYPE_COMBO",
+	"_NET_WM_WINDOW_TYPE_DND",
+	"_NET_WM_WINDOW_TYPE_NORMAL",
+}
+
+// InternAtoms interns names not cached yet in one round trip, later Atom calls of the names do not block
+func (w *Wm) InternAtoms(names ...string) error {
+	var pending []string
+	w.atomsLock.Lock()
+	for _, name := range names {
+		if _, ok := w.stringToAtom[name]; !ok {
+			pending = append(pending, name)
+		}
+	}
+	w.atomsLock.Unlock()
+	cookies := make([]Cookie[*xproto.InternAtomReply], 0, len(pending))
+	for _, name := range pending {
+		cookies = append(cookies, w.Backend.InternAtom(false, name))
+	}
+	var errs []error
+	for i, cookie := range cookies {
+		reply, err := cookie.Reply()
+		if err != nil {
+			errs = append(errs, ef("intern atom %s: %w", pending[i], err))
+			continue
+		}
+		w.cacheAtom(pending[i], reply.Atom)
+	}
+	return errors.Join(errs...)
+}
+
 func (w *Wm) Atom(name string) xproto.Atom {
-	if atom, ok := w.stringToAtom[name]; ok {
+	w.atomsLock.Lock()
+	atom, ok := w.stringToAtom[name]
+	w.atomsLock.Unlock()
+	if ok {
 		return atom
 	}
-	reply, err := xproto.InternAtom(w.Conn, false, uint16(len(name)), name).Reply()
+	reply, err := w.Backend.InternAtom(false, name).Reply()
 	if err != nil {
-		log.Fatalf("atom intern error %v", err)
+		w.logger.Error("intern atom", atomAttr(name), requestAttr("InternAtom"), errAttr(err))
+		os.Exit(1)
 	}
-	w.stringToAtom[name] = reply.Atom
+	w.cacheAtom(name, reply.Atom)
 	return reply.Atom
 }
 
 func (w *Wm) AtomName(atom xproto.Atom) string {
-	if name, ok := w.atomToString[atom]; ok {
+	w.atomsLock.Lock()
+	name, ok := w.atomToString[atom]
+	w.atomsLock.Unlock()
+	if ok {
 		return name
 	}
-	reply, err := xproto.GetAtomName(w.Conn, atom).Reply()
+	reply, err := w.Backend.GetAtomName(atom).Reply()
 	if err != nil {
-		log.Fatalf("get atom name error %v", err)
+		w.logger.Error("get atom name", slog.Any("atom", atom), requestAttr("GetAtomName"), errAttr(err))
+		os.Exit(1)
 	}
-	w.atomToString[atom] = reply.Name
+	w.cacheAtom(reply.Name, atom)
 	return reply.Name
 }
+
+func (w *Wm) cacheAtom(name string, atom xproto.Atom) {
+	w.atomsLock.Lock()
+	defer w.atomsLock.Unlock()
+	w.stringToAtom[name] = atom
+	w.atomToString[atom] = name
+}
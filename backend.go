@@ -0,0 +1,199 @@
+package wmutil
+
+import (
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xinerama"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Backend is the X connection used by Wm. methods mirror xproto requests without the connection argument.
+// requests are sent when called, errors and replies are read from the returned cookies, so requests can be pipelined
+type Backend interface {
+	Setup() *xproto.SetupInfo
+	DefaultScreen() *xproto.ScreenInfo
+	WaitForEvent() (xgb.Event, xgb.Error)
+	PollForEvent() (xgb.Event, xgb.Error)
+	Close()
+
+	ChangeWindowAttributes(window xproto.Window, valueMask uint32, valueList []uint32) VoidCookie
+	ConfigureWindow(window xproto.Window, valueMask uint16, valueList []uint32) VoidCookie
+	MapWindow(window xproto.Window) VoidCookie
+	DestroyWindow(window xproto.Window) VoidCookie
+	SendEvent(propagate bool, destination xproto.Window, eventMask uint32, event string) VoidCookie
+	ChangeProperty(mode byte, window xproto.Window, property, typ xproto.Atom, format byte, dataLen uint32, data []byte) VoidCookie
+	GetProperty(delete bool, window xproto.Window, property, typ xproto.Atom, longOffset, longLength uint32) Cookie[*xproto.GetPropertyReply]
+	InternAtom(onlyIfExists bool, name string) Cookie[*xproto.InternAtomReply]
+	GetAtomName(atom xproto.Atom) Cookie[*xproto.GetAtomNameReply]
+	GetInputFocus() Cookie[*xproto.GetInputFocusReply]
+	SetInputFocus(revertTo byte, focus xproto.Window, time xproto.Timestamp) VoidCookie
+	QueryTree(window xproto.Window) Cookie[*xproto.QueryTreeReply]
+	QueryPointer(window xproto.Window) Cookie[*xproto.QueryPointerReply]
+	WarpPointer(srcWindow, dstWindow xproto.Window, srcX, srcY int16, srcWidth, srcHeight uint16, dstX, dstY int16) VoidCookie
+	GetKeyboardMapping(firstKeycode xproto.Keycode, count byte) Cookie[*xproto.GetKeyboardMappingReply]
+	GetModifierMapping() Cookie[*xproto.GetModifierMappingReply]
+	GrabKey(ownerEvents bool, grabWindow xproto.Window, modifiers uint16, key xproto.Keycode, pointerMode, keyboardMode byte) VoidCookie
+	UngrabKey(key xproto.Keycode, grabWindow xproto.Window, modifiers uint16) VoidCookie
+	GrabKeyboard(ownerEvents bool, grabWindow xproto.Window, time xproto.Timestamp, pointerMode, keyboardMode byte) Cookie[*xproto.GrabKeyboardReply]
+	UngrabKeyboard(time xproto.Timestamp) VoidCookie
+	AllowEvents(mode byte, time xproto.Timestamp) VoidCookie
+	GrabButton(ownerEvents bool, grabWindow xproto.Window, eventMask uint16, pointerMode, keyboardMode byte, confineTo xproto.Window, cursor xproto.Cursor, button byte, modifiers uint16) VoidCookie
+	UngrabButton(button byte, grabWindow xproto.Window, modifiers uint16) VoidCookie
+	// QueryScreens returns an error if xinerama is not available
+	QueryScreens() Cookie[*xinerama.QueryScreensReply]
+}
+
+type VoidCookie interface {
+	Check() error
+}
+
+type Cookie[T any] interface {
+	Reply() (T, error)
+}
+
+// Result is a resolved cookie
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+func (r Result[T]) Reply() (T, error) {
+	return r.Value, r.Err
+}
+
+func (r Result[T]) Check() error {
+	return r.Err
+}
+
+// XgbBackend is the default Backend
+type XgbBackend struct {
+	Conn        *xgb.Conn
+	hasXinerama bool
+}
+
+var _ Backend = new(XgbBackend)
+
+func NewXgbBackend(conn *xgb.Conn) *XgbBackend {
+	return &XgbBackend{
+		Conn:        conn,
+		hasXinerama: xinerama.Init(conn) == nil,
+	}
+}
+
+func (b *XgbBackend) Setup() *xproto.SetupInfo {
+	return xproto.Setup(b.Conn)
+}
+
+func (b *XgbBackend) DefaultScreen() *xproto.ScreenInfo {
+	return xproto.Setup(b.Conn).DefaultScreen(b.Conn)
+}
+
+func (b *XgbBackend) WaitForEvent() (xgb.Event, xgb.Error) {
+	return b.Conn.WaitForEvent()
+}
+
+func (b *XgbBackend) PollForEvent() (xgb.Event, xgb.Error) {
+	return b.Conn.PollForEvent()
+}
+
+func (b *XgbBackend) Close() {
+	b.Conn.Close()
+}
+
+func (b *XgbBackend) ChangeWindowAttributes(window xproto.Window, valueMask uint32, valueList []uint32) VoidCookie {
+	return xproto.ChangeWindowAttributesChecked(b.Conn, window, valueMask, valueList)
+}
+
+func (b *XgbBackend) ConfigureWindow(window xproto.Window, valueMask uint16, valueList []uint32) VoidCookie {
+	return xproto.ConfigureWindowChecked(b.Conn, window, valueMask, valueList)
+}
+
+func (b *XgbBackend) MapWindow(window xproto.Window) VoidCookie {
+	return xproto.MapWindowChecked(b.Conn, window)
+}
+
+func (b *XgbBackend) DestroyWindow(window xproto.Window) VoidCookie {
+	return xproto.DestroyWindowChecked(b.Conn, window)
+}
+
+func (b *XgbBackend) SendEvent(propagate bool, destination xproto.Window, eventMask uint32, event string) VoidCookie {
+	return xproto.SendEventChecked(b.Conn, propagate, destination, eventMask, event)
+}
+
+func (b *XgbBackend) ChangeProperty(mode byte, window xproto.Window, property, typ xproto.Atom, format byte, dataLen uint32, data []byte) VoidCookie {
+	return xproto.ChangePropertyChecked(b.Conn, mode, window, property, typ, format, dataLen, data)
+}
+
+func (b *XgbBackend) GetProperty(delete bool, window xproto.Window, property, typ xproto.Atom, longOffset, longLength uint32) Cookie[*xproto.GetPropertyReply] {
+	return xproto.GetProperty(b.Conn, delete, window, property, typ, longOffset, longLength)
+}
+
+func (b *XgbBackend) InternAtom(onlyIfExists bool, name string) Cookie[*xproto.InternAtomReply] {
+	return xproto.InternAtom(b.Conn, onlyIfExists, uint16(len(name)), name)
+}
+
+func (b *XgbBackend) GetAtomName(atom xproto.Atom) Cookie[*xproto.GetAtomNameReply] {
+	return xproto.GetAtomName(b.Conn, atom)
+}
+
+func (b *XgbBackend) GetInputFocus() Cookie[*xproto.GetInputFocusReply] {
+	return xproto.GetInputFocus(b.Conn)
+}
+
+func (b *XgbBackend) SetInputFocus(revertTo byte, focus xproto.Window, time xproto.Timestamp) VoidCookie {
+	return xproto.SetInputFocusChecked(b.Conn, revertTo, focus, time)
+}
+
+func (b *XgbBackend) QueryTree(window xproto.Window) Cookie[*xproto.QueryTreeReply] {
+	return xproto.QueryTree(b.Conn, window)
+}
+
+func (b *XgbBackend) QueryPointer(window xproto.Window) Cookie[*xproto.QueryPointerReply] {
+	return xproto.QueryPointer(b.Conn, window)
+}
+
+func (b *XgbBackend) WarpPointer(srcWindow, dstWindow xproto.Window, srcX, srcY int16, srcWidth, srcHeight uint16, dstX, dstY int16) VoidCookie {
+	return xproto.WarpPointerChecked(b.Conn, srcWindow, dstWindow, srcX, srcY, srcWidth, srcHeight, dstX, dstY)
+}
+
+func (b *XgbBackend) GetKeyboardMapping(firstKeycode xproto.Keycode, count byte) Cookie[*xproto.GetKeyboardMappingReply] {
+	return xproto.GetKeyboardMapping(b.Conn, firstKeycode, count)
+}
+
+func (b *XgbBackend) GetModifierMapping() Cookie[*xproto.GetModifierMappingReply] {
+	return xproto.GetModifierMapping(b.Conn)
+}
+
+func (b *XgbBackend) GrabKey(ownerEvents bool, grabWindow xproto.Window, modifiers uint16, key xproto.Keycode, pointerMode, keyboardMode byte) VoidCookie {
+	return xproto.GrabKeyChecked(b.Conn, ownerEvents, grabWindow, modifiers, key, pointerMode, keyboardMode)
+}
+
+func (b *XgbBackend) UngrabKey(key xproto.Keycode, grabWindow xproto.Window, modifiers uint16) VoidCookie {
+	return xproto.UngrabKeyChecked(b.Conn, key, grabWindow, modifiers)
+}
+
+func (b *XgbBackend) GrabKeyboard(ownerEvents bool, grabWindow xproto.Window, time xproto.Timestamp, pointerMode, keyboardMode byte) Cookie[*xproto.GrabKeyboardReply] {
+	return xproto.GrabKeyboard(b.Conn, ownerEvents, grabWindow, time, pointerMode, keyboardMode)
+}
+
+func (b *XgbBackend) UngrabKeyboard(time xproto.Timestamp) VoidCookie {
+	return xproto.UngrabKeyboardChecked(b.Conn, time)
+}
+
+func (b *XgbBackend) AllowEvents(mode byte, time xproto.Timestamp) VoidCookie {
+	return xproto.AllowEventsChecked(b.Conn, mode, time)
+}
+
+func (b *XgbBackend) GrabButton(ownerEvents bool, grabWindow xproto.Window, eventMask uint16, pointerMode, keyboardMode byte, confineTo xproto.Window, cursor xproto.Cursor, button byte, modifiers uint16) VoidCookie {
+	return xproto.GrabButtonChecked(b.Conn, ownerEvents, grabWindow, eventMask, pointerMode, keyboardMode, confineTo, cursor, button, modifiers)
+}
+
+func (b *XgbBackend) UngrabButton(button byte, grabWindow xproto.Window, modifiers uint16) VoidCookie {
+	return xproto.UngrabButtonChecked(b.Conn, button, grabWindow, modifiers)
+}
+
+func (b *XgbBackend) QueryScreens() Cookie[*xinerama.QueryScreensReply] {
+	if !b.hasXinerama {
+		return Result[*xinerama.QueryScreensReply]{Err: ef("xinerama not available")}
+	}
+	return xinerama.QueryScreens(b.Conn)
+}
@@ -0,0 +1,123 @@
+package wmutil
+
+import (
+	"errors"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Batch sends requests without waiting for each, then checks them all in one round trip with Wait.
+// window fields are updated for successful requests. the zero value is ready to use
+type Batch struct {
+	pending []pendingRequest
+}
+
+type pendingRequest struct {
+	win     *Window
+	request string
+	msg     string
+	cookie  VoidCookie
+	done    func()
+}
+
+// Batch runs fn and waits for the requests it added
+func (w *Wm) Batch(fn func(b *Batch)) error {
+	b := new(Batch)
+	fn(b)
+	return b.Wait()
+}
+
+func (b *Batch) add(win *Window, request, msg string, cookie VoidCookie, done func()) {
+	b.pending = append(b.pending, pendingRequest{
+		win:     win,
+		request: request,
+		msg:     msg,
+		cookie:  cookie,
+		done:    done,
+	})
+}
+
+// Wait checks all requests added, failures are logged and returned joined
+func (b *Batch) Wait() error {
+	var errs []error
+	for _, p := range b.pending {
+		err := p.cookie.Check()
+		if err != nil {
+			p.win.wm.logger.Error(p.msg, windowAttr(p.win.Id), requestAttr(p.request), errAttr(err))
+			errs = append(errs, ef("%s 0x%x: %w", p.request, p.win.Id, err))
+			continue
+		}
+		if p.done != nil {
+			p.done()
+		}
+	}
+	b.pending = nil
+	return errors.Join(errs...)
+}
+
+func (b *Batch) configure(win *Window, msg string, mask uint16, values []uint32, done func()) {
+	b.add(win, "ConfigureWindow", msg, win.wm.Backend.ConfigureWindow(win.Id, mask, values), done)
+}
+
+func (b *Batch) SetPos(win *Window, x, y int) {
+	b.configure(win, "set window position", xproto.ConfigWindowX|xproto.ConfigWindowY,
+		[]uint32{uint32(x), uint32(y)}, func() {
+			win.WriteLock(func() {
+				win.X, win.Y = x, y
+			})
+		})
+}
+
+func (b *Batch) SetSize(win *Window, width, height int) {
+	b.configure(win, "set window size", xproto.ConfigWindowWidth|xproto.ConfigWindowHeight,
+		[]uint32{uint32(width), uint32(height)}, func() {
+			win.WriteLock(func() {
+				win.Width, win.Height = width, height
+			})
+		})
+}
+
+func (b *Batch) SetGeometry(win *Window, x, y, width, height int) {
+	b.configure(win, "set window geometry",
+		xproto.ConfigWindowX|xproto.ConfigWindowY|xproto.ConfigWindowWidth|xproto.ConfigWindowHeight,
+		[]uint32{uint32(x), uint32(y), uint32(width), uint32(height)}, func() {
+			win.WriteLock(func() {
+				win.X, win.Y, win.Width, win.Height = x, y, width, height
+			})
+		})
+}
+
+func (b *Batch) restack(win, sibling *Window, mode byte) {
+	if sibling != nil {
+		b.configure(win, "restack window", xproto.ConfigWindowSibling|xproto.ConfigWindowStackMode,
+			[]uint32{uint32(sibling.Id), uint32(mode)}, nil)
+	} else {
+		b.configure(win, "restack window", xproto.ConfigWindowStackMode, []uint32{uint32(mode)}, nil)
+	}
+}
+
+// Above stacks win above sibling, or on top if sibling is nil
+func (b *Batch) Above(win, sibling *Window) {
+	b.restack(win, sibling, xproto.StackModeAbove)
+}
+
+// Below stacks win below sibling, or at bottom if sibling is nil
+func (b *Batch) Below(win, sibling *Window) {
+	b.restack(win, sibling, xproto.StackModeBelow)
+}
+
+// SetBorderColor sets the border pixel, like 0xRRGGBB on true color screens
+func (b *Batch) SetBorderColor(win *Window, pixel uint32) {
+	b.add(win, "ChangeWindowAttributes", "set border color",
+		win.wm.Backend.ChangeWindowAttributes(win.Id, xproto.CwBorderPixel, []uint32{pixel}), nil)
+}
+
+func (b *Batch) ChangeInt32sProperty(win *Window, atom, what xproto.Atom, ints ...uint32) {
+	buf := make([]byte, len(ints)*4)
+	for i, integer := range ints {
+		xgb.Put32(buf[i*4:], integer)
+	}
+	b.add(win, "ChangeProperty", "change window property",
+		win.wm.Backend.ChangeProperty(xproto.PropModeReplace, win.Id, atom, what, 32, uint32(len(ints)), buf), nil)
+}
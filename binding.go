@@ -0,0 +1,237 @@
+package wmutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+type Trigger int
+
+const (
+	TriggerPress Trigger = iota
+	// TriggerRelease fires when the stroke ends: when its key is released for strokes without modifiers, or when one of its modifiers is released
+	TriggerRelease
+	// TriggerHold fires when the key is held down for Binding.Hold
+	TriggerHold
+)
+
+type Binding struct {
+	Stroke  Stroke
+	Trigger Trigger
+	Hold    time.Duration
+}
+
+type BindingEvent struct {
+	Binding Binding
+	// time since the stroke was first pressed
+	Held time.Duration
+}
+
+type heldStroke struct {
+	stroke  Stroke
+	code    xproto.Keycode
+	pressed time.Time
+	timers  []*time.Timer
+	keyUp   bool // key released, modifiers still held
+	grabbed bool
+}
+
+type binder struct {
+	sync.Mutex
+	bindings map[Stroke][]Binding
+	held     *heldStroke
+}
+
+func newBinder(bindings []Binding) *binder {
+	b := &binder{
+		bindings: make(map[Stroke][]Binding),
+	}
+	for _, binding := range bindings {
+		b.bindings[binding.Stroke] = append(b.bindings[binding.Stroke], binding)
+	}
+	return b
+}
+
+func (b *binder) strokes() (ret []Stroke) {
+	for stroke := range b.bindings {
+		ret = append(ret, stroke)
+	}
+	return
+}
+
+// pressBinding returns false if the stroke is not bound
+func (w *Wm) pressBinding(stroke Stroke, code xproto.Keycode) bool {
+	b := w.currentConfig().binder
+	if b == nil {
+		return false
+	}
+	b.Lock()
+	bindings, ok := b.bindings[stroke]
+	if !ok {
+		b.Unlock()
+		return false
+	}
+	var evs []BindingEvent
+	h := b.held
+	// modifier releases are not seen without the keyboard grabbed, a stroke with its key up may have ended long ago
+	if h != nil && h.stroke == stroke && h.code == code && (!h.keyUp || h.grabbed) {
+		if !h.keyUp { // unfiltered autorepeat
+			b.Unlock()
+			return true
+		}
+		// pressed again in the same chord
+		h.keyUp = false
+	} else {
+		if h != nil {
+			evs = w.endStroke(b, h)
+		}
+		h = &heldStroke{
+			stroke:  stroke,
+			code:    code,
+			pressed: time.Now(),
+		}
+		b.held = h
+	}
+	for _, binding := range bindings {
+		binding := binding
+		switch binding.Trigger {
+		case TriggerPress:
+			evs = append(evs, BindingEvent{
+				Binding: binding,
+				Held:    time.Since(h.pressed),
+			})
+		case TriggerHold:
+			var timer *time.Timer
+			timer = time.AfterFunc(binding.Hold, func() {
+				b.Lock()
+				if b.held != h || h.keyUp {
+					b.Unlock()
+					return
+				}
+				for i, t := range h.timers {
+					if t == timer {
+						h.timers = append(h.timers[:i], h.timers[i+1:]...)
+						break
+					}
+				}
+				b.Unlock()
+				send(w, "Binding", w.Binding, BindingEvent{
+					Binding: binding,
+					Held:    time.Since(h.pressed),
+				})
+			})
+			h.timers = append(h.timers, timer)
+		case TriggerRelease:
+			// modifier releases are only seen with the keyboard grabbed
+			if stroke.Modifiers != 0 && !h.grabbed {
+				if reply, err := w.Backend.GrabKeyboard(true, w.DefaultRootId, xproto.TimeCurrentTime,
+					xproto.GrabModeAsync, xproto.GrabModeAsync).Reply(); err != nil || reply.Status != xproto.GrabStatusSuccess {
+					w.logger.Error("grab keyboard", requestAttr("GrabKeyboard"), errAttr(err))
+				} else {
+					h.grabbed = true
+				}
+			}
+		}
+	}
+	b.Unlock()
+	for _, ev := range evs {
+		send(w, "Binding", w.Binding, ev)
+	}
+	return true
+}
+
+func (w *Wm) releaseBinding(code xproto.Keycode) {
+	b := w.currentConfig().binder
+	if b == nil {
+		return
+	}
+	b.Lock()
+	h := b.held
+	if h == nil {
+		b.Unlock()
+		return
+	}
+	var evs []BindingEvent
+	if code == h.code {
+		stopTimers(h)
+		h.keyUp = true
+		if h.stroke.Modifiers == 0 {
+			evs = w.endStroke(b, h)
+		}
+	} else if w.modifierMasks[code]&h.stroke.Modifiers != 0 {
+		evs = w.endStroke(b, h)
+	}
+	b.Unlock()
+	for _, ev := range evs {
+		send(w, "Binding", w.Binding, ev)
+	}
+}
+
+// endStroke must be called with lock held, returns release events to send
+func (w *Wm) endStroke(b *binder, h *heldStroke) (evs []BindingEvent) {
+	stopTimers(h)
+	if h.grabbed {
+		if err := w.Backend.UngrabKeyboard(xproto.TimeCurrentTime).Check(); err != nil {
+			w.logger.Error("ungrab keyboard", requestAttr("UngrabKeyboard"), errAttr(err))
+		}
+	}
+	b.held = nil
+	for _, binding := range b.bindings[h.stroke] {
+		if binding.Trigger == TriggerRelease {
+			evs = append(evs, BindingEvent{
+				Binding: binding,
+				Held:    time.Since(h.pressed),
+			})
+		}
+	}
+	return
+}
+
+func stopTimers(h *heldStroke) {
+	for _, timer := range h.timers {
+		timer.Stop()
+	}
+	h.timers = nil
+}
+
+// isAutorepeat reports whether the release is followed by a press of the same key at the same time, which is consumed.
+// the server sends both together, so only the event already queued is checked. other events read are queued for the loop
+func (w *Wm) isAutorepeat(ev xproto.KeyReleaseEvent) bool {
+	var next xgb.Event
+	if len(w.pendingEvents) > 0 {
+		next = w.pendingEvents[0]
+	} else {
+		var xerr xgb.Error
+		for {
+			next, xerr = w.Backend.PollForEvent()
+			if xerr == nil {
+				break
+			}
+			w.logger.Error("x error", errAttr(xerr))
+			w.Metrics.countError(xerr)
+		}
+		if next == nil {
+			return false
+		}
+		w.pendingEvents = append(w.pendingEvents, next)
+	}
+	if press, ok := next.(xproto.KeyPressEvent); ok && press.Detail == ev.Detail && press.Time == ev.Time {
+		w.pendingEvents = w.pendingEvents[1:]
+		return true
+	}
+	return false
+}
+
+// isHeldKey reports whether the key release may end the held stroke
+func (w *Wm) isHeldKey(code xproto.Keycode) bool {
+	b := w.currentConfig().binder
+	if b == nil {
+		return false
+	}
+	b.Lock()
+	defer b.Unlock()
+	return b.held != nil && (b.held.code == code || w.modifierMasks[code] != 0)
+}
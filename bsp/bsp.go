@@ -0,0 +1,333 @@
+package bsp
+
+import "github.com/reusee/wmutil"
+
+type Rect = wmutil.Rect
+
+type Direction int
+
+const (
+	Left Direction = iota
+	Right
+	Up
+	Down
+)
+
+// Node is a leaf holding a window, or a split with two children
+type Node struct {
+	Parent        *Node
+	First, Second *Node
+	// split into top and bottom if true, left and right otherwise
+	Vertical bool
+	// size of First
+	Ratio  float64
+	Window *wmutil.Window
+	// where the next window goes when splitting this leaf
+	Presel *Presel
+	// last arranged geometry
+	rect Rect
+}
+
+type Presel struct {
+	Direction Direction
+	// size of the new window
+	Ratio float64
+}
+
+func (n *Node) IsLeaf() bool {
+	return n.First == nil
+}
+
+func (n *Node) walk(fn func(*Node)) {
+	if n == nil {
+		return
+	}
+	fn(n)
+	n.First.walk(fn)
+	n.Second.walk(fn)
+}
+
+func (n *Node) leaves() (count int) {
+	n.walk(func(node *Node) {
+		if node.IsLeaf() {
+			count++
+		}
+	})
+	return
+}
+
+func (n *Node) firstLeaf() *Node {
+	for !n.IsLeaf() {
+		n = n.First
+	}
+	return n
+}
+
+type Tree struct {
+	Root    *Node
+	Focused *Node
+	// default size of the split leaf
+	Ratio float64
+	Gap   int
+}
+
+func New() *Tree {
+	return &Tree{
+		Ratio: 0.5,
+	}
+}
+
+func (t *Tree) Find(win *wmutil.Window) (ret *Node) {
+	t.Root.walk(func(n *Node) {
+		if n.Window == win {
+			ret = n
+		}
+	})
+	return
+}
+
+// Map inserts windows delivered on Wm.Map
+func (t *Tree) Map(win *wmutil.Window) {
+	if t.Find(win) == nil {
+		t.Insert(win)
+	}
+}
+
+// Unmap removes windows delivered on Wm.Unmap, which also precedes the destruction of mapped windows
+func (t *Tree) Unmap(win *wmutil.Window) {
+	t.Remove(win)
+}
+
+// Insert splits the focused leaf in the preselected direction, or along its longer side, and focuses the new leaf
+func (t *Tree) Insert(win *wmutil.Window) *Node {
+	leaf := &Node{
+		Window: win,
+	}
+	target := t.Focused
+	if t.Root == nil {
+		t.Root = leaf
+		t.Focused = leaf
+		return leaf
+	}
+	if target == nil {
+		target = t.Root.firstLeaf()
+	}
+	presel := target.Presel
+	if presel == nil {
+		dir := Right
+		if target.rect.Height > target.rect.Width {
+			dir = Down
+		}
+		presel = &Presel{
+			Direction: dir,
+			Ratio:     1 - t.Ratio,
+		}
+	}
+	// the target leaf becomes a split holding the old and new leaves
+	old := &Node{
+		Parent: target,
+		Window: target.Window,
+		rect:   target.rect,
+	}
+	leaf.Parent = target
+	target.Window = nil
+	target.Presel = nil
+	target.Vertical = presel.Direction == Up || presel.Direction == Down
+	if presel.Direction == Left || presel.Direction == Up {
+		target.First, target.Second = leaf, old
+		target.Ratio = presel.Ratio
+	} else {
+		target.First, target.Second = old, leaf
+		target.Ratio = 1 - presel.Ratio
+	}
+	t.Focused = leaf
+	return leaf
+}
+
+// Remove deletes the window's leaf, its sibling takes the place of their parent
+func (t *Tree) Remove(win *wmutil.Window) {
+	leaf := t.Find(win)
+	if leaf == nil {
+		return
+	}
+	parent := leaf.Parent
+	if parent == nil {
+		t.Root = nil
+		t.Focused = nil
+		return
+	}
+	sibling := parent.First
+	if sibling == leaf {
+		sibling = parent.Second
+	}
+	sibling.Parent = parent.Parent
+	if parent.Parent == nil {
+		t.Root = sibling
+	} else if parent.Parent.First == parent {
+		parent.Parent.First = sibling
+	} else {
+		parent.Parent.Second = sibling
+	}
+	if t.Focused == leaf || t.Focused == parent {
+		t.Focused = sibling.firstLeaf()
+	}
+}
+
+// prune removes windows unmapped or destroyed without Unmap being called
+func (t *Tree) prune() {
+	var dead []*wmutil.Window
+	t.Root.walk(func(n *Node) {
+		if !n.IsLeaf() {
+			return
+		}
+		mapped := false
+		n.Window.ReadLock(func() {
+			mapped = n.Window.Mapped
+		})
+		if !mapped {
+			dead = append(dead, n.Window)
+		}
+	})
+	for _, win := range dead {
+		t.Remove(win)
+	}
+}
+
+func (t *Tree) Focus(win *wmutil.Window) {
+	if n := t.Find(win); n != nil {
+		t.Focused = n
+	}
+}
+
+// Preselect sets where the next window goes when it splits the focused leaf
+func (t *Tree) Preselect(dir Direction, ratio float64) {
+	if t.Focused == nil {
+		return
+	}
+	if ratio <= 0 || ratio >= 1 {
+		ratio = 1 - t.Ratio
+	}
+	t.Focused.Presel = &Presel{
+		Direction: dir,
+		Ratio:     ratio,
+	}
+}
+
+func (t *Tree) CancelPreselect() {
+	if t.Focused != nil {
+		t.Focused.Presel = nil
+	}
+}
+
+// subtree returns the node to operate on, the root if node is nil
+func (t *Tree) subtree(node *Node) *Node {
+	if node == nil {
+		return t.Root
+	}
+	return node
+}
+
+// Rotate turns the subtree clockwise by degrees of multiple of 90
+func (t *Tree) Rotate(node *Node, degrees int) {
+	steps := ((degrees/90)%4 + 4) % 4
+	for i := 0; i < steps; i++ {
+		t.subtree(node).walk(func(n *Node) {
+			if n.IsLeaf() {
+				return
+			}
+			// left becomes top, top becomes right
+			if n.Vertical {
+				n.First, n.Second = n.Second, n.First
+				n.Ratio = 1 - n.Ratio
+			}
+			n.Vertical = !n.Vertical
+		})
+	}
+}
+
+// Flip mirrors the subtree, left and right if horizontal, top and bottom otherwise
+func (t *Tree) Flip(node *Node, horizontal bool) {
+	t.subtree(node).walk(func(n *Node) {
+		if n.IsLeaf() || n.Vertical == horizontal {
+			return
+		}
+		n.First, n.Second = n.Second, n.First
+		n.Ratio = 1 - n.Ratio
+	})
+}
+
+// Balance sets ratios so that all leaves in the subtree have the same area
+func (t *Tree) Balance(node *Node) {
+	t.subtree(node).walk(func(n *Node) {
+		if n.IsLeaf() {
+			return
+		}
+		first := n.First.leaves()
+		n.Ratio = float64(first) / float64(first+n.Second.leaves())
+	})
+}
+
+type Placement struct {
+	Window *wmutil.Window
+	Rect   Rect
+}
+
+func (t *Tree) Arrange(area Rect) (ret []Placement) {
+	if t.Root == nil {
+		return
+	}
+	var arrange func(n *Node, area Rect)
+	arrange = func(n *Node, area Rect) {
+		n.rect = area
+		if n.IsLeaf() {
+			r := area
+			if r.Width > t.Gap*2 && r.Height > t.Gap*2 {
+				r.X += t.Gap
+				r.Y += t.Gap
+				r.Width -= t.Gap * 2
+				r.Height -= t.Gap * 2
+			}
+			ret = append(ret, Placement{
+				Window: n.Window,
+				Rect:   r,
+			})
+			return
+		}
+		a, b := area, area
+		if n.Vertical {
+			a.Height = int(float64(area.Height) * n.Ratio)
+			b.Y += a.Height
+			b.Height -= a.Height
+		} else {
+			a.Width = int(float64(area.Width) * n.Ratio)
+			b.X += a.Width
+			b.Width -= a.Width
+		}
+		arrange(n.First, a)
+		arrange(n.Second, b)
+	}
+	arrange(t.Root, area)
+	return
+}
+
+// Apply removes windows no longer mapped, then arranges windows in area
+func (t *Tree) Apply(area Rect) error {
+	t.prune()
+	var b wmutil.Batch
+	for _, p := range t.Arrange(area) {
+		r := p.Rect
+		border := 0
+		p.Window.ReadLock(func() {
+			border = p.Window.Border
+		})
+		width, height := r.Width-border*2, r.Height-border*2
+		if width < 1 {
+			width = 1
+		}
+		if height < 1 {
+			height = 1
+		}
+		b.SetGeometry(p.Window, r.X, r.Y, width, height)
+	}
+	return b.Wait()
+}
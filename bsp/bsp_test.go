@@ -0,0 +1,128 @@
+package bsp
+
+import (
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/fake"
+)
+
+func newWindow() *wmutil.Window {
+	return &wmutil.Window{
+		RWMutex: new(sync.RWMutex),
+	}
+}
+
+func rects(t *Tree, area Rect) map[*wmutil.Window]Rect {
+	ret := make(map[*wmutil.Window]Rect)
+	for _, p := range t.Arrange(area) {
+		ret[p.Window] = p.Rect
+	}
+	return ret
+}
+
+func TestBSP(t *testing.T) {
+	area := Rect{X: 0, Y: 0, Width: 200, Height: 120}
+	tree := New()
+	a, b, c := newWindow(), newWindow(), newWindow()
+	tree.Map(a)
+	tree.Arrange(area)
+	tree.Map(b)
+	tree.Arrange(area)
+	// b is taller than wide now
+	tree.Map(c)
+	got := rects(tree, area)
+	if got[a] != (Rect{X: 0, Y: 0, Width: 100, Height: 120}) ||
+		got[b] != (Rect{X: 100, Y: 0, Width: 100, Height: 60}) ||
+		got[c] != (Rect{X: 100, Y: 60, Width: 100, Height: 60}) {
+		t.Fatalf("got %v", got)
+	}
+
+	tree.Balance(nil)
+	got = rects(tree, area)
+	if got[a].Width != 66 {
+		t.Fatalf("got %v", got[a])
+	}
+
+	tree.Rotate(nil, 90)
+	got = rects(tree, area)
+	if got[a].Y != 0 || got[a].Width != 200 {
+		t.Fatalf("got %v", got[a])
+	}
+	tree.Rotate(nil, -90)
+	tree.Flip(nil, true)
+	got = rects(tree, area)
+	if got[a].X == 0 {
+		t.Fatalf("got %v", got[a])
+	}
+
+	tree.Unmap(b)
+	got = rects(tree, area)
+	if len(got) != 2 || got[c].Height != 120 {
+		t.Fatalf("got %v", got)
+	}
+	if tree.Focused.Window != c {
+		t.Fatal("focus should go to sibling")
+	}
+}
+
+func TestPreselect(t *testing.T) {
+	area := Rect{X: 0, Y: 0, Width: 100, Height: 100}
+	tree := New()
+	a, b := newWindow(), newWindow()
+	tree.Map(a)
+	tree.Preselect(Up, 0.3)
+	tree.Map(b)
+	got := rects(tree, area)
+	if got[b] != (Rect{X: 0, Y: 0, Width: 100, Height: 30}) || got[a] != (Rect{X: 0, Y: 30, Width: 100, Height: 70}) {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestApplyDestroyed(t *testing.T) {
+	s := fake.New(200, 100)
+	wm, err := wmutil.New(&wmutil.Config{
+		Backend: s.Backend(),
+		Logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wm.Close()
+	go func() {
+		for range wm.Unmap {
+		}
+	}()
+	area := Rect{Width: 200, Height: 100}
+	tree := New()
+	var ids []xproto.Window
+	for i := 0; i < 2; i++ {
+		id := s.CreateWindow(fake.WindowOptions{Width: 10, Height: 10})
+		s.Map(id)
+		tree.Map(<-wm.Map)
+		ids = append(ids, id)
+	}
+	if err := tree.Apply(area); err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := s.Geometry(ids[1]); g != (Rect{X: 100, Width: 100, Height: 100}) {
+		t.Fatalf("got %v", g)
+	}
+
+	// Unmap is not called, the destroyed window is pruned
+	s.Destroy(ids[0])
+	s.WaitIdle()
+	if err := tree.Apply(area); err != nil {
+		t.Fatal(err)
+	}
+	if g, _ := s.Geometry(ids[1]); g != area {
+		t.Fatalf("got %v", g)
+	}
+	if tree.Root.Window == nil || tree.Root.Window.Id != ids[1] {
+		t.Fatal("sibling should take the place of the parent")
+	}
+}
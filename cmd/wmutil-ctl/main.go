@@ -0,0 +1,173 @@
+// wmutil-ctl controls a window manager built on wmutil through its IPC socket
+//
+//	wmutil-ctl move-to-workspace 3
+//	wmutil-ctl windows
+//	wmutil-ctl -json workspaces
+//	wmutil-ctl subscribe map unmap | while read ev; do ...; done
+//	wmutil-ctl dump > state.json
+//
+// exit status is 1 if the command failed, 2 for usage or connection errors
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/ipc"
+)
+
+var (
+	socketPath = flag.String("s", "", "socket path, defaults to WMUTIL_SOCKET or the socket of DISPLAY")
+	asJSON     = flag.Bool("json", false, "print replies as JSON instead of tables")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [flags] windows|workspaces|monitors|dump|subscribe [events...]|<command> [args...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	path := *socketPath
+	if path == "" {
+		var err error
+		path, err = ipc.DefaultSocketPath()
+		if err != nil {
+			fatal(2, err)
+		}
+	}
+	client, err := ipc.Dial(path)
+	if err != nil {
+		fatal(2, err)
+	}
+	defer client.Close()
+
+	args := flag.Args()
+	switch args[0] {
+
+	case "windows":
+		var windows []ipc.WindowInfo
+		query(client, ipc.RequestWindows, &windows)
+		table([]string{"ID", "CLASS", "INSTANCE", "NAME", "GEOMETRY", "MAPPED", "WORKSPACE"}, len(windows), func(i int) []interface{} {
+			w := windows[i]
+			return []interface{}{
+				fmt.Sprintf("0x%x", w.Id), w.Class, w.Instance, w.Name,
+				fmt.Sprintf("%dx%d+%d+%d", w.Width, w.Height, w.X, w.Y), w.Mapped, w.Workspace,
+			}
+		})
+
+	case "workspaces":
+		var workspaces []ipc.Workspace
+		query(client, ipc.RequestWorkspaces, &workspaces)
+		table([]string{"NAME", "FOCUSED", "MONITOR", "WINDOWS"}, len(workspaces), func(i int) []interface{} {
+			w := workspaces[i]
+			var ids []string
+			for _, id := range w.Windows {
+				ids = append(ids, fmt.Sprintf("0x%x", id))
+			}
+			return []interface{}{w.Name, w.Focused, w.Monitor, strings.Join(ids, ",")}
+		})
+
+	case "monitors":
+		var monitors []wmutil.Rect
+		query(client, ipc.RequestMonitors, &monitors)
+		table([]string{"MONITOR", "GEOMETRY"}, len(monitors), func(i int) []interface{} {
+			m := monitors[i]
+			return []interface{}{i, fmt.Sprintf("%dx%d+%d+%d", m.Width, m.Height, m.X, m.Y)}
+		})
+
+	case "dump":
+		// always JSON
+		data, err := client.Call(ipc.Request{
+			Type: ipc.RequestDump,
+		})
+		if err != nil {
+			fatal(exitCode(err), err)
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			fatal(2, err)
+		}
+		fmt.Printf("%s\n", buf.Bytes())
+
+	case "subscribe":
+		// events are always printed as JSON lines
+		err := client.Subscribe(args[1:], func(ev ipc.Event, line []byte) error {
+			_, err := fmt.Printf("%s\n", line)
+			return err
+		})
+		if err != nil {
+			fatal(exitCode(err), err)
+		}
+
+	default:
+		words := make([]string, 0, len(args))
+		for _, arg := range args {
+			words = append(words, quote(arg))
+		}
+		if err := client.Command(strings.Join(words, " ")); err != nil {
+			fatal(exitCode(err), err)
+		}
+	}
+}
+
+func query(client *ipc.Client, typ string, target interface{}) {
+	data, err := client.Call(ipc.Request{
+		Type: typ,
+	})
+	if err != nil {
+		fatal(exitCode(err), err)
+	}
+	if *asJSON {
+		fmt.Printf("%s\n", data)
+		os.Exit(0)
+	}
+	if err := json.Unmarshal(data, target); err != nil {
+		fatal(2, err)
+	}
+}
+
+func table(header []string, n int, row func(int) []interface{}) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for i := 0; i < n; i++ {
+		var cols []string
+		for _, col := range row(i) {
+			cols = append(cols, fmt.Sprint(col))
+		}
+		fmt.Fprintln(w, strings.Join(cols, "\t"))
+	}
+	w.Flush()
+}
+
+// quote keeps shell arguments as single words for command.Split
+func quote(arg string) string {
+	if arg != "" && !strings.ContainsAny(arg, " \t\n\"'\\") {
+		return arg
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(arg) + `"`
+}
+
+func exitCode(err error) int {
+	var cmdErr *ipc.CommandError
+	if errors.As(err, &cmdErr) {
+		return 1
+	}
+	return 2
+}
+
+func fatal(code int, err error) {
+	fmt.Fprintf(os.Stderr, "%s: %v\n", os.Args[0], err)
+	os.Exit(code)
+}
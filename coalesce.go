@@ -0,0 +1,116 @@
+package wmutil
+
+import (
+	"time"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+func coalescable(ev xgb.Event) bool {
+	switch ev.(type) {
+	case xproto.PropertyNotifyEvent, xproto.ConfigureRequestEvent, xproto.ConfigureNotifyEvent:
+		return true
+	}
+	return false
+}
+
+// coalesce reads the run of property and configure events already queued after ev, for at most Config.CoalesceLatency.
+// an event is merged into the last event of its window if they are property notifies of the same atom, or configure events of the same kind.
+// the run ends at other events, which are queued after the merged ones, or when no event is queued. the loop never waits for events
+func (w *Wm) coalesce(ev xgb.Event) xgb.Event {
+	run := []xgb.Event{ev}
+	deadline := time.Now().Add(w.coalesceLatency)
+	for time.Now().Before(deadline) {
+		next, xerr := w.Backend.PollForEvent()
+		if xerr != nil {
+			w.logger.Error("x error", errAttr(xerr))
+			w.Metrics.countError(xerr)
+			continue
+		}
+		if next == nil {
+			break
+		}
+		if !coalescable(next) {
+			w.pendingEvents = append(w.pendingEvents, next)
+			break
+		}
+		if !merge(run, next) {
+			run = append(run, next)
+		}
+	}
+	w.pendingEvents = append(run[1:], w.pendingEvents...)
+	return run[0]
+}
+
+// eventWindow returns the window of a coalescable event
+func eventWindow(ev xgb.Event) xproto.Window {
+	switch ev := ev.(type) {
+	case xproto.PropertyNotifyEvent:
+		return ev.Window
+	case xproto.ConfigureNotifyEvent:
+		return ev.Window
+	case xproto.ConfigureRequestEvent:
+		return ev.Window
+	}
+	return 0
+}
+
+// merge merges next into the last event of the same window in run, events of a window are not reordered
+func merge(run []xgb.Event, next xgb.Event) bool {
+	window := eventWindow(next)
+	for i := len(run) - 1; i >= 0; i-- {
+		if eventWindow(run[i]) != window {
+			continue
+		}
+		switch ev := run[i].(type) {
+		case xproto.PropertyNotifyEvent:
+			if n, ok := next.(xproto.PropertyNotifyEvent); ok && n.Atom == ev.Atom {
+				run[i] = n
+				return true
+			}
+		case xproto.ConfigureNotifyEvent:
+			if n, ok := next.(xproto.ConfigureNotifyEvent); ok {
+				run[i] = n
+				return true
+			}
+		case xproto.ConfigureRequestEvent:
+			if n, ok := next.(xproto.ConfigureRequestEvent); ok {
+				run[i] = mergeConfigureRequest(ev, n)
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+// mergeConfigureRequest returns next with values of prev not requested by next
+func mergeConfigureRequest(prev, next xproto.ConfigureRequestEvent) xproto.ConfigureRequestEvent {
+	missing := prev.ValueMask &^ next.ValueMask
+	if missing&xproto.ConfigWindowX > 0 {
+		next.X = prev.X
+	}
+	if missing&xproto.ConfigWindowY > 0 {
+		next.Y = prev.Y
+	}
+	if missing&xproto.ConfigWindowWidth > 0 {
+		next.Width = prev.Width
+	}
+	if missing&xproto.ConfigWindowHeight > 0 {
+		next.Height = prev.Height
+	}
+	if missing&xproto.ConfigWindowBorderWidth > 0 {
+		next.BorderWidth = prev.BorderWidth
+	}
+	// sibling is relative to stack mode, they are taken together
+	stacking := uint16(xproto.ConfigWindowSibling | xproto.ConfigWindowStackMode)
+	if next.ValueMask&stacking == 0 {
+		next.Sibling = prev.Sibling
+		next.StackMode = prev.StackMode
+	} else {
+		missing &^= stacking
+	}
+	next.ValueMask |= missing
+	return next
+}
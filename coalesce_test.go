@@ -0,0 +1,30 @@
+package wmutil
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+func TestMerge(t *testing.T) {
+	configure := func(x int16) xproto.ConfigureRequestEvent {
+		return xproto.ConfigureRequestEvent{Window: 1, X: x, ValueMask: xproto.ConfigWindowX}
+	}
+	name := xproto.PropertyNotifyEvent{Window: 1, Atom: xproto.AtomWmName}
+
+	// not moved ahead of the property notify
+	run := []xgb.Event{configure(1), name}
+	if merge(run, configure(2)) {
+		t.Fatal("should not merge")
+	}
+
+	// events of other windows are skipped
+	run = []xgb.Event{configure(1), xproto.PropertyNotifyEvent{Window: 2, Atom: xproto.AtomWmName}}
+	if !merge(run, configure(2)) {
+		t.Fatal("should merge")
+	}
+	if ev := run[0].(xproto.ConfigureRequestEvent); ev.X != 2 {
+		t.Fatalf("got %+v", ev)
+	}
+}
@@ -0,0 +1,316 @@
+package command
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type ArgType int
+
+const (
+	String ArgType = iota
+	Int
+	Float
+	Bool
+	// Rest takes all remaining words as []string, must be the last argument
+	Rest
+)
+
+var argTypeNames = []string{"string", "int", "float", "bool", "rest"}
+
+func (t ArgType) String() string {
+	if t >= 0 && int(t) < len(argTypeNames) {
+		return argTypeNames[t]
+	}
+	return "unknown"
+}
+
+type Arg struct {
+	Name string
+	Type ArgType
+	// optional arguments take zero values when omitted, and must follow required ones
+	Optional bool
+	// allowed values of String arguments, any if empty
+	Choices []string
+}
+
+type Command struct {
+	Name string
+	Args []Arg
+	Help string
+	Func func(args Args) error
+}
+
+// Usage returns the command line synopsis like "move-to-workspace <workspace:int>"
+func (c *Command) Usage() string {
+	parts := []string{c.Name}
+	for _, arg := range c.Args {
+		s := arg.Name + ":" + arg.Type.String()
+		if len(arg.Choices) > 0 {
+			s = strings.Join(arg.Choices, "|")
+		}
+		if arg.Type == Rest {
+			s += "..."
+		}
+		if arg.Optional {
+			parts = append(parts, "["+s+"]")
+		} else {
+			parts = append(parts, "<"+s+">")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Args holds parsed argument values in the declared order
+type Args []interface{}
+
+func (a Args) String(i int) string {
+	s, _ := a[i].(string)
+	return s
+}
+
+func (a Args) Int(i int) int {
+	n, _ := a[i].(int)
+	return n
+}
+
+func (a Args) Float(i int) float64 {
+	f, _ := a[i].(float64)
+	return f
+}
+
+func (a Args) Bool(i int) bool {
+	b, _ := a[i].(bool)
+	return b
+}
+
+func (a Args) Rest(i int) []string {
+	s, _ := a[i].([]string)
+	return s
+}
+
+// Invocation is a parsed command line
+type Invocation struct {
+	Command *Command
+	Args    Args
+}
+
+func (i *Invocation) Run() error {
+	return i.Command.Func(i.Args)
+}
+
+// Registry maps command names to commands, safe for concurrent use
+type Registry struct {
+	sync.RWMutex
+	commands map[string]*Command
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		commands: make(map[string]*Command),
+	}
+}
+
+func (r *Registry) Register(cmd *Command) error {
+	if cmd.Name == "" || strings.ContainsAny(cmd.Name, " \t\"'") {
+		return ef("bad command name %q", cmd.Name)
+	}
+	if cmd.Func == nil {
+		return ef("command %s: nil func", cmd.Name)
+	}
+	optional := false
+	for i, arg := range cmd.Args {
+		if arg.Type == Rest && i != len(cmd.Args)-1 {
+			return ef("command %s: rest argument %s is not the last", cmd.Name, arg.Name)
+		}
+		if optional && !arg.Optional {
+			return ef("command %s: required argument %s follows optional ones", cmd.Name, arg.Name)
+		}
+		optional = arg.Optional
+	}
+	r.Lock()
+	defer r.Unlock()
+	if _, ok := r.commands[cmd.Name]; ok {
+		return ef("duplicated command %s", cmd.Name)
+	}
+	r.commands[cmd.Name] = cmd
+	return nil
+}
+
+// MustRegister is like Register but panics on error
+func (r *Registry) MustRegister(cmds ...*Command) {
+	for _, cmd := range cmds {
+		if err := r.Register(cmd); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (r *Registry) Lookup(name string) *Command {
+	r.RLock()
+	defer r.RUnlock()
+	return r.commands[name]
+}
+
+// Commands returns registered commands sorted by name
+func (r *Registry) Commands() (ret []*Command) {
+	r.RLock()
+	for _, cmd := range r.commands {
+		ret = append(ret, cmd)
+	}
+	r.RUnlock()
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return
+}
+
+// Help returns usage and help text of all commands
+func (r *Registry) Help() string {
+	var b strings.Builder
+	for _, cmd := range r.Commands() {
+		b.WriteString(cmd.Usage())
+		if cmd.Help != "" {
+			b.WriteString("\n\t")
+			b.WriteString(cmd.Help)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Parse parses a command line like `move-to-workspace 3`. words are separated by spaces, and may be quoted by ' or "
+func (r *Registry) Parse(line string) (*Invocation, error) {
+	words, err := Split(line)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, ef("empty command")
+	}
+	cmd := r.Lookup(words[0])
+	if cmd == nil {
+		return nil, ef("unknown command %s", words[0])
+	}
+	return cmd.Parse(words[1:])
+}
+
+// Parse converts words to typed arguments
+func (c *Command) Parse(words []string) (*Invocation, error) {
+	inv := &Invocation{
+		Command: c,
+	}
+	for i, arg := range c.Args {
+		if i >= len(words) {
+			if !arg.Optional {
+				return nil, ef("%s: missing argument %s, usage: %s", c.Name, arg.Name, c.Usage())
+			}
+			inv.Args = append(inv.Args, zero(arg.Type))
+			continue
+		}
+		word := words[i]
+		var value interface{}
+		var err error
+		switch arg.Type {
+		case String:
+			if len(arg.Choices) > 0 && !contains(arg.Choices, word) {
+				err = ef("want one of %s", strings.Join(arg.Choices, ", "))
+			}
+			value = word
+		case Int:
+			value, err = strconv.Atoi(word)
+		case Float:
+			value, err = strconv.ParseFloat(word, 64)
+		case Bool:
+			value, err = strconv.ParseBool(word)
+		case Rest:
+			value = append([]string(nil), words[i:]...)
+			words = words[:i+1]
+		}
+		if err != nil {
+			return nil, ef("%s: bad argument %s %q: %v", c.Name, arg.Name, word, err)
+		}
+		inv.Args = append(inv.Args, value)
+	}
+	if len(words) > len(c.Args) {
+		return nil, ef("%s: too many arguments, usage: %s", c.Name, c.Usage())
+	}
+	return inv, nil
+}
+
+// Run parses and runs a command line
+func (r *Registry) Run(line string) error {
+	inv, err := r.Parse(line)
+	if err != nil {
+		return err
+	}
+	return inv.Run()
+}
+
+// Split splits a command line into words, honoring quotes and backslash escapes
+func Split(line string) (words []string, err error) {
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, ef("unterminated quote or escape in %q", line)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return
+}
+
+func zero(t ArgType) interface{} {
+	switch t {
+	case Int:
+		return 0
+	case Float:
+		return 0.0
+	case Bool:
+		return false
+	case Rest:
+		return []string(nil)
+	}
+	return ""
+}
+
+func contains(ss []string, s string) bool {
+	for _, e := range ss {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	var got Args
+	r.MustRegister(&Command{
+		Name: "move-to-workspace",
+		Args: []Arg{
+			{Name: "workspace", Type: Int},
+			{Name: "follow", Type: Bool, Optional: true},
+		},
+		Func: func(args Args) error {
+			got = args
+			return nil
+		},
+	}, &Command{
+		Name: "focus",
+		Args: []Arg{
+			{Name: "direction", Choices: []string{"next", "prev"}},
+		},
+		Func: func(args Args) error {
+			got = args
+			return nil
+		},
+	}, &Command{
+		Name: "exec",
+		Args: []Arg{
+			{Name: "argv", Type: Rest},
+		},
+		Func: func(args Args) error {
+			got = args
+			return nil
+		},
+	})
+
+	if err := r.Run("move-to-workspace 3"); err != nil {
+		t.Fatal(err)
+	}
+	if got.Int(0) != 3 || got.Bool(1) {
+		t.Fatalf("got %v", got)
+	}
+	if err := r.Run(`exec sh -c "echo 'a b'"`); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Rest(0), []string{"sh", "-c", "echo 'a b'"}) {
+		t.Fatalf("got %q", got.Rest(0))
+	}
+	for _, line := range []string{
+		"",
+		"nope",
+		"move-to-workspace",
+		"move-to-workspace x",
+		"move-to-workspace 1 true 2",
+		"focus up",
+		`focus "next`,
+	} {
+		if err := r.Run(line); err == nil {
+			t.Fatalf("expecting error for %q", line)
+		}
+	}
+	if usage := r.Lookup("move-to-workspace").Usage(); usage != "move-to-workspace <workspace:int> [follow:bool]" {
+		t.Fatalf("got %s", usage)
+	}
+	if err := r.Register(&Command{Name: "focus", Func: func(Args) error { return nil }}); err == nil {
+		t.Fatal("expecting duplicated error")
+	}
+	if ArgType(-1).String() != "unknown" || Rest.String() != "rest" {
+		t.Fatal("bad arg type name")
+	}
+}
@@ -0,0 +1,411 @@
+package command
+
+import (
+	"sync"
+
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/layout"
+)
+
+// Desktop keeps windows in workspaces for the focus, workspace, move-to-workspace and layout commands.
+// the caller adds windows from Wm.Map and removes them from Wm.Unmap. windows of hidden workspaces are moved off screen.
+// the Workspace, Floating and Focus rule actions of windows are applied when they are added
+type Desktop struct {
+	Wm *wmutil.Wm
+	// names of workspaces, the first is shown at start. a single workspace "1" if empty
+	Workspaces []string
+	// arranges windows of the shown workspace in Area, windows are not moved if nil
+	Layout layout.Layout
+	// the work area of the first monitor if empty
+	Area wmutil.Rect
+	// parameters of layouts set by the layout command
+	Masters int
+	Ratio   float64
+	Gap     int
+	// borders are colored if not nil
+	Colors *BorderColors
+	// called after a command changes the focused window or the shown workspace
+	OnFocus     func(*wmutil.Window)
+	OnWorkspace func(name string)
+
+	lock    sync.Mutex
+	current int
+	focused *wmutil.Window
+	// windows of each workspace, the focused one first
+	windows [][]*wmutil.Window
+	// windows not arranged by the layout
+	floating map[*wmutil.Window]bool
+	// positions of hidden windows, restored when shown without layout
+	hidden map[*wmutil.Window][2]int
+}
+
+// BorderColors are border pixels like 0xRRGGBB
+type BorderColors struct {
+	Focused, Normal uint32
+}
+
+func (d *Desktop) init() {
+	if d.windows != nil {
+		return
+	}
+	if len(d.Workspaces) == 0 {
+		d.Workspaces = []string{"1"}
+	}
+	d.windows = make([][]*wmutil.Window, len(d.Workspaces))
+	d.hidden = make(map[*wmutil.Window][2]int)
+	d.floating = make(map[*wmutil.Window]bool)
+}
+
+func (d *Desktop) index(name string) int {
+	for i, ws := range d.Workspaces {
+		if ws == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (d *Desktop) find(win *wmutil.Window) (ws, i int) {
+	for ws, windows := range d.windows {
+		for i, w := range windows {
+			if w == win {
+				return ws, i
+			}
+		}
+	}
+	return -1, -1
+}
+
+// Add puts the window in the workspace of its rule, or the shown one. a window added to the shown workspace is focused
+// unless its rule says not, then it is put after the focused one
+func (d *Desktop) Add(win *wmutil.Window) {
+	d.lock.Lock()
+	d.init()
+	if ws, _ := d.find(win); ws >= 0 {
+		d.lock.Unlock()
+		return
+	}
+	var rule wmutil.RuleActions
+	win.ReadLock(func() {
+		rule = win.Rule
+	})
+	ws := d.current
+	if i := d.index(rule.Workspace); i >= 0 {
+		ws = i
+	}
+	if rule.Floating != nil && *rule.Floating {
+		d.floating[win] = true
+	}
+	if ws != d.current {
+		d.windows[ws] = append(d.windows[ws], win)
+		var b wmutil.Batch
+		d.hide(&b, win)
+		d.color(&b, win, false)
+		b.Wait()
+		d.lock.Unlock()
+		return
+	}
+	focus := rule.Focus == nil || *rule.Focus
+	windows := d.windows[ws]
+	if focus || len(windows) == 0 {
+		d.windows[ws] = append([]*wmutil.Window{win}, windows...)
+	} else {
+		d.windows[ws] = append(windows[:1:1], append([]*wmutil.Window{win}, windows[1:]...)...)
+	}
+	d.arrange()
+	if !focus {
+		var b wmutil.Batch
+		d.color(&b, win, false)
+		b.Wait()
+		d.lock.Unlock()
+		return
+	}
+	focused := d.focus()
+	d.lock.Unlock()
+	if d.OnFocus != nil {
+		d.OnFocus(focused)
+	}
+}
+
+// Remove forgets the window, like when it is unmapped
+func (d *Desktop) Remove(win *wmutil.Window) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.init()
+	ws, i := d.find(win)
+	if ws < 0 {
+		return
+	}
+	d.windows[ws] = append(d.windows[ws][:i:i], d.windows[ws][i+1:]...)
+	delete(d.hidden, win)
+	delete(d.floating, win)
+	if d.focused == win {
+		d.focused = nil
+	}
+	if ws == d.current {
+		d.arrange()
+	}
+}
+
+// Current returns the name of the shown workspace
+func (d *Desktop) Current() string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.init()
+	return d.Workspaces[d.current]
+}
+
+// Windows returns windows of the workspace, the focused one first
+func (d *Desktop) Windows(name string) []*wmutil.Window {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.init()
+	if i := d.index(name); i >= 0 {
+		return append([]*wmutil.Window(nil), d.windows[i]...)
+	}
+	return nil
+}
+
+// WindowWorkspace returns the workspace name of the window, or empty if not added
+func (d *Desktop) WindowWorkspace(win *wmutil.Window) string {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.init()
+	if ws, _ := d.find(win); ws >= 0 {
+		return d.Workspaces[ws]
+	}
+	return ""
+}
+
+func (d *Desktop) area() wmutil.Rect {
+	if d.Area.Width > 0 && d.Area.Height > 0 {
+		return d.Area
+	}
+	return d.Wm.WorkArea(d.Wm.Monitors()[0])
+}
+
+// arrange applies the layout to windows of the shown workspace not floating
+func (d *Desktop) arrange() error {
+	if d.Layout == nil {
+		return nil
+	}
+	var tiled []*wmutil.Window
+	for _, win := range d.windows[d.current] {
+		if !d.floating[win] {
+			tiled = append(tiled, win)
+		}
+	}
+	return layout.Apply(d.Layout, d.area(), tiled)
+}
+
+func (d *Desktop) hide(b *wmutil.Batch, win *wmutil.Window) {
+	var x, y, width, border int
+	win.ReadLock(func() {
+		x, y, width, border = win.X, win.Y, win.Width, win.Border
+	})
+	d.hidden[win] = [2]int{x, y}
+	b.SetPos(win, -2*(width+border*2), y)
+}
+
+func (d *Desktop) show(b *wmutil.Batch, win *wmutil.Window) {
+	if pos, ok := d.hidden[win]; ok {
+		delete(d.hidden, win)
+		if d.Layout == nil || d.floating[win] {
+			b.SetPos(win, pos[0], pos[1])
+		}
+	}
+}
+
+func (d *Desktop) color(b *wmutil.Batch, win *wmutil.Window, focused bool) {
+	if d.Colors == nil {
+		return
+	}
+	if focused {
+		b.SetBorderColor(win, d.Colors.Focused)
+	} else {
+		b.SetBorderColor(win, d.Colors.Normal)
+	}
+}
+
+// focus raises the first window of the shown workspace and moves the pointer into it
+func (d *Desktop) focus() *wmutil.Window {
+	windows := d.windows[d.current]
+	if len(windows) == 0 {
+		return nil
+	}
+	win := windows[0]
+	if win != d.focused {
+		var b wmutil.Batch
+		if d.focused != nil {
+			d.color(&b, d.focused, false)
+		}
+		d.color(&b, win, true)
+		b.Wait()
+		d.focused = win
+	}
+	win.Above(nil)
+	if d.Wm.PointingWindow() != win {
+		win.WarpPointer()
+	}
+	d.Wm.FocusPointerRoot()
+	return win
+}
+
+// FocusNext focuses the next window of the shown workspace, the focused one is lowered. prev focuses the previous one
+func (d *Desktop) FocusNext(prev bool) error {
+	d.lock.Lock()
+	d.init()
+	windows := d.windows[d.current]
+	if len(windows) <= 1 {
+		d.lock.Unlock()
+		return nil
+	}
+	if prev {
+		last := windows[len(windows)-1]
+		copy(windows[1:], windows[:len(windows)-1])
+		windows[0] = last
+	} else {
+		front := windows[0]
+		copy(windows, windows[1:])
+		windows[len(windows)-1] = front
+		front.Below(nil)
+	}
+	win := d.focus()
+	d.lock.Unlock()
+	if d.OnFocus != nil {
+		d.OnFocus(win)
+	}
+	return nil
+}
+
+// Show shows the workspace and hides the shown one
+func (d *Desktop) Show(name string) error {
+	d.lock.Lock()
+	d.init()
+	i := d.index(name)
+	if i < 0 {
+		d.lock.Unlock()
+		return ef("no workspace %s", name)
+	}
+	if i == d.current {
+		d.lock.Unlock()
+		return nil
+	}
+	var b wmutil.Batch
+	for _, win := range d.windows[d.current] {
+		d.hide(&b, win)
+	}
+	d.current = i
+	for _, win := range d.windows[i] {
+		d.show(&b, win)
+	}
+	err := b.Wait()
+	if e := d.arrange(); err == nil {
+		err = e
+	}
+	win := d.focus()
+	d.lock.Unlock()
+	if d.OnWorkspace != nil {
+		d.OnWorkspace(name)
+	}
+	if win != nil && d.OnFocus != nil {
+		d.OnFocus(win)
+	}
+	return err
+}
+
+// MoveFocused moves the focused window of the shown workspace to the workspace, the next window of the shown one is focused
+func (d *Desktop) MoveFocused(name string) error {
+	d.lock.Lock()
+	d.init()
+	i := d.index(name)
+	if i < 0 {
+		d.lock.Unlock()
+		return ef("no workspace %s", name)
+	}
+	windows := d.windows[d.current]
+	if i == d.current || len(windows) == 0 {
+		d.lock.Unlock()
+		return nil
+	}
+	win := windows[0]
+	d.windows[d.current] = windows[1:]
+	d.windows[i] = append([]*wmutil.Window{win}, d.windows[i]...)
+	var b wmutil.Batch
+	d.hide(&b, win)
+	if d.focused == win {
+		d.color(&b, win, false)
+		d.focused = nil
+	}
+	err := b.Wait()
+	if e := d.arrange(); err == nil {
+		err = e
+	}
+	next := d.focus()
+	d.lock.Unlock()
+	if next != nil && d.OnFocus != nil {
+		d.OnFocus(next)
+	}
+	return err
+}
+
+// SetLayout arranges the shown workspace by the named layout, nil stops arranging
+func (d *Desktop) SetLayout(l layout.Layout) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.init()
+	d.Layout = l
+	return d.arrange()
+}
+
+// RegisterDesktop registers commands acting on windows of the desktop
+func RegisterDesktop(r *Registry, d *Desktop) error {
+	for _, cmd := range []*Command{
+		{
+			Name: "focus",
+			Args: []Arg{
+				{Name: "direction", Choices: []string{"next", "prev"}},
+			},
+			Help: "focus the next or previous window of the workspace",
+			Func: func(args Args) error {
+				return d.FocusNext(args.String(0) == "prev")
+			},
+		},
+		{
+			Name: "workspace",
+			Args: []Arg{
+				{Name: "workspace"},
+			},
+			Help: "show the workspace",
+			Func: func(args Args) error {
+				return d.Show(args.String(0))
+			},
+		},
+		{
+			Name: "move-to-workspace",
+			Args: []Arg{
+				{Name: "workspace"},
+			},
+			Help: "move the focused window to the workspace",
+			Func: func(args Args) error {
+				return d.MoveFocused(args.String(0))
+			},
+		},
+		{
+			Name: "layout",
+			Args: []Arg{
+				{Name: "layout", Choices: layout.Names},
+			},
+			Help: "arrange windows of the workspace by the layout",
+			Func: func(args Args) error {
+				l, _ := layout.New(args.String(0), d.Masters, d.Ratio, d.Gap)
+				return d.SetLayout(l)
+			},
+		},
+	} {
+		if err := r.Register(cmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package command
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/fake"
+	"github.com/reusee/wmutil/layout"
+)
+
+func TestDesktop(t *testing.T) {
+	s := fake.New(1000, 800)
+	wm, err := wmutil.New(&wmutil.Config{
+		Backend: s.Backend(),
+		Logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wm.Close()
+	var focused *wmutil.Window
+	var shown string
+	d := &Desktop{
+		Wm:         wm,
+		Workspaces: []string{"1", "2"},
+		Layout:     &layout.Columns{},
+		Area:       wmutil.Rect{Width: 300, Height: 100},
+		Colors:     &BorderColors{Focused: 0xff0000, Normal: 0x333333},
+		OnFocus: func(win *wmutil.Window) {
+			focused = win
+		},
+		OnWorkspace: func(name string) {
+			shown = name
+		},
+	}
+	r := NewRegistry()
+	if err := RegisterDesktop(r, d); err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []xproto.Window
+	var windows []*wmutil.Window
+	for i := 0; i < 3; i++ {
+		id := s.CreateWindow(fake.WindowOptions{Width: 10, Height: 10})
+		s.Map(id)
+		win := <-wm.Map
+		d.Add(win)
+		ids = append(ids, id)
+		windows = append(windows, win)
+	}
+	geometry := func(i int) wmutil.Rect {
+		g, _ := s.Geometry(ids[i])
+		return g
+	}
+	// the last added is focused and first
+	if geometry(2).X != 0 || geometry(1).X != 100 || geometry(0).X != 200 {
+		t.Fatalf("got %v %v %v", geometry(0), geometry(1), geometry(2))
+	}
+
+	if err := r.Run("focus next"); err != nil {
+		t.Fatal(err)
+	}
+	if focused != windows[1] {
+		t.Fatalf("got %v", focused)
+	}
+	if stack := s.Stack(); stack[len(stack)-1] != ids[1] {
+		t.Fatalf("got %v", stack)
+	}
+	if s.BorderPixel(ids[1]) != 0xff0000 || s.BorderPixel(ids[2]) != 0x333333 {
+		t.Fatalf("got %x %x", s.BorderPixel(ids[1]), s.BorderPixel(ids[2]))
+	}
+
+	if err := r.Run("move-to-workspace 2"); err != nil {
+		t.Fatal(err)
+	}
+	if d.WindowWorkspace(windows[1]) != "2" || geometry(1).X >= 0 {
+		t.Fatalf("got %v", geometry(1))
+	}
+	if geometry(0).Width != 150 || geometry(2).Width != 150 {
+		t.Fatalf("got %v %v", geometry(0), geometry(2))
+	}
+	// the next window of the shown workspace is focused
+	if focused != windows[0] || s.BorderPixel(ids[0]) != 0xff0000 || s.BorderPixel(ids[1]) != 0x333333 {
+		t.Fatalf("got %v %x %x", focused, s.BorderPixel(ids[0]), s.BorderPixel(ids[1]))
+	}
+
+	if err := r.Run("workspace 2"); err != nil {
+		t.Fatal(err)
+	}
+	if shown != "2" || d.Current() != "2" || focused != windows[1] {
+		t.Fatalf("got %s %v", shown, focused)
+	}
+	if g := geometry(1); g != (wmutil.Rect{Width: 300, Height: 100}) {
+		t.Fatalf("got %v", g)
+	}
+	if geometry(0).X >= 0 || geometry(2).X >= 0 {
+		t.Fatalf("got %v %v", geometry(0), geometry(2))
+	}
+
+	if err := r.Run("workspace 3"); err == nil {
+		t.Fatal("expecting error")
+	}
+	if err := r.Run("layout spiral"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := d.Layout.(*layout.Spiral); !ok {
+		t.Fatalf("got %T", d.Layout)
+	}
+
+	d.Remove(windows[1])
+	if len(d.Windows("2")) != 0 {
+		t.Fatal("not removed")
+	}
+}
+
+func TestDesktopRules(t *testing.T) {
+	s := fake.New(1000, 800)
+	no := false
+	yes := true
+	wm, err := wmutil.New(&wmutil.Config{
+		Backend: s.Backend(),
+		Logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Rules: wmutil.Rules{
+			{Match: wmutil.RuleMatch{Class: &wmutil.Matcher{Exact: "Gimp"}}, Actions: wmutil.RuleActions{Workspace: "2"}},
+			{Match: wmutil.RuleMatch{Class: &wmutil.Matcher{Exact: "Float"}}, Actions: wmutil.RuleActions{Floating: &yes}},
+			{Match: wmutil.RuleMatch{Class: &wmutil.Matcher{Exact: "Quiet"}}, Actions: wmutil.RuleActions{Focus: &no}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wm.Close()
+	var focused *wmutil.Window
+	d := &Desktop{
+		Wm:         wm,
+		Workspaces: []string{"1", "2"},
+		Layout:     &layout.Columns{},
+		Area:       wmutil.Rect{Width: 300, Height: 100},
+		OnFocus: func(win *wmutil.Window) {
+			focused = win
+		},
+	}
+	add := func(class string) (xproto.Window, *wmutil.Window) {
+		id := s.CreateWindow(fake.WindowOptions{
+			Width:      10,
+			Height:     10,
+			Properties: []fake.NamedProperty{fake.Class(class, class)},
+		})
+		s.Map(id)
+		win := <-wm.Map
+		d.Add(win)
+		return id, win
+	}
+
+	termId, term := add("Term")
+	if focused != term {
+		t.Fatalf("got %v", focused)
+	}
+	gimpId, gimp := add("Gimp")
+	if d.WindowWorkspace(gimp) != "2" || focused != term {
+		t.Fatalf("got %s %v", d.WindowWorkspace(gimp), focused)
+	}
+	if g, _ := s.Geometry(gimpId); g.X >= 0 {
+		t.Fatalf("got %v", g)
+	}
+
+	// not arranged
+	floatId, _ := add("Float")
+	if g, _ := s.Geometry(floatId); g.Width != 10 {
+		t.Fatalf("got %v", g)
+	}
+	if g, _ := s.Geometry(termId); g.Width != 300 {
+		t.Fatalf("got %v", g)
+	}
+
+	_, quiet := add("Quiet")
+	if windows := d.Windows("1"); focused == quiet || windows[0] == quiet {
+		t.Fatalf("got %v", windows)
+	}
+}
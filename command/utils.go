@@ -0,0 +1,7 @@
+package command
+
+import "fmt"
+
+var (
+	ef = fmt.Errorf
+)
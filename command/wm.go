@@ -0,0 +1,46 @@
+package command
+
+import (
+	"os/exec"
+
+	"github.com/reusee/wmutil"
+)
+
+// RegisterWm registers commands that need nothing but the Wm
+func RegisterWm(r *Registry, wm *wmutil.Wm) error {
+	for _, cmd := range []*Command{
+		{
+			Name: "kill",
+			Help: "destroy the window under the pointer",
+			Func: func(Args) error {
+				win := wm.PointingWindow()
+				if win == nil {
+					return ef("no window to kill")
+				}
+				win.Destroy()
+				return nil
+			},
+		},
+		{
+			Name: "spawn",
+			Args: []Arg{
+				{Name: "command", Type: Rest},
+			},
+			Help: "start a program without waiting for it",
+			Func: func(args Args) error {
+				argv := args.Rest(0)
+				cmd := exec.Command(argv[0], argv[1:]...)
+				if err := cmd.Start(); err != nil {
+					return err
+				}
+				go cmd.Wait()
+				return nil
+			},
+		},
+	} {
+		if err := r.Register(cmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,517 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/command"
+	"github.com/reusee/wmutil/layout"
+)
+
+// File is a declarative configuration in JSON
+//
+//	{
+//	  "bindings": [
+//	    {"keys": "Mod4+Return", "spawn": ["xterm"]},
+//	    {"keys": "Mod4+w h", "command": "focus left"},
+//	    {"keys": "Mod1+Tab", "trigger": "release", "command": "commit-cycle"},
+//	    {"keys": "F12", "trigger": "hold", "hold": "500ms", "command": "talk"}
+//	  ],
+//	  "buttons": [{"button": "Mod4+Button1", "command": "move"}],
+//	  "rules": [{"match": {"class": "Gimp"}, "actions": {"floating": true}}],
+//	  "workspaces": ["main", "web"],
+//	  "layout": {"name": "tile", "masters": 1, "ratio": 0.6, "gap": 4},
+//	  "colors": {"focused": "#4c7899", "normal": "#333333"}
+//	}
+type File struct {
+	Bindings   []*Binding
+	Buttons    []*Button
+	Rules      wmutil.Rules
+	Workspaces []string
+	Layout     Layout
+	// border colors of focused and normal windows
+	Colors map[string]Color
+
+	bindings map[string]*Binding
+	buttons  map[wmutil.ButtonStroke]*Button
+}
+
+type Binding struct {
+	// space separated strokes like Mod4+Shift+Return, more than one makes a sequence
+	Keys string `json:"keys"`
+	// press, release or hold
+	Trigger string `json:"trigger,omitempty"`
+	// duration for hold trigger
+	Hold    string   `json:"hold,omitempty"`
+	Command string   `json:"command,omitempty"`
+	Spawn   []string `json:"spawn,omitempty"`
+
+	Sequence wmutil.Sequence `json:"-"`
+	Line     int             `json:"-"`
+	trigger  wmutil.Trigger
+	hold     time.Duration
+}
+
+type Button struct {
+	// like Mod4+Button1
+	Button  string   `json:"button"`
+	Command string   `json:"command,omitempty"`
+	Spawn   []string `json:"spawn,omitempty"`
+
+	Stroke wmutil.ButtonStroke `json:"-"`
+	Line   int                 `json:"-"`
+}
+
+type Layout struct {
+	Name    string  `json:"name"`
+	Masters int     `json:"masters"`
+	Ratio   float64 `json:"ratio"`
+	Gap     int     `json:"gap"`
+}
+
+// New returns the configured layout, tile if not specified
+func (l Layout) New() layout.Layout {
+	name := l.Name
+	if name == "" {
+		name = "tile"
+	}
+	ret, _ := layout.New(name, l.Masters, l.Ratio, l.Gap)
+	return ret
+}
+
+// Color is 0xRRGGBB, written as #rrggbb
+type Color uint32
+
+func (c *Color) UnmarshalText(text []byte) error {
+	s := string(text)
+	if len(s) != 7 || s[0] != '#' {
+		return fmt.Errorf("bad color %q, want #rrggbb", s)
+	}
+	v, err := strconv.ParseUint(s[1:], 16, 32)
+	if err != nil {
+		return fmt.Errorf("bad color %q, want #rrggbb", s)
+	}
+	*c = Color(v)
+	return nil
+}
+
+func (c Color) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("#%06x", uint32(c))), nil
+}
+
+type Error struct {
+	Line int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+type Errors []*Error
+
+func (e Errors) Error() string {
+	var lines []string
+	for _, err := range e {
+		lines = append(lines, err.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return f, nil
+}
+
+// Parse decodes and validates the config, errors are Errors with line numbers
+func Parse(data []byte) (*File, error) {
+	f := &File{
+		bindings: make(map[string]*Binding),
+		buttons:  make(map[wmutil.ButtonStroke]*Button),
+	}
+	var errs Errors
+	lineAt := func(offset int64) int {
+		// skip separators to the start of the value
+		for offset < int64(len(data)) && strings.IndexByte(" \t\r\n,:", data[offset]) >= 0 {
+			offset++
+		}
+		return bytes.Count(data[:offset], []byte("\n")) + 1
+	}
+	fail := func(line int, format string, args ...interface{}) {
+		errs = append(errs, &Error{
+			Line: line,
+			Err:  fmt.Errorf(format, args...),
+		})
+	}
+	// syntax error offsets are in the stream, other errors are reported at line
+	decodeError := func(dec *json.Decoder, err error, line int) error {
+		if e, ok := err.(*json.SyntaxError); ok {
+			line = lineAt(e.Offset)
+		}
+		return Errors{{
+			Line: line,
+			Err:  err,
+		}}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil {
+		return nil, decodeError(dec, err, 1)
+	} else if tok != json.Delim('{') {
+		return nil, Errors{{Line: 1, Err: fmt.Errorf("config must be an object")}}
+	}
+	// decodes arrays element by element to keep their lines
+	decodeArray := func(fn func(line int) (interface{}, func())) error {
+		if tok, err := dec.Token(); err != nil {
+			return decodeError(dec, err, lineAt(dec.InputOffset()))
+		} else if tok != json.Delim('[') {
+			return Errors{{Line: lineAt(dec.InputOffset()), Err: fmt.Errorf("expecting array")}}
+		}
+		for dec.More() {
+			line := lineAt(dec.InputOffset())
+			target, validate := fn(line)
+			if err := dec.Decode(target); err != nil {
+				return decodeError(dec, err, line)
+			}
+			validate()
+		}
+		_, err := dec.Token()
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, decodeError(dec, err, lineAt(dec.InputOffset()))
+		}
+		key, _ := tok.(string)
+		line := lineAt(dec.InputOffset())
+		switch key {
+
+		case "bindings":
+			err = decodeArray(func(line int) (interface{}, func()) {
+				b := &Binding{
+					Line: line,
+				}
+				return b, func() {
+					if err := f.addBinding(b); err != nil {
+						fail(line, "binding %q: %v", b.Keys, err)
+					}
+				}
+			})
+
+		case "buttons":
+			err = decodeArray(func(line int) (interface{}, func()) {
+				b := &Button{
+					Line: line,
+				}
+				return b, func() {
+					if err := f.addButton(b); err != nil {
+						fail(line, "button %q: %v", b.Button, err)
+					}
+				}
+			})
+
+		case "rules":
+			err = decodeArray(func(line int) (interface{}, func()) {
+				var rule wmutil.Rule
+				return &rule, func() {
+					if err := (wmutil.Rules{rule}).Validate(); err != nil {
+						fail(line, "%v", err)
+					}
+					f.Rules = append(f.Rules, rule)
+				}
+			})
+
+		case "workspaces":
+			seen := make(map[string]bool)
+			err = decodeArray(func(line int) (interface{}, func()) {
+				var name string
+				return &name, func() {
+					if name == "" || seen[name] {
+						fail(line, "empty or duplicated workspace name %q", name)
+					}
+					seen[name] = true
+					f.Workspaces = append(f.Workspaces, name)
+				}
+			})
+
+		case "layout":
+			if err = dec.Decode(&f.Layout); err == nil {
+				if _, ok := layout.New(f.Layout.Name, 0, 0, 0); f.Layout.Name != "" && !ok {
+					fail(line, "unknown layout %q, want one of %s", f.Layout.Name, strings.Join(layout.Names, ", "))
+				}
+				if f.Layout.Ratio < 0 || f.Layout.Ratio >= 1 {
+					fail(line, "layout ratio %v not in [0, 1)", f.Layout.Ratio)
+				}
+				if f.Layout.Masters < 0 || f.Layout.Gap < 0 {
+					fail(line, "negative layout masters or gap")
+				}
+			}
+
+		case "colors":
+			// decoded entry by entry to keep their lines
+			f.Colors = make(map[string]Color)
+			var tok json.Token
+			if tok, err = dec.Token(); err == nil && tok != json.Delim('{') {
+				return nil, Errors{{Line: line, Err: fmt.Errorf("expecting object")}}
+			}
+			for err == nil && dec.More() {
+				if tok, err = dec.Token(); err != nil {
+					break
+				}
+				name, _ := tok.(string)
+				line := lineAt(dec.InputOffset())
+				if name != "focused" && name != "normal" {
+					fail(line, "unknown color %q, want focused or normal", name)
+				}
+				var color Color
+				if e := dec.Decode(&color); e != nil {
+					if _, ok := e.(*json.SyntaxError); ok {
+						err = e
+						break
+					}
+					fail(line, "color %q: %v", name, e)
+				}
+				f.Colors[name] = color
+			}
+			if err == nil {
+				_, err = dec.Token()
+			}
+
+		default:
+			return nil, Errors{{Line: line, Err: fmt.Errorf("unknown key %q", key)}}
+		}
+		if err != nil {
+			if _, ok := err.(Errors); ok {
+				return nil, err
+			}
+			return nil, decodeError(dec, err, line)
+		}
+	}
+
+	// the sequencer takes strokes starting a sequence, bindings of them are never triggered
+	for _, b := range f.Bindings {
+		for _, seq := range f.Bindings {
+			if isPrefix(b.Sequence, seq.Sequence) {
+				fail(b.Line, "binding %q is a prefix of sequence %q at line %d", b.Keys, seq.Keys, seq.Line)
+				break
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return f, nil
+}
+
+// isPrefix reports whether a is a proper prefix of b
+func isPrefix(a, b wmutil.Sequence) bool {
+	if len(a) >= len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func bindingKey(seq wmutil.Sequence, trigger wmutil.Trigger) string {
+	return fmt.Sprintf("%s/%d", seq, trigger)
+}
+
+func (f *File) addBinding(b *Binding) (err error) {
+	if b.Sequence, err = wmutil.ParseSequence(b.Keys); err != nil {
+		return err
+	}
+	switch b.Trigger {
+	case "", "press":
+		b.trigger = wmutil.TriggerPress
+	case "release":
+		b.trigger = wmutil.TriggerRelease
+	case "hold":
+		b.trigger = wmutil.TriggerHold
+		if b.hold, err = time.ParseDuration(b.Hold); err != nil || b.hold <= 0 {
+			return fmt.Errorf("bad hold duration %q", b.Hold)
+		}
+	default:
+		return fmt.Errorf("unknown trigger %q, want press, release or hold", b.Trigger)
+	}
+	if len(b.Sequence) > 1 && b.trigger != wmutil.TriggerPress {
+		return fmt.Errorf("sequences only support press trigger")
+	}
+	if (b.Command == "") == (len(b.Spawn) == 0) {
+		return fmt.Errorf("want one of command or spawn")
+	}
+	key := bindingKey(b.Sequence, b.trigger)
+	if prev, ok := f.bindings[key]; ok {
+		return fmt.Errorf("duplicated with line %d", prev.Line)
+	}
+	f.bindings[key] = b
+	f.Bindings = append(f.Bindings, b)
+	return nil
+}
+
+func (f *File) addButton(b *Button) (err error) {
+	if b.Stroke, err = wmutil.ParseButtonStroke(b.Button); err != nil {
+		return err
+	}
+	if (b.Command == "") == (len(b.Spawn) == 0) {
+		return fmt.Errorf("want one of command or spawn")
+	}
+	if prev, ok := f.buttons[b.Stroke]; ok {
+		return fmt.Errorf("duplicated with line %d", prev.Line)
+	}
+	f.buttons[b.Stroke] = b
+	f.Buttons = append(f.Buttons, b)
+	return nil
+}
+
+// WmConfig returns a copy of base with strokes, sequences, bindings, buttons and rules of the file added
+func (f *File) WmConfig(base *wmutil.Config) *wmutil.Config {
+	config := new(wmutil.Config)
+	if base != nil {
+		*config = *base
+	}
+	config.Strokes = append([]wmutil.Stroke(nil), config.Strokes...)
+	config.Sequences = append([]wmutil.Sequence(nil), config.Sequences...)
+	config.Bindings = append([]wmutil.Binding(nil), config.Bindings...)
+	config.Buttons = append([]wmutil.ButtonStroke(nil), config.Buttons...)
+	config.Rules = append(append(wmutil.Rules(nil), config.Rules...), f.Rules...)
+	for _, b := range f.Bindings {
+		switch {
+		case len(b.Sequence) > 1:
+			config.Sequences = append(config.Sequences, b.Sequence)
+		// the binder claims presses of its strokes, so a press with other triggers goes with them
+		case b.trigger == wmutil.TriggerPress && f.bindings[bindingKey(b.Sequence, wmutil.TriggerRelease)] == nil &&
+			f.bindings[bindingKey(b.Sequence, wmutil.TriggerHold)] == nil:
+			config.Strokes = append(config.Strokes, b.Sequence[0])
+		default:
+			config.Bindings = append(config.Bindings, wmutil.Binding{
+				Stroke:  b.Sequence[0],
+				Trigger: b.trigger,
+				Hold:    b.hold,
+			})
+		}
+	}
+	for _, b := range f.Buttons {
+		config.Buttons = append(config.Buttons, b.Stroke)
+	}
+	return config
+}
+
+// Apply regrabs keys and buttons of the wm without restarting it
+func (f *File) Apply(wm *wmutil.Wm, base *wmutil.Config) *wmutil.GrabReport {
+	return wm.Reconfigure(f.WmConfig(base))
+}
+
+// Reload loads the file and applies it, the wm is not changed if the file is invalid
+func Reload(wm *wmutil.Wm, path string, base *wmutil.Config) (*File, *wmutil.GrabReport, error) {
+	f, err := Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, f.Apply(wm, base), nil
+}
+
+// BorderColors returns the colors for command.Desktop, nil if not configured. missing ones are the colors in the example of File
+func (f *File) BorderColors() *command.BorderColors {
+	if len(f.Colors) == 0 {
+		return nil
+	}
+	colors := &command.BorderColors{
+		Focused: 0x4c7899,
+		Normal:  0x333333,
+	}
+	if c, ok := f.Colors["focused"]; ok {
+		colors.Focused = uint32(c)
+	}
+	if c, ok := f.Colors["normal"]; ok {
+		colors.Normal = uint32(c)
+	}
+	return colors
+}
+
+// StrokeBinding returns the binding for strokes delivered on Wm.Stroke
+func (f *File) StrokeBinding(stroke wmutil.Stroke) *Binding {
+	return f.bindings[bindingKey(wmutil.Sequence{stroke}, wmutil.TriggerPress)]
+}
+
+// SequenceBinding returns the binding for matched sequences delivered on Wm.Sequence
+func (f *File) SequenceBinding(seq wmutil.Sequence) *Binding {
+	return f.bindings[bindingKey(seq, wmutil.TriggerPress)]
+}
+
+// TriggerBinding returns the binding for events delivered on Wm.Binding
+func (f *File) TriggerBinding(b wmutil.Binding) *Binding {
+	return f.bindings[bindingKey(wmutil.Sequence{b.Stroke}, b.Trigger)]
+}
+
+// ButtonBinding returns the binding for events delivered on Wm.Button
+func (f *File) ButtonBinding(b wmutil.ButtonStroke) *Button {
+	return f.buttons[b]
+}
+
+// CheckCommands reports commands of bindings and buttons not parsable by the registry
+func (f *File) CheckCommands(r *command.Registry) error {
+	var errs Errors
+	check := func(line int, cmd string) {
+		if cmd == "" {
+			return
+		}
+		if _, err := r.Parse(cmd); err != nil {
+			errs = append(errs, &Error{
+				Line: line,
+				Err:  err,
+			})
+		}
+	}
+	for _, b := range f.Bindings {
+		check(b.Line, b.Command)
+	}
+	for _, b := range f.Buttons {
+		check(b.Line, b.Command)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Run runs the command by the registry, or spawns the program
+func (b *Binding) Run(r *command.Registry) error {
+	return run(r, b.Command, b.Spawn)
+}
+
+func (b *Button) Run(r *command.Registry) error {
+	return run(r, b.Command, b.Spawn)
+}
+
+func run(r *command.Registry, cmd string, spawn []string) error {
+	if cmd != "" {
+		return r.Run(cmd)
+	}
+	c := exec.Command(spawn[0], spawn[1:]...)
+	if err := c.Start(); err != nil {
+		return err
+	}
+	go c.Wait()
+	return nil
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/reusee/wmutil/command"
+	"github.com/reusee/wmutil/layout"
+)
+
+func TestParse(t *testing.T) {
+	f, err := Parse([]byte(`{
+  "bindings": [
+    {"keys": "Mod4+Return", "spawn": ["xterm"]},
+    {"keys": "Mod4+w h", "command": "focus left"},
+    {"keys": "Mod1+Tab", "trigger": "release", "command": "commit-cycle"}
+  ],
+  "buttons": [{"button": "Mod4+Button1", "command": "move"}],
+  "rules": [{"match": {"class": "Gimp"}, "actions": {"floating": true}}],
+  "workspaces": ["main", "web"],
+  "layout": {"name": "grid", "gap": 4},
+  "colors": {"focused": "#4c7899"}
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Bindings) != 3 || f.Bindings[1].Line != 4 {
+		t.Fatalf("bad bindings %+v", f.Bindings)
+	}
+	if f.Colors["focused"] != 0x4c7899 {
+		t.Fatalf("bad color %x", f.Colors["focused"])
+	}
+	if c := f.BorderColors(); c == nil || c.Focused != 0x4c7899 || c.Normal != 0x333333 {
+		t.Fatalf("bad border colors %+v", c)
+	}
+	config := f.WmConfig(nil)
+	if len(config.Strokes) != 1 || len(config.Sequences) != 1 || len(config.Bindings) != 1 ||
+		len(config.Buttons) != 1 || len(config.Rules) != 1 {
+		t.Fatalf("bad config %+v", config)
+	}
+	if b := f.SequenceBinding(config.Sequences[0]); b == nil || b.Command != "focus left" {
+		t.Fatalf("bad sequence binding %+v", b)
+	}
+	if b := f.TriggerBinding(config.Bindings[0]); b == nil || b.Command != "commit-cycle" {
+		t.Fatalf("bad trigger binding %+v", b)
+	}
+	if g, ok := f.Layout.New().(*layout.Grid); !ok || g.Gap != 4 {
+		t.Fatal("bad layout")
+	}
+
+	// press and hold of a stroke both go to the binder
+	f, err = Parse([]byte(`{"bindings": [
+  {"keys": "F12", "command": "mute"},
+  {"keys": "F12", "trigger": "hold", "hold": "500ms", "command": "talk"}
+]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config = f.WmConfig(nil)
+	if len(config.Strokes) != 0 || len(config.Bindings) != 2 {
+		t.Fatalf("bad config %+v", config)
+	}
+	if b := f.TriggerBinding(config.Bindings[0]); b == nil || b.Command != "mute" {
+		t.Fatalf("bad trigger binding %+v", b)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		src  string
+		line int
+	}{
+		{"{\n  \"bindings\": [\n    {\"keys\": \"Mod4+Nope\", \"command\": \"x\"}\n  ]\n}", 3},
+		{"{\n  \"bindings\": [\n    {\"keys\": \"a\", \"command\": \"x\"},\n    {\"keys\": \"a\", \"command\": \"y\"}\n  ]\n}", 4},
+		{"{\n  \"layout\": {\"name\": \"foo\"}\n}", 2},
+		{"{\n  \"colors\": {\n    \"focused\": \"red\"\n  }\n}", 3},
+		{"{\n  \"colors\": {\n    \"urgent\": \"#ff0000\"\n  }\n}", 3},
+		{"{\n\n  \"foo\": 1\n}", 3},
+		{"{\n  \"workspaces\": [\n    1\n  ]\n}", 3},
+		{"{\n  \"rules\": [\n    {},\n    {\"match\": {\"class\": {\"regex\": \"(\"}}}\n  ]\n}", 4},
+		{"{\n  \"buttons\": [\n  ]\n  \"x\"\n}", 4},
+		{"{\n  \"bindings\": [\n    {\"keys\": \"a b\", \"command\": \"x\"},\n    {\"keys\": \"a\", \"command\": \"y\"}\n  ]\n}", 4},
+	}
+	for _, c := range cases {
+		_, err := Parse([]byte(c.src))
+		var errs Errors
+		if !errors.As(err, &errs) || len(errs) == 0 {
+			t.Fatalf("expecting errors for %s, got %v", c.src, err)
+		}
+		if errs[0].Line != c.line {
+			t.Fatalf("expecting line %d, got %v", c.line, err)
+		}
+	}
+}
+
+func TestCheckCommands(t *testing.T) {
+	f, err := Parse([]byte("{\n  \"bindings\": [\n    {\"keys\": \"a\", \"command\": \"kill\"},\n    {\"keys\": \"b\", \"command\": \"nope\"}\n  ]\n}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := command.NewRegistry()
+	r.MustRegister(&command.Command{
+		Name: "kill",
+		Func: func(command.Args) error { return nil },
+	})
+	var errs Errors
+	if err := f.CheckCommands(r); !errors.As(err, &errs) || len(errs) != 1 || errs[0].Line != 4 {
+		t.Fatalf("got %v", err)
+	}
+}
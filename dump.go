@@ -0,0 +1,223 @@
+package wmutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// State is a snapshot of what the Wm tracks, for debugging
+type State struct {
+	Windows []WindowState `json:"windows"`
+	// interned atoms by name
+	Atoms    map[string]uint32 `json:"atoms"`
+	Keyboard KeyboardState     `json:"keyboard"`
+	Grabs    GrabState         `json:"grabs"`
+}
+
+type WindowState struct {
+	Id           string   `json:"id"`
+	Parent       string   `json:"parent"`
+	X            int      `json:"x"`
+	Y            int      `json:"y"`
+	Width        int      `json:"width"`
+	Height       int      `json:"height"`
+	Border       int      `json:"border"`
+	Mapped       bool     `json:"mapped"`
+	Name         string   `json:"name"`
+	Icon         string   `json:"icon"`
+	Instance     string   `json:"instance"`
+	Class        string   `json:"class"`
+	Role         string   `json:"role,omitempty"`
+	TransientFor string   `json:"transient_for,omitempty"`
+	Protocols    []string `json:"protocols"`
+	Types        []string `json:"types"`
+	// _NET_WM_STATE, read from the server
+	States      []string    `json:"states"`
+	NormalHints NormalHints `json:"normal_hints"`
+	Strut       Strut       `json:"strut"`
+	Rule        RuleActions `json:"rule"`
+}
+
+type KeyboardState struct {
+	MinKeycode int `json:"min_keycode"`
+	MaxKeycode int `json:"max_keycode"`
+	// keycodes with keysyms
+	Keycodes int `json:"keycodes"`
+	// keysyms of modifier keys, by modifier like Mod4
+	Modifiers   map[string][]string `json:"modifiers"`
+	NumLockMask uint16              `json:"numlock_mask"`
+}
+
+type GrabState struct {
+	Strokes         []string `json:"strokes"`
+	Conflicts       []string `json:"conflicts"`
+	Failed          []string `json:"failed"`
+	Unmapped        []string `json:"unmapped"`
+	Buttons         []string `json:"buttons"`
+	ButtonConflicts []string `json:"button_conflicts"`
+}
+
+func hexId(id xproto.Window) string {
+	return fmt.Sprintf("0x%x", id)
+}
+
+func (w *Wm) atomNames(atoms []xproto.Atom) []string {
+	names := []string{}
+	for _, atom := range atoms {
+		names = append(names, w.AtomName(atom))
+	}
+	return names
+}
+
+func stringers[T fmt.Stringer](values []T) []string {
+	ret := []string{}
+	for _, v := range values {
+		ret = append(ret, v.String())
+	}
+	return ret
+}
+
+// State returns a snapshot of tracked windows, atoms, keyboard and grabs. safe to call from any goroutine
+func (w *Wm) State() *State {
+	state := &State{
+		Windows: []WindowState{},
+		Atoms:   make(map[string]uint32),
+	}
+
+	windows := w.AllWindows()
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Id < windows[j].Id
+	})
+	netWmState := w.Atom("_NET_WM_STATE")
+	for _, win := range windows {
+		var s WindowState
+		var protocols, types []xproto.Atom
+		var transientFor xproto.Window
+		win.ReadLock(func() {
+			s = WindowState{
+				Id:          hexId(win.Id),
+				Parent:      hexId(win.Parent),
+				X:           win.X,
+				Y:           win.Y,
+				Width:       win.Width,
+				Height:      win.Height,
+				Border:      win.Border,
+				Mapped:      win.Mapped,
+				Name:        win.Name,
+				Icon:        win.Icon,
+				Instance:    win.Instance,
+				Class:       win.Class,
+				Role:        win.Role,
+				NormalHints: win.NormalHints,
+				Strut:       win.Strut,
+				Rule:        win.Rule,
+			}
+			protocols, types, transientFor = win.Protocols, win.Types, win.TransientFor
+		})
+		if transientFor != 0 {
+			s.TransientFor = hexId(transientFor)
+		}
+		s.Protocols = w.atomNames(protocols)
+		s.Types = w.atomNames(types)
+		s.States = w.atomNames(win.GetAtomsProperty(netWmState))
+		state.Windows = append(state.Windows, s)
+	}
+
+	w.atomsLock.Lock()
+	for name, atom := range w.stringToAtom {
+		state.Atoms[name] = uint32(atom)
+	}
+	w.atomsLock.Unlock()
+
+	k := &state.Keyboard
+	k.MinKeycode, k.MaxKeycode = int(w.Setup.MinKeycode), int(w.Setup.MaxKeycode)
+	for _, syms := range w.CodeToSyms {
+		if len(syms) > 0 {
+			k.Keycodes++
+		}
+	}
+	k.Modifiers = make(map[string][]string)
+	k.NumLockMask = w.numlockModMask
+	codes := make([]int, 0, len(w.modifierMasks))
+	for code := range w.modifierMasks {
+		codes = append(codes, int(code))
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		mask := w.modifierMasks[xproto.Keycode(code)]
+		for i := 0; i < 8; i++ {
+			if mask&(1<<i) == 0 {
+				continue
+			}
+			name := modifiersString(1 << i)
+			sym := fmt.Sprintf("keycode %d", code)
+			if syms := w.CodeToSyms[code]; len(syms) > 0 {
+				sym = syms[0].String()
+			}
+			k.Modifiers[name] = append(k.Modifiers[name], sym)
+		}
+	}
+
+	w.configLock.RLock()
+	report := w.config.report
+	state.Grabs.Buttons = []string{}
+	for stroke := range w.config.buttons {
+		state.Grabs.Buttons = append(state.Grabs.Buttons, stroke.String())
+	}
+	w.configLock.RUnlock()
+	sort.Strings(state.Grabs.Buttons)
+	if report != nil {
+		grabs := &state.Grabs
+		grabs.Strokes = stringers(report.Grabbed)
+		grabs.Conflicts = stringers(report.Conflicts)
+		grabs.Failed = stringers(report.Failed)
+		grabs.Unmapped = stringers(report.Unmapped)
+		grabs.ButtonConflicts = stringers(report.ButtonConflicts)
+	}
+
+	return state
+}
+
+// Dump returns State as indented JSON
+func (w *Wm) Dump() ([]byte, error) {
+	return json.MarshalIndent(w.State(), "", "  ")
+}
+
+// DumpFile writes Dump to the file
+func (w *Wm) DumpFile(path string) error {
+	data, err := w.Dump()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// DumpOnSignal writes Dump to the file each time one of the signals is received, until stop is called
+func (w *Wm) DumpOnSignal(path string, sigs ...os.Signal) (stop func()) {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, sigs...)
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-c:
+				if err := w.DumpFile(path); err != nil {
+					w.logger.Error("dump state", "path", path, errAttr(err))
+				} else {
+					w.logger.Info("state dumped", "path", path)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return func() {
+		signal.Stop(c)
+		close(done)
+	}
+}
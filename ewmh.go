@@ -13,7 +13,7 @@ func (w *Wm) setSupported() error {
 	for i, atom := range atoms {
 		xgb.Put32(buf[i*4:], uint32(atom))
 	}
-	err := xproto.ChangePropertyChecked(w.Conn, xproto.PropModeReplace, w.DefaultRootId,
+	err := w.Backend.ChangeProperty(xproto.PropModeReplace, w.DefaultRootId,
 		w.Atom("_NET_SUPPORTED"), xproto.AtomAtom, 32, uint32(len(atoms)), buf).Check()
 	return err
 }
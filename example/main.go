@@ -1,120 +1,275 @@
 package main
 
 import (
-	"container/list"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
+	"log/slog"
 	"os"
 	"os/exec"
 	"os/user"
 	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
 
-	"github.com/BurntSushi/xgb/xproto"
 	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/command"
+	"github.com/reusee/wmutil/config"
+	"github.com/reusee/wmutil/hook"
+	"github.com/reusee/wmutil/ipc"
 )
 
 var (
 	pt = fmt.Printf
 )
 
+// defaultConfig is used if there is no config file
+const defaultConfig = `{
+  "bindings": [
+    {"keys": "Mod4+q", "command": "quit"},
+    {"keys": "Mod4+r", "command": "reload"},
+    {"keys": "Mod4+Return", "spawn": ["xfce4-terminal"]},
+    {"keys": "Mod4+o", "spawn": ["dmenu_run"]},
+    {"keys": "Mod4+z", "command": "kill"},
+    {"keys": "Mod4+j", "command": "focus next"},
+    {"keys": "Mod4+k", "command": "focus prev"},
+    {"keys": "Mod4+1", "command": "workspace 1"},
+    {"keys": "Mod4+2", "command": "workspace 2"},
+    {"keys": "Mod4+3", "command": "workspace 3"},
+    {"keys": "Mod4+Shift+1", "command": "move-to-workspace 1"},
+    {"keys": "Mod4+Shift+2", "command": "move-to-workspace 2"},
+    {"keys": "Mod4+Shift+3", "command": "move-to-workspace 3"}
+  ],
+  "rules": [{"match": {"transient": true}, "actions": {"floating": true}}],
+  "workspaces": ["1", "2", "3"],
+  "layout": {"name": "tile", "masters": 1, "ratio": 0.6},
+  "colors": {"focused": "#4c7899", "normal": "#333333"}
+}`
+
 func main() {
 	var wm *wmutil.Wm
-	windows := list.New()
 	kill := make(chan struct{})
-	mod := uint16(xproto.ModMask4)
-	display := os.Getenv("DISPLAY")
-	if display == ":2" {
-		mod = uint16(xproto.ModMaskControl)
-	}
-	keyBindings := map[wmutil.Stroke]func(){
-		wmutil.Stroke{mod, wmutil.Key_q}: func() {
-			close(kill)
-		},
-		wmutil.Stroke{mod, wmutil.Key_Return}: func() {
-			exec.Command("xfce4-terminal").Start()
-		},
-		wmutil.Stroke{mod, wmutil.Key_o}: func() {
-			exec.Command("dmenu_run").Start()
+	var killOnce sync.Once
+
+	user, err := user.Current()
+	if err != nil {
+		log.Fatalf("get current user %v", err)
+	}
+	logWriter, err := os.Create(filepath.Join(user.HomeDir, ".wmutils-example.log"))
+	if err != nil {
+		log.Fatalf("open log file %v", err)
+	}
+
+	// event tracing with WMUTIL_DEBUG=1
+	level := slog.LevelInfo
+	if os.Getenv("WMUTIL_DEBUG") != "" {
+		level = slog.LevelDebug
+	}
+	logger := slog.New(slog.NewTextHandler(logWriter, &slog.HandlerOptions{
+		Level: level,
+	}))
+
+	// ~/.config/wmutil-example/config.json, or the default one
+	var configPath string
+	if dir, err := os.UserConfigDir(); err == nil {
+		configPath = filepath.Join(dir, "wmutil-example", "config.json")
+	}
+	file, err := config.Load(configPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		configPath = ""
+		file, err = config.Parse([]byte(defaultConfig))
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	base := &wmutil.Config{
+		Logger:          logger,
+		CoalesceLatency: time.Millisecond * 10,
+	}
+	wm, err = wmutil.New(file.WmConfig(base))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer wm.Close()
+	printReport := func(report *wmutil.GrabReport) {
+		for _, stroke := range report.Conflicts {
+			pt("stroke %v is grabbed by another client\n", stroke)
+		}
+		for _, sym := range report.Unmapped {
+			pt("keysym %v is not on the keyboard\n", sym)
+		}
+	}
+	printReport(wm.GrabReport)
+
+	commands := command.NewRegistry()
+	commands.MustRegister(&command.Command{
+		Name: "quit",
+		Help: "exit the window manager",
+		Func: func(command.Args) error {
+			// quit may run again before the loop sees kill
+			killOnce.Do(func() {
+				close(kill)
+			})
+			return nil
 		},
-		wmutil.Stroke{mod, wmutil.Key_z}: func() {
-			win := wm.PointingWindow()
-			if win != nil {
-				win.Destroy()
+	})
+	// commands run in the loop below, so file is not changed concurrently
+	commands.MustRegister(&command.Command{
+		Name: "reload",
+		Help: "reload bindings and rules from the config file",
+		Func: func(command.Args) error {
+			if configPath == "" {
+				return fmt.Errorf("no config file")
 			}
+			f, report, err := config.Reload(wm, configPath, base)
+			if err != nil {
+				return err
+			}
+			file = f
+			printReport(report)
+			return f.CheckCommands(commands)
 		},
-		wmutil.Stroke{mod, wmutil.Key_j}: func() {
-			if windows.Len() <= 1 {
-				return
+	})
+	if err := command.RegisterWm(commands, wm); err != nil {
+		log.Fatal(err)
+	}
+	workspaces := file.Workspaces
+	if len(workspaces) == 0 {
+		workspaces = []string{"1"}
+	}
+	desktop := &command.Desktop{
+		Wm:         wm,
+		Workspaces: workspaces,
+		Layout:     file.Layout.New(),
+		Masters:    file.Layout.Masters,
+		Ratio:      file.Layout.Ratio,
+		Gap:        file.Layout.Gap,
+		Colors:     file.BorderColors(),
+	}
+	if err := command.RegisterDesktop(commands, desktop); err != nil {
+		log.Fatal(err)
+	}
+	if err := file.CheckCommands(commands); err != nil {
+		pt("config: %v\n", err)
+	}
+	run := func(b interface{ Run(*command.Registry) error }) {
+		if err := b.Run(commands); err != nil {
+			pt("binding: %v\n", err)
+		}
+	}
+
+	// commands from the socket run in the loop below, until it exits
+	runs := make(chan func())
+	done := make(chan struct{})
+	server := &ipc.Server{
+		Wm:       wm,
+		Commands: commands,
+		Workspaces: func() (ret []ipc.Workspace) {
+			current := desktop.Current()
+			for _, name := range workspaces {
+				ws := ipc.Workspace{
+					Name:    name,
+					Focused: name == current,
+					// the desktop arranges on the first monitor
+					Monitor: 0,
+					Windows: []uint32{},
+				}
+				for _, win := range desktop.Windows(name) {
+					ws.Windows = append(ws.Windows, uint32(win.Id))
+				}
+				ret = append(ret, ws)
 			}
-			front := windows.Remove(windows.Front()).(*wmutil.Window)
-			back := windows.Back().Value.(*wmutil.Window)
-			front.Below(back)
-			windows.PushBack(front)
-			next := windows.Front().Value.(*wmutil.Window)
-			if wm.PointingWindow() != next {
-				next.WarpPointer()
+			return
+		},
+		WindowWorkspace: desktop.WindowWorkspace,
+		Run: func(inv *command.Invocation) error {
+			ret := make(chan error, 1)
+			select {
+			case runs <- func() {
+				ret <- inv.Run()
+			}:
+			case <-done:
+				return errors.New("wm exited")
 			}
-			wm.FocusPointerRoot()
+			return <-ret
 		},
 	}
+	if err := server.Listen(ipc.SocketPath(wm.Conn.DisplayNumber)); err != nil {
+		pt("ipc: %v\n", err)
+	} else {
+		defer server.Close()
+	}
+	defer close(done)
 
-	logWriter := os.Stdout
-	if display != ":2" {
-		user, err := user.Current()
-		if err != nil {
-			log.Fatalf("get current user %v", err)
-		}
-		logWriter, err = os.Create(filepath.Join(user.HomeDir, ".wmutils-example.log"))
-		if err != nil {
-			log.Fatalf("open log file %v", err)
-		}
+	// executables in ~/.config/wmutil-example/hooks/<event>.d
+	hooks := &hook.Runner{
+		Logger: logger.With("component", "hook"),
+	}
+	if dir, err := os.UserConfigDir(); err == nil {
+		hooks.Dir = filepath.Join(dir, "wmutil-example", "hooks")
 	}
+	defer hooks.Close()
 
-	var strokes []wmutil.Stroke
-	for stroke, _ := range keyBindings {
-		strokes = append(strokes, stroke)
+	// commands run in the loop below, so do the callbacks
+	desktop.OnFocus = func(win *wmutil.Window) {
+		server.PublishWindow(ipc.EventFocus, win)
+		hooks.Run(hook.EventFocus, win, "")
 	}
-	var err error
-	wm, err = wmutil.New(&wmutil.Config{
-		Strokes: strokes,
-		Logger:  log.New(logWriter, "===|>", log.Lmicroseconds),
-	})
-	if err != nil {
-		log.Fatal(err)
+	desktop.OnWorkspace = func(name string) {
+		server.Publish(ipc.Event{Type: ipc.EventWorkspace, Workspace: name})
+		hooks.Run(hook.EventWorkspace, nil, name)
+	}
+
+	// curl --unix-socket $TMPDIR/wmutil-example-metrics.sock http://wm/debug/vars
+	wm.Metrics.Publish("wm")
+	if server, err := wmutil.ServeMetrics(filepath.Join(os.TempDir(), "wmutil-example-metrics.sock")); err != nil {
+		pt("metrics: %v\n", err)
+	} else {
+		defer server.Close()
+	}
+
+	// kill -USR1 to see what the wm tracks
+	if dir, err := os.UserCacheDir(); err == nil {
+		defer wm.DumpOnSignal(filepath.Join(dir, "wmutil-example-state.json"), syscall.SIGUSR1)()
 	}
-	defer wm.Close()
 
 	exec.Command("xsetroot", "-cursor_name", "left_ptr").Start()
 
-	screenWidth := int(wm.DefaultScreen.WidthInPixels)
-	screenHeight := int(wm.DefaultScreen.HeightInPixels)
-	windowHeight := screenHeight
-	windowWidth := windowHeight / 3 * 4
-	startX := screenWidth - windowWidth
-	startY := (screenHeight - windowHeight) / 2
 	for {
 		select {
 		case win := <-wm.Map:
-			if !win.IsTransient {
-				win.SetGeometry(startX, startY, windowWidth, windowHeight)
+			server.PublishWindow(ipc.EventMap, win)
+			hooks.Run(hook.EventMap, win, "")
+			// focused by the desktop, unless a rule says not
+			desktop.Add(win)
+		case win := <-wm.Unmap:
+			desktop.Remove(win)
+			server.PublishWindow(ipc.EventUnmap, win)
+			hooks.Run(hook.EventUnmap, win, "")
+		case stroke := <-wm.Stroke:
+			if b := file.StrokeBinding(stroke); b != nil {
+				run(b)
 			}
-			if wm.PointingWindow() != win {
-				win.WarpPointer()
+		case ev := <-wm.Sequence:
+			if b := file.SequenceBinding(ev.Sequence); b != nil && ev.Status == wmutil.SequenceMatched {
+				run(b)
 			}
-			windows.PushFront(win)
-			wm.FocusPointerRoot()
-		case win := <-wm.Unmap:
-			for e := windows.Front(); e != nil; e = e.Next() {
-				if e.Value == win {
-					windows.Remove(e)
-					break
-				}
+		case ev := <-wm.Binding:
+			if b := file.TriggerBinding(ev.Binding); b != nil {
+				run(b)
 			}
-		case stroke := <-wm.Stroke:
-			if cb, ok := keyBindings[stroke]; ok {
-				cb()
+		case ev := <-wm.Button:
+			if b := file.ButtonBinding(ev.ButtonStroke); b != nil {
+				run(b)
 			}
-		case <-wm.NameChanged:
+		case win := <-wm.NameChanged:
+			server.PublishWindow(ipc.EventName, win)
+			hooks.Run(hook.EventName, win, "")
+		case fn := <-runs:
+			fn()
 		case <-wm.IconChanged:
 		case req := <-wm.Resize:
 			pt("resize %v\n", req)
@@ -0,0 +1,546 @@
+package fake
+
+import (
+	"errors"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xinerama"
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+// backend is the window manager connection to the server
+type backend struct {
+	s *Server
+}
+
+var _ wmutil.Backend = new(backend)
+
+type void = wmutil.Result[struct{}]
+
+var errNoXinerama = errors.New("xinerama not available")
+
+func badWindow(id xproto.Window) xproto.WindowError {
+	return xproto.WindowError{
+		NiceName: "Window",
+		BadValue: uint32(id),
+	}
+}
+
+func badAtom(atom xproto.Atom) xproto.AtomError {
+	return xproto.AtomError{
+		NiceName: "Atom",
+		BadValue: uint32(atom),
+	}
+}
+
+func reply[T any](v T) wmutil.Result[T] {
+	return wmutil.Result[T]{Value: v}
+}
+
+func fail[T any](err error) wmutil.Result[T] {
+	return wmutil.Result[T]{Err: err}
+}
+
+func (b *backend) Setup() *xproto.SetupInfo {
+	return b.s.setup
+}
+
+func (b *backend) DefaultScreen() *xproto.ScreenInfo {
+	return &b.s.setup.Roots[0]
+}
+
+func (b *backend) WaitForEvent() (xgb.Event, xgb.Error) {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.waiting = true
+	s.cond.Broadcast()
+	for len(s.events) == 0 && !s.closed {
+		s.cond.Wait()
+	}
+	s.waiting = false
+	if s.closed {
+		return nil, nil
+	}
+	ev := s.events[0]
+	s.events = s.events[1:]
+	return ev, nil
+}
+
+func (b *backend) PollForEvent() (xgb.Event, xgb.Error) {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.events) == 0 || s.closed {
+		return nil, nil
+	}
+	ev := s.events[0]
+	s.events = s.events[1:]
+	return ev, nil
+}
+
+func (b *backend) Close() {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	s.cond.Broadcast()
+}
+
+func (b *backend) ChangeWindowAttributes(id xproto.Window, valueMask uint32, valueList []uint32) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("ChangeWindowAttributes")
+	win, ok := s.windows[id]
+	if !ok {
+		return fail[struct{}](badWindow(id))
+	}
+	i := 0
+	for bit := uint32(1); bit <= xproto.CwCursor; bit <<= 1 {
+		if valueMask&bit == 0 {
+			continue
+		}
+		if i >= len(valueList) {
+			break
+		}
+		switch bit {
+		case xproto.CwEventMask:
+			if id == s.root && valueList[i]&xproto.EventMaskSubstructureRedirect != 0 &&
+				win.eventMask&xproto.EventMaskSubstructureRedirect != 0 {
+				return fail[struct{}](xproto.AccessError{NiceName: "Access"})
+			}
+			win.eventMask = valueList[i]
+		case xproto.CwBorderPixel:
+			win.borderPixel = valueList[i]
+		case xproto.CwOverrideRedirect:
+			win.overrideRedirect = valueList[i] != 0
+		}
+		i++
+	}
+	return void{}
+}
+
+func (b *backend) ConfigureWindow(id xproto.Window, valueMask uint16, valueList []uint32) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("ConfigureWindow")
+	win, ok := s.windows[id]
+	if !ok {
+		return fail[struct{}](badWindow(id))
+	}
+	var sibling xproto.Window
+	var stackMode byte
+	restack := false
+	i := 0
+	for bit := uint16(1); bit <= xproto.ConfigWindowStackMode; bit <<= 1 {
+		if valueMask&bit == 0 {
+			continue
+		}
+		if i >= len(valueList) {
+			break
+		}
+		v := valueList[i]
+		switch bit {
+		case xproto.ConfigWindowX:
+			win.x = int(int32(v))
+		case xproto.ConfigWindowY:
+			win.y = int(int32(v))
+		case xproto.ConfigWindowWidth:
+			win.width = int(v)
+		case xproto.ConfigWindowHeight:
+			win.height = int(v)
+		case xproto.ConfigWindowBorderWidth:
+			win.border = int(v)
+		case xproto.ConfigWindowSibling:
+			sibling = xproto.Window(v)
+			if _, ok := s.windows[sibling]; !ok {
+				return fail[struct{}](badWindow(sibling))
+			}
+		case xproto.ConfigWindowStackMode:
+			stackMode = byte(v)
+			restack = true
+		}
+		i++
+	}
+	if restack {
+		s.restack(win, sibling, stackMode)
+	}
+	s.structureNotify(win, xproto.ConfigureNotifyEvent{
+		Event:            win.parent,
+		Window:           win.id,
+		X:                int16(win.x),
+		Y:                int16(win.y),
+		Width:            uint16(win.width),
+		Height:           uint16(win.height),
+		BorderWidth:      uint16(win.border),
+		OverrideRedirect: win.overrideRedirect,
+	})
+	return void{}
+}
+
+func (b *backend) MapWindow(id xproto.Window) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("MapWindow")
+	win, ok := s.windows[id]
+	if !ok {
+		return fail[struct{}](badWindow(id))
+	}
+	s.mapWindow(win)
+	return void{}
+}
+
+func (s *Server) mapWindow(win *window) {
+	if win.mapped {
+		return
+	}
+	win.mapped = true
+	s.structureNotify(win, xproto.MapNotifyEvent{
+		Event:            win.parent,
+		Window:           win.id,
+		OverrideRedirect: win.overrideRedirect,
+	})
+}
+
+func (b *backend) DestroyWindow(id xproto.Window) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("DestroyWindow")
+	win, ok := s.windows[id]
+	if !ok {
+		return fail[struct{}](badWindow(id))
+	}
+	s.remove(win)
+	return void{}
+}
+
+func (b *backend) SendEvent(propagate bool, destination xproto.Window, eventMask uint32, event string) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("SendEvent")
+	if _, ok := s.windows[destination]; !ok {
+		return fail[struct{}](badWindow(destination))
+	}
+	s.sent = append(s.sent, SentEvent{
+		Destination: destination,
+		EventMask:   eventMask,
+		Event:       event,
+	})
+	return void{}
+}
+
+func (b *backend) ChangeProperty(mode byte, id xproto.Window, property, typ xproto.Atom, format byte, dataLen uint32, data []byte) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("ChangeProperty")
+	if _, ok := s.windows[id]; !ok {
+		return fail[struct{}](badWindow(id))
+	}
+	if _, ok := s.atomNames[property]; !ok {
+		return fail[struct{}](badAtom(property))
+	}
+	data = append([]byte(nil), data[:int(dataLen)*int(format/8)]...)
+	if mode == xproto.PropModeAppend || mode == xproto.PropModePrepend {
+		if old, ok := s.windows[id].properties[property]; ok {
+			if mode == xproto.PropModeAppend {
+				data = append(old.Data, data...)
+			} else {
+				data = append(data, old.Data...)
+			}
+		}
+	}
+	s.setProperty(id, property, Property{
+		Type:   typ,
+		Format: format,
+		Data:   data,
+	})
+	return void{}
+}
+
+func (b *backend) GetProperty(delete bool, id xproto.Window, property, typ xproto.Atom, longOffset, longLength uint32) wmutil.Cookie[*xproto.GetPropertyReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("GetProperty")
+	win, ok := s.windows[id]
+	if !ok {
+		return fail[*xproto.GetPropertyReply](badWindow(id))
+	}
+	p, ok := win.properties[property]
+	if !ok {
+		return reply(new(xproto.GetPropertyReply))
+	}
+	if typ != xproto.GetPropertyTypeAny && typ != p.Type {
+		return reply(&xproto.GetPropertyReply{
+			Format:     p.Format,
+			Type:       p.Type,
+			BytesAfter: uint32(len(p.Data)),
+		})
+	}
+	start := min(int(longOffset)*4, len(p.Data))
+	end := len(p.Data)
+	if n := int64(longLength) * 4; int64(end-start) > n {
+		end = start + int(n)
+	}
+	value := append([]byte(nil), p.Data[start:end]...)
+	unit := max(int(p.Format/8), 1)
+	if delete && end == len(p.Data) {
+		s.deleteProperty(id, property)
+	}
+	return reply(&xproto.GetPropertyReply{
+		Format:     p.Format,
+		Type:       p.Type,
+		BytesAfter: uint32(len(p.Data) - end),
+		ValueLen:   uint32(len(value) / unit),
+		Value:      value,
+	})
+}
+
+func (b *backend) InternAtom(onlyIfExists bool, name string) wmutil.Cookie[*xproto.InternAtomReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("InternAtom")
+	if _, ok := s.atoms[name]; !ok && onlyIfExists {
+		return reply(&xproto.InternAtomReply{Atom: xproto.AtomNone})
+	}
+	return reply(&xproto.InternAtomReply{Atom: s.atom(name)})
+}
+
+func (b *backend) GetAtomName(atom xproto.Atom) wmutil.Cookie[*xproto.GetAtomNameReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("GetAtomName")
+	name, ok := s.atomNames[atom]
+	if !ok {
+		return fail[*xproto.GetAtomNameReply](badAtom(atom))
+	}
+	return reply(&xproto.GetAtomNameReply{
+		NameLen: uint16(len(name)),
+		Name:    name,
+	})
+}
+
+func (b *backend) GetInputFocus() wmutil.Cookie[*xproto.GetInputFocusReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("GetInputFocus")
+	return reply(&xproto.GetInputFocusReply{
+		RevertTo: xproto.InputFocusPointerRoot,
+		Focus:    s.focus,
+	})
+}
+
+func (b *backend) SetInputFocus(revertTo byte, focus xproto.Window, time xproto.Timestamp) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("SetInputFocus")
+	if focus != xproto.InputFocusPointerRoot && focus != xproto.WindowNone {
+		win, ok := s.windows[focus]
+		if !ok {
+			return fail[struct{}](badWindow(focus))
+		}
+		if !win.mapped {
+			return fail[struct{}](xproto.MatchError{NiceName: "Match"})
+		}
+	}
+	s.focus = focus
+	return void{}
+}
+
+func (b *backend) QueryTree(id xproto.Window) wmutil.Cookie[*xproto.QueryTreeReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("QueryTree")
+	win, ok := s.windows[id]
+	if !ok {
+		return fail[*xproto.QueryTreeReply](badWindow(id))
+	}
+	return reply(&xproto.QueryTreeReply{
+		Root:        s.root,
+		Parent:      win.parent,
+		ChildrenLen: uint16(len(win.children)),
+		Children:    append([]xproto.Window(nil), win.children...),
+	})
+}
+
+func (b *backend) QueryPointer(id xproto.Window) wmutil.Cookie[*xproto.QueryPointerReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("QueryPointer")
+	win, ok := s.windows[id]
+	if !ok {
+		return fail[*xproto.QueryPointerReply](badWindow(id))
+	}
+	ret := &xproto.QueryPointerReply{
+		SameScreen: true,
+		Root:       s.root,
+		RootX:      int16(s.pointerX),
+		RootY:      int16(s.pointerY),
+		WinX:       int16(s.pointerX - win.x),
+		WinY:       int16(s.pointerY - win.y),
+	}
+	if id == s.root {
+		ret.Child = s.childAt(s.pointerX, s.pointerY)
+	}
+	return reply(ret)
+}
+
+func (b *backend) WarpPointer(srcWindow, dstWindow xproto.Window, srcX, srcY int16, srcWidth, srcHeight uint16, dstX, dstY int16) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("WarpPointer")
+	if dstWindow == xproto.WindowNone {
+		s.pointerX += int(dstX)
+		s.pointerY += int(dstY)
+		return void{}
+	}
+	win, ok := s.windows[dstWindow]
+	if !ok {
+		return fail[struct{}](badWindow(dstWindow))
+	}
+	s.pointerX = win.x + int(dstX)
+	s.pointerY = win.y + int(dstY)
+	return void{}
+}
+
+func (b *backend) GetKeyboardMapping(firstKeycode xproto.Keycode, count byte) wmutil.Cookie[*xproto.GetKeyboardMappingReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("GetKeyboardMapping")
+	ret := &xproto.GetKeyboardMappingReply{
+		KeysymsPerKeycode: keysymsPerKeycode,
+	}
+	for code := int(firstKeycode); code < int(firstKeycode)+int(count); code++ {
+		syms := make([]xproto.Keysym, keysymsPerKeycode)
+		if code < len(s.keysyms) {
+			copy(syms, s.keysyms[code])
+		}
+		ret.Keysyms = append(ret.Keysyms, syms...)
+	}
+	return reply(ret)
+}
+
+func (b *backend) GetModifierMapping() wmutil.Cookie[*xproto.GetModifierMappingReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("GetModifierMapping")
+	per := 0
+	for _, codes := range s.modifiers {
+		per = max(per, len(codes))
+	}
+	ret := &xproto.GetModifierMappingReply{
+		KeycodesPerModifier: byte(per),
+		Keycodes:            make([]xproto.Keycode, per*8),
+	}
+	for i, codes := range s.modifiers {
+		copy(ret.Keycodes[i*per:], codes)
+	}
+	return reply(ret)
+}
+
+func (b *backend) GrabKey(ownerEvents bool, grabWindow xproto.Window, modifiers uint16, key xproto.Keycode, pointerMode, keyboardMode byte) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("GrabKey")
+	grab := keyGrab{key, modifiers}
+	if s.foreignKeyGrabs[grab] {
+		return fail[struct{}](xproto.AccessError{NiceName: "Access"})
+	}
+	s.keyGrabs[grab] = keyboardMode
+	return void{}
+}
+
+func (b *backend) UngrabKey(key xproto.Keycode, grabWindow xproto.Window, modifiers uint16) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("UngrabKey")
+	for grab := range s.keyGrabs {
+		if (key == xproto.GrabAny || key == grab.key) &&
+			(modifiers == xproto.ModMaskAny || modifiers == grab.modifiers) {
+			delete(s.keyGrabs, grab)
+		}
+	}
+	return void{}
+}
+
+func (b *backend) GrabKeyboard(ownerEvents bool, grabWindow xproto.Window, time xproto.Timestamp, pointerMode, keyboardMode byte) wmutil.Cookie[*xproto.GrabKeyboardReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("GrabKeyboard")
+	s.keyboardGrabbed = true
+	return reply(&xproto.GrabKeyboardReply{
+		Status: xproto.GrabStatusSuccess,
+	})
+}
+
+func (b *backend) UngrabKeyboard(time xproto.Timestamp) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("UngrabKeyboard")
+	s.keyboardGrabbed = false
+	return void{}
+}
+
+func (b *backend) AllowEvents(mode byte, time xproto.Timestamp) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("AllowEvents")
+	s.frozen = false
+	return void{}
+}
+
+func (b *backend) GrabButton(ownerEvents bool, grabWindow xproto.Window, eventMask uint16, pointerMode, keyboardMode byte, confineTo xproto.Window, cursor xproto.Cursor, button byte, modifiers uint16) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("GrabButton")
+	s.buttonGrabs[buttonGrab{button, modifiers}] = true
+	return void{}
+}
+
+func (b *backend) UngrabButton(button byte, grabWindow xproto.Window, modifiers uint16) wmutil.VoidCookie {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("UngrabButton")
+	for grab := range s.buttonGrabs {
+		if (button == xproto.ButtonIndexAny || button == grab.button) &&
+			(modifiers == xproto.ModMaskAny || modifiers == grab.modifiers) {
+			delete(s.buttonGrabs, grab)
+		}
+	}
+	return void{}
+}
+
+func (b *backend) QueryScreens() wmutil.Cookie[*xinerama.QueryScreensReply] {
+	s := b.s
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.request("QueryScreens")
+	ret, ok := s.xineramaScreens()
+	if !ok {
+		return fail[*xinerama.QueryScreensReply](errNoXinerama)
+	}
+	return reply(ret)
+}
@@ -0,0 +1,401 @@
+package fake
+
+import (
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+type Property struct {
+	Type   xproto.Atom
+	Format byte
+	Data   []byte
+}
+
+// NamedProperty is a property for window creation, types and names are interned by the server
+type NamedProperty struct {
+	Name   string
+	Type   string
+	Format byte
+	Data   []byte
+
+	atoms []string
+}
+
+func String(name, value string) NamedProperty {
+	return NamedProperty{Name: name, Type: "STRING", Format: 8, Data: []byte(value)}
+}
+
+func UTF8String(name, value string) NamedProperty {
+	return NamedProperty{Name: name, Type: "UTF8_STRING", Format: 8, Data: []byte(value)}
+}
+
+// Class is WM_CLASS
+func Class(instance, class string) NamedProperty {
+	return String("WM_CLASS", instance+"\x00"+class+"\x00")
+}
+
+func Cardinals(name string, values ...uint32) NamedProperty {
+	return NamedProperty{Name: name, Type: "CARDINAL", Format: 32, Data: uint32sBytes(values)}
+}
+
+func TransientFor(id xproto.Window) NamedProperty {
+	return NamedProperty{Name: "WM_TRANSIENT_FOR", Type: "WINDOW", Format: 32, Data: uint32sBytes([]uint32{uint32(id)})}
+}
+
+// Atoms is a property of atom names, like Atoms("WM_PROTOCOLS", "WM_DELETE_WINDOW")
+func Atoms(name string, atoms ...string) NamedProperty {
+	return NamedProperty{Name: name, Type: "ATOM", Format: 32, atoms: atoms}
+}
+
+func uint32sBytes(values []uint32) []byte {
+	buf := make([]byte, len(values)*4)
+	for i, v := range values {
+		xgb.Put32(buf[i*4:], v)
+	}
+	return buf
+}
+
+func (s *Server) resolve(p NamedProperty) (xproto.Atom, Property) {
+	data := p.Data
+	if p.atoms != nil {
+		var values []uint32
+		for _, name := range p.atoms {
+			values = append(values, uint32(s.atom(name)))
+		}
+		data = uint32sBytes(values)
+	}
+	return s.atom(p.Name), Property{
+		Type:   s.atom(p.Type),
+		Format: p.Format,
+		Data:   data,
+	}
+}
+
+func (s *Server) setProperty(id xproto.Window, atom xproto.Atom, p Property) {
+	s.windows[id].properties[atom] = p
+	if s.selected(id, xproto.EventMaskPropertyChange) {
+		s.push(xproto.PropertyNotifyEvent{
+			Window: id,
+			Atom:   atom,
+			Time:   s.now(),
+			State:  xproto.PropertyNewValue,
+		})
+	}
+}
+
+func (s *Server) deleteProperty(id xproto.Window, atom xproto.Atom) {
+	delete(s.windows[id].properties, atom)
+	if s.selected(id, xproto.EventMaskPropertyChange) {
+		s.push(xproto.PropertyNotifyEvent{
+			Window: id,
+			Atom:   atom,
+			Time:   s.now(),
+			State:  xproto.PropertyDelete,
+		})
+	}
+}
+
+type WindowOptions struct {
+	X, Y, Width, Height, Border int
+	OverrideRedirect            bool
+	// set before the window manager is notified
+	Properties []NamedProperty
+}
+
+// CreateWindow creates an unmapped top level window, as a client
+func (s *Server) CreateWindow(opts WindowOptions) xproto.Window {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.nextId++
+	id := s.nextId
+	win := &window{
+		id:               id,
+		parent:           s.root,
+		x:                opts.X,
+		y:                opts.Y,
+		width:            max(opts.Width, 1),
+		height:           max(opts.Height, 1),
+		border:           opts.Border,
+		overrideRedirect: opts.OverrideRedirect,
+		properties:       make(map[xproto.Atom]Property),
+	}
+	s.windows[id] = win
+	root := s.windows[s.root]
+	root.children = append(root.children, id)
+	for _, p := range opts.Properties {
+		atom, value := s.resolve(p)
+		win.properties[atom] = value
+	}
+	s.structureNotify(win, xproto.CreateNotifyEvent{
+		Parent:           s.root,
+		Window:           id,
+		X:                int16(win.x),
+		Y:                int16(win.y),
+		Width:            uint16(win.width),
+		Height:           uint16(win.height),
+		BorderWidth:      uint16(win.border),
+		OverrideRedirect: win.overrideRedirect,
+	})
+	return id
+}
+
+// Map maps the window as a client, a MapRequest is sent to the window manager if it redirects the root
+func (s *Server) Map(id xproto.Window) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	win, ok := s.windows[id]
+	if !ok || win.mapped {
+		return
+	}
+	if s.redirected(win) {
+		s.push(xproto.MapRequestEvent{
+			Parent: win.parent,
+			Window: id,
+		})
+		return
+	}
+	s.mapWindow(win)
+}
+
+// Unmap unmaps the window as a client
+func (s *Server) Unmap(id xproto.Window) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	win, ok := s.windows[id]
+	if !ok || !win.mapped {
+		return
+	}
+	win.mapped = false
+	s.structureNotify(win, xproto.UnmapNotifyEvent{
+		Event:  win.parent,
+		Window: id,
+	})
+}
+
+// Destroy destroys the window as a client
+func (s *Server) Destroy(id xproto.Window) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if win, ok := s.windows[id]; ok {
+		s.remove(win)
+	}
+}
+
+// SetProperty changes the property as a client
+func (s *Server) SetProperty(id xproto.Window, p NamedProperty) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.windows[id]; !ok {
+		return
+	}
+	atom, value := s.resolve(p)
+	s.setProperty(id, atom, value)
+}
+
+// Configure requests the geometry as a client
+func (s *Server) Configure(id xproto.Window, x, y, width, height int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	win, ok := s.windows[id]
+	if !ok {
+		return
+	}
+	mask := uint16(xproto.ConfigWindowX | xproto.ConfigWindowY | xproto.ConfigWindowWidth | xproto.ConfigWindowHeight)
+	if s.redirected(win) {
+		s.push(xproto.ConfigureRequestEvent{
+			Parent:    win.parent,
+			Window:    id,
+			X:         int16(x),
+			Y:         int16(y),
+			Width:     uint16(width),
+			Height:    uint16(height),
+			ValueMask: mask,
+		})
+		return
+	}
+	win.x, win.y, win.width, win.height = x, y, width, height
+}
+
+// GrabKeyByOtherClient makes grabs of the key conflict
+func (s *Server) GrabKeyByOtherClient(key xproto.Keycode, modifiers uint16) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.foreignKeyGrabs[keyGrab{key, modifiers}] = true
+}
+
+// KeyGrabbed reports whether the wm grabbed the key
+func (s *Server) KeyGrabbed(key xproto.Keycode, modifiers uint16) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.keyGrabs[keyGrab{key, modifiers}]
+	return ok
+}
+
+func (s *Server) keyGrabbed(key xproto.Keycode, modifiers uint16) (byte, bool) {
+	for _, grab := range []keyGrab{{key, modifiers}, {key, xproto.ModMaskAny}, {xproto.GrabAny, modifiers}} {
+		if mode, ok := s.keyGrabs[grab]; ok {
+			return mode, true
+		}
+	}
+	return 0, false
+}
+
+func (s *Server) keyEvent(press bool, key xproto.Keycode, state uint16) {
+	mode, grabbed := s.keyGrabbed(key, state)
+	if !grabbed && !s.keyboardGrabbed {
+		return
+	}
+	if press && grabbed && !s.keyboardGrabbed && mode == xproto.GrabModeSync {
+		// frozen until AllowEvents
+		s.frozen = true
+	}
+	fields := xproto.KeyPressEvent{
+		Detail:     key,
+		Time:       s.now(),
+		Root:       s.root,
+		Event:      s.root,
+		Child:      s.childAt(s.pointerX, s.pointerY),
+		RootX:      int16(s.pointerX),
+		RootY:      int16(s.pointerY),
+		EventX:     int16(s.pointerX),
+		EventY:     int16(s.pointerY),
+		State:      state,
+		SameScreen: true,
+	}
+	if press {
+		s.push(fields)
+	} else {
+		s.push(xproto.KeyReleaseEvent(fields))
+	}
+}
+
+// KeyPress presses the key with modifier state, the window manager receives it if grabbed
+func (s *Server) KeyPress(key xproto.Keycode, state uint16) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keyEvent(true, key, state)
+}
+
+func (s *Server) KeyRelease(key xproto.Keycode, state uint16) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keyEvent(false, key, state)
+}
+
+// KeyRepeat sends the release and press of an autorepeat of the held key, with the same time
+func (s *Server) KeyRepeat(key xproto.Keycode, state uint16) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.keyEvent(false, key, state)
+	s.time--
+	s.keyEvent(true, key, state)
+}
+
+// Frozen reports whether the keyboard is frozen by a sync grab
+func (s *Server) Frozen() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.frozen
+}
+
+// ButtonPress presses the button at the pointer, the window manager receives it if grabbed
+func (s *Server) ButtonPress(button byte, state uint16) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.buttonGrabs[buttonGrab{button, state}] && !s.buttonGrabs[buttonGrab{button, xproto.ModMaskAny}] {
+		return
+	}
+	s.push(xproto.ButtonPressEvent{
+		Detail:     xproto.Button(button),
+		Time:       s.now(),
+		Root:       s.root,
+		Event:      s.root,
+		Child:      s.childAt(s.pointerX, s.pointerY),
+		RootX:      int16(s.pointerX),
+		RootY:      int16(s.pointerY),
+		EventX:     int16(s.pointerX),
+		EventY:     int16(s.pointerY),
+		State:      state,
+		SameScreen: true,
+	})
+}
+
+// MovePointer moves the pointer as the user
+func (s *Server) MovePointer(x, y int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.pointerX, s.pointerY = x, y
+}
+
+// Geometry returns the window geometry without border
+func (s *Server) Geometry(id xproto.Window) (wmutil.Rect, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	win, ok := s.windows[id]
+	if !ok {
+		return wmutil.Rect{}, false
+	}
+	return wmutil.Rect{X: win.x, Y: win.y, Width: win.width, Height: win.height}, true
+}
+
+func (s *Server) Mapped(id xproto.Window) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	win, ok := s.windows[id]
+	return ok && win.mapped
+}
+
+// BorderPixel returns the border pixel set by the wm
+func (s *Server) BorderPixel(id xproto.Window) uint32 {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if win, ok := s.windows[id]; ok {
+		return win.borderPixel
+	}
+	return 0
+}
+
+// Stack returns top level windows from bottom to top
+func (s *Server) Stack() []xproto.Window {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]xproto.Window(nil), s.windows[s.root].children...)
+}
+
+// Focus returns the input focus
+func (s *Server) Focus() xproto.Window {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.focus
+}
+
+// SetFocus sets the input focus as a client
+func (s *Server) SetFocus(id xproto.Window) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.focus = id
+}
+
+func (s *Server) Property(id xproto.Window, name string) (Property, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	win, ok := s.windows[id]
+	if !ok {
+		return Property{}, false
+	}
+	p, ok := win.properties[s.atom(name)]
+	return p, ok
+}
+
+// Sent returns events sent by the window manager
+func (s *Server) Sent() []SentEvent {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]SentEvent(nil), s.sent...)
+}
+
+// Root returns the root window id
+func (s *Server) Root() xproto.Window {
+	return s.root
+}
@@ -0,0 +1,686 @@
+package fake
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/layout"
+)
+
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(time.Second * 5):
+		t.Fatalf("no %T received", *new(T))
+	}
+	panic("unreachable")
+}
+
+func newWm(t *testing.T, s *Server, config *wmutil.Config) *wmutil.Wm {
+	t.Helper()
+	config.Backend = s.Backend()
+	if config.Logger == nil {
+		config.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	wm, err := wmutil.New(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(wm.Close)
+	return wm
+}
+
+func TestManage(t *testing.T) {
+	s := New(1000, 800)
+	wm := newWm(t, s, &wmutil.Config{
+		Placement: func(*wmutil.Window) wmutil.Placement {
+			return wmutil.PlaceCenter
+		},
+	})
+
+	id := s.CreateWindow(WindowOptions{
+		Width:  200,
+		Height: 100,
+		Properties: []NamedProperty{
+			Class("xterm", "XTerm"),
+			String("WM_NAME", "shell"),
+			Atoms("WM_PROTOCOLS", "WM_TAKE_FOCUS", "WM_DELETE_WINDOW"),
+		},
+	})
+	s.Map(id)
+	win := receive(t, wm.Map)
+	if win.Id != id || win.Class != "XTerm" || win.Instance != "xterm" || win.Name != "shell" || len(win.Protocols) != 2 {
+		t.Fatalf("got %+v", win)
+	}
+	if !s.Mapped(id) {
+		t.Fatal("not mapped")
+	}
+	if g, _ := s.Geometry(id); g != (wmutil.Rect{X: 400, Y: 350, Width: 200, Height: 100}) {
+		t.Fatalf("got %+v", g)
+	}
+
+	dialog := s.CreateWindow(WindowOptions{
+		Properties: []NamedProperty{
+			TransientFor(id),
+		},
+	})
+	s.Map(dialog)
+	if w := receive(t, wm.Map); w.Id != dialog || w.TransientFor != id {
+		t.Fatalf("got %+v", w)
+	}
+	win.Above(nil)
+	if stack := s.Stack(); stack[len(stack)-1] != id {
+		t.Fatalf("got %v", stack)
+	}
+
+	s.SetProperty(id, UTF8String("_NET_WM_NAME", "vim"))
+	if w := receive(t, wm.NameChanged); w != win || w.Name != "vim" {
+		t.Fatalf("got %+v", w)
+	}
+
+	// WM_DELETE_WINDOW is sent instead of destroying
+	win.Destroy()
+	if sent := s.Sent(); len(sent) != 1 || sent[0].Destination != id {
+		t.Fatalf("got %+v", sent)
+	}
+	s.Unmap(id)
+	if w := receive(t, wm.Unmap); w != win {
+		t.Fatalf("got %+v", w)
+	}
+}
+
+func TestStrokes(t *testing.T) {
+	s := New(1000, 800)
+	f1 := s.Keycode(wmutil.Key_F1)
+	s.GrabKeyByOtherClient(f1, 0)
+	wm := newWm(t, s, &wmutil.Config{
+		Strokes: []wmutil.Stroke{
+			{Modifiers: xproto.ModMask4, Sym: wmutil.Key_Return},
+			{Sym: wmutil.Key_F1},
+		},
+	})
+	if r := wm.GrabReport; len(r.Grabbed) != 1 || len(r.Conflicts) != 1 || r.Conflicts[0].Sym != wmutil.Key_F1 {
+		t.Fatalf("got %+v", r)
+	}
+	ret := s.Keycode(wmutil.Key_Return)
+	for _, mods := range []uint16{xproto.ModMask4, xproto.ModMask4 | xproto.ModMask2, xproto.ModMask4 | xproto.ModMaskLock} {
+		if !s.KeyGrabbed(ret, mods) {
+			t.Fatalf("not grabbed with %x", mods)
+		}
+	}
+	// num lock is ignored
+	s.KeyPress(ret, xproto.ModMask4|xproto.ModMask2)
+	if stroke := receive(t, wm.Stroke); stroke != (wmutil.Stroke{Modifiers: xproto.ModMask4, Sym: wmutil.Key_Return}) {
+		t.Fatalf("got %v", stroke)
+	}
+}
+
+func TestLogging(t *testing.T) {
+	s := New(1000, 800)
+	buf := new(bytes.Buffer)
+	wm := newWm(t, s, &wmutil.Config{
+		Logger: slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{
+			Level: slog.LevelWarn,
+		})),
+	})
+	id := s.CreateWindow(WindowOptions{})
+	s.Map(id)
+	win := receive(t, wm.Map)
+	s.Destroy(id)
+	s.WaitIdle()
+	win.SetPos(10, 10)
+	out := buf.String()
+	for _, attr := range []string{
+		"level=ERROR",
+		fmt.Sprintf("window=0x%x", id),
+		"request=ConfigureWindow",
+		`err="BadWindow`,
+	} {
+		if !strings.Contains(out, attr) {
+			t.Fatalf("no %s in %q", attr, out)
+		}
+	}
+}
+
+func TestDump(t *testing.T) {
+	s := New(1000, 800)
+	wm := newWm(t, s, &wmutil.Config{
+		Strokes: []wmutil.Stroke{
+			{Modifiers: xproto.ModMask4, Sym: wmutil.Key_Return},
+		},
+		Buttons: []wmutil.ButtonStroke{
+			{Modifiers: xproto.ModMask4, Button: 1},
+		},
+	})
+	id := s.CreateWindow(WindowOptions{
+		Width:  100,
+		Height: 50,
+		Properties: []NamedProperty{
+			Class("xterm", "XTerm"),
+			Atoms("WM_PROTOCOLS", "WM_DELETE_WINDOW"),
+			Atoms("_NET_WM_STATE", "_NET_WM_STATE_ABOVE"),
+		},
+	})
+	s.Map(id)
+	receive(t, wm.Map)
+
+	data, err := wm.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var state wmutil.State
+	if err := json.Unmarshal(data, &state); err != nil {
+		t.Fatal(err)
+	}
+	if len(state.Windows) != 1 {
+		t.Fatalf("got %+v", state.Windows)
+	}
+	win := state.Windows[0]
+	if win.Id != fmt.Sprintf("0x%x", id) || win.Class != "XTerm" || !win.Mapped ||
+		!reflect.DeepEqual(win.Protocols, []string{"WM_DELETE_WINDOW"}) ||
+		!reflect.DeepEqual(win.States, []string{"_NET_WM_STATE_ABOVE"}) {
+		t.Fatalf("got %+v", win)
+	}
+	if state.Atoms["WM_PROTOCOLS"] != uint32(s.Atom("WM_PROTOCOLS")) {
+		t.Fatalf("got %v", state.Atoms)
+	}
+	if !reflect.DeepEqual(state.Keyboard.Modifiers["Mod4"], []string{"Super_L"}) || state.Keyboard.NumLockMask != xproto.ModMask2 {
+		t.Fatalf("got %+v", state.Keyboard)
+	}
+	if !reflect.DeepEqual(state.Grabs.Strokes, []string{"Mod4+Return"}) ||
+		!reflect.DeepEqual(state.Grabs.Buttons, []string{"Mod4+Button1"}) {
+		t.Fatalf("got %+v", state.Grabs)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	s := New(1000, 800)
+	wm := newWm(t, s, &wmutil.Config{})
+	id := s.CreateWindow(WindowOptions{})
+	s.Map(id)
+	win := receive(t, wm.Map)
+	s.Destroy(id)
+	s.WaitIdle()
+	win.SetPos(10, 10)
+
+	m := wm.Metrics
+	if v := m.Events.Get("MapRequest").String(); v != "1" {
+		t.Fatalf("got %s", v)
+	}
+	if h := m.EventTime.Get("CreateNotify").(*wmutil.Histogram); h.Count() != 1 {
+		t.Fatalf("got %s", h)
+	}
+	if h := m.SendTime.Get("Map").(*wmutil.Histogram); h.Count() != 1 {
+		t.Fatalf("got %s", h)
+	}
+	if v := m.Requests.Get("MapWindow").String(); v != "1" {
+		t.Fatalf("got %s", v)
+	}
+	if v := m.Errors.Get("BadWindow").String(); v != "1" {
+		t.Fatalf("got %s", v)
+	}
+	if !json.Valid([]byte(m.Var().String())) {
+		t.Fatalf("bad json %s", m.Var())
+	}
+}
+
+// tracingBackend logs GetProperty requests and replies in order
+type tracingBackend struct {
+	wmutil.Backend
+	lock  sync.Mutex
+	trace []string
+}
+
+func (b *tracingBackend) log(s string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.trace = append(b.trace, s)
+}
+
+func (b *tracingBackend) GetProperty(del bool, win xproto.Window, property, typ xproto.Atom, offset, length uint32) wmutil.Cookie[*xproto.GetPropertyReply] {
+	b.log("request")
+	return tracingCookie{b.Backend.GetProperty(del, win, property, typ, offset, length), b}
+}
+
+type tracingCookie struct {
+	wmutil.Cookie[*xproto.GetPropertyReply]
+	b *tracingBackend
+}
+
+func (c tracingCookie) Reply() (*xproto.GetPropertyReply, error) {
+	c.b.log("reply")
+	return c.Cookie.Reply()
+}
+
+func TestBatch(t *testing.T) {
+	s := New(1000, 800)
+	backend := &tracingBackend{Backend: s.Backend()}
+	wm, err := wmutil.New(&wmutil.Config{
+		Backend: backend,
+		Logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wm.Close()
+
+	// property reads at window creation are pipelined
+	backend.lock.Lock()
+	backend.trace = nil
+	backend.lock.Unlock()
+	a := s.CreateWindow(WindowOptions{
+		Properties: []NamedProperty{
+			Class("xterm", "XTerm"),
+		},
+	})
+	s.Map(a)
+	if win := receive(t, wm.Map); win.Class != "XTerm" {
+		t.Fatalf("got %+v", win)
+	}
+	backend.lock.Lock()
+	trace := strings.Join(backend.trace, " ")
+	backend.lock.Unlock()
+	if !strings.HasPrefix(trace, strings.Repeat("request ", 10)+"reply") {
+		t.Fatalf("got %s", trace)
+	}
+
+	b := s.CreateWindow(WindowOptions{})
+	s.Map(b)
+	winA := wm.Window(a)
+	winB := receive(t, wm.Map)
+	s.Destroy(b)
+	s.WaitIdle()
+	s.ResetRequests()
+	err = wm.Batch(func(batch *wmutil.Batch) {
+		batch.SetGeometry(winA, 10, 20, 300, 200)
+		batch.SetPos(winB, 5, 5)
+		batch.Above(winA, nil)
+	})
+	if err == nil || !strings.Contains(err.Error(), "BadWindow") {
+		t.Fatalf("got %v", err)
+	}
+	if n := s.Requests("ConfigureWindow"); n != 3 {
+		t.Fatalf("got %d", n)
+	}
+	if g, _ := s.Geometry(a); g != (wmutil.Rect{X: 10, Y: 20, Width: 300, Height: 200}) {
+		t.Fatalf("got %+v", g)
+	}
+	winA.ReadLock(func() {
+		if winA.X != 10 || winA.Width != 300 {
+			t.Fatalf("got %+v", winA)
+		}
+	})
+	if err := layout.Apply(&layout.Monocle{}, wmutil.Rect{Width: 100, Height: 100}, []*wmutil.Window{winA, winB}); err == nil {
+		t.Fatal("expecting error")
+	}
+}
+
+func TestInternAtoms(t *testing.T) {
+	s := New(1000, 800)
+	wm := newWm(t, s, &wmutil.Config{})
+	s.ResetRequests()
+
+	id := s.CreateWindow(WindowOptions{
+		Properties: []NamedProperty{
+			Atoms("_NET_WM_WINDOW_TYPE", "_NET_WM_WINDOW_TYPE_DIALOG"),
+		},
+	})
+	s.Map(id)
+	receive(t, wm.Map)
+	s.SetProperty(id, String("_NET_WM_NAME", "title"))
+	if win := receive(t, wm.NameChanged); win.Name != "title" {
+		t.Fatalf("got %+v", win)
+	}
+	s.SetProperty(id, String("_NET_WM_PID", "1"))
+	s.WaitIdle()
+	if n := s.Requests("InternAtom") + s.Requests("GetAtomName"); n != 0 {
+		t.Fatalf("got %d", n)
+	}
+
+	if err := wm.InternAtoms("FOO", "BAR", "WM_PROTOCOLS"); err != nil {
+		t.Fatal(err)
+	}
+	if n := s.Requests("InternAtom"); n != 2 {
+		t.Fatalf("got %d", n)
+	}
+	if wm.Atom("FOO") == wm.Atom("BAR") || s.Requests("InternAtom") != 2 {
+		t.Fatal("not cached")
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	s := New(1000, 800)
+	wm := newWm(t, s, &wmutil.Config{
+		CoalesceLatency: time.Millisecond * 50,
+	})
+	id := s.CreateWindow(WindowOptions{})
+	s.Map(id)
+	win := receive(t, wm.Map)
+	go func() {
+		for range wm.NameChanged {
+		}
+	}()
+	s.WaitIdle()
+
+	// a title update for every keystroke
+	s.ResetRequests()
+	const flood = 1000
+	for i := 0; i < flood; i++ {
+		s.SetProperty(id, String("_NET_WM_NAME", fmt.Sprintf("title %d", i)))
+	}
+	s.WaitIdle()
+	if n := s.Requests("GetProperty"); n >= flood/10 {
+		t.Fatalf("got %d", n)
+	}
+	win.ReadLock(func() {
+		if win.Name != fmt.Sprintf("title %d", flood-1) {
+			t.Fatalf("got %s", win.Name)
+		}
+	})
+
+	// configure storm of an unmapped window
+	other := s.CreateWindow(WindowOptions{})
+	s.WaitIdle()
+	s.ResetRequests()
+	for i := 0; i < flood; i++ {
+		s.Configure(other, i, i, 100, 100)
+	}
+	s.WaitIdle()
+	if n := s.Requests("ConfigureWindow"); n >= flood/10 {
+		t.Fatalf("got %d", n)
+	}
+	if g, _ := s.Geometry(other); g != (wmutil.Rect{X: flood - 1, Y: flood - 1, Width: 100, Height: 100}) {
+		t.Fatalf("got %+v", g)
+	}
+}
+
+func TestReconfigure(t *testing.T) {
+	s := New(1000, 800)
+	config := &wmutil.Config{
+		SyncStrokes: []wmutil.Stroke{
+			{Modifiers: xproto.ModMask4, Sym: wmutil.Key_a},
+		},
+		Buttons: []wmutil.ButtonStroke{
+			{Modifiers: xproto.ModMask4, Button: 1},
+		},
+	}
+	wm := newWm(t, s, config)
+	go func() {
+		for stroke := range wm.SyncStroke {
+			stroke.Consume()
+		}
+	}()
+	go func() {
+		for range wm.Button {
+		}
+	}()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 50; i++ {
+			wm.Reconfigure(config)
+		}
+	}()
+	a := s.Keycode(wmutil.Key_a)
+	for {
+		select {
+		case <-done:
+			s.WaitIdle()
+			if r := wm.State().Grabs; len(r.Buttons) != 1 {
+				t.Fatalf("got %+v", r)
+			}
+			config.Rules = wmutil.Rules{{Match: wmutil.RuleMatch{Class: &wmutil.Matcher{Regex: "("}}}}
+			if r := wm.Reconfigure(config); r.InvalidRules == nil {
+				t.Fatal("expecting invalid rules")
+			}
+			return
+		default:
+		}
+		wm.State()
+		s.KeyPress(a, xproto.ModMask4)
+		s.KeyRelease(a, xproto.ModMask4)
+		s.ButtonPress(1, xproto.ModMask4)
+	}
+}
+
+func TestSyncStrokeKeycode(t *testing.T) {
+	s := New(1000, 800)
+	upper := wmutil.Stroke{Modifiers: xproto.ModMask4, Sym: wmutil.Key_A}
+	nosym := wmutil.Stroke{Modifiers: xproto.ModMask4, Sym: wmutil.Key_b}
+	wm := newWm(t, s, &wmutil.Config{
+		SyncStrokes: []wmutil.Stroke{upper, nosym},
+		SyncTimeout: time.Hour,
+	})
+	b := s.Keycode(wmutil.Key_b)
+	// as if the keymap lost the keysym
+	wm.CodeToSyms[b] = nil
+
+	for _, c := range []struct {
+		code   xproto.Keycode
+		stroke wmutil.Stroke
+	}{
+		{s.Keycode(wmutil.Key_a), upper},
+		{b, nosym},
+	} {
+		s.KeyPress(c.code, xproto.ModMask4|xproto.ModMaskLock)
+		if !s.Frozen() {
+			t.Fatal("not frozen")
+		}
+		sync := receive(t, wm.SyncStroke)
+		if sync.Stroke != c.stroke {
+			t.Fatalf("got %v", sync.Stroke)
+		}
+		sync.Consume()
+		if s.Frozen() {
+			t.Fatalf("frozen after %v", c.stroke)
+		}
+	}
+}
+
+func TestRulesPropertiesBeforeMap(t *testing.T) {
+	s := New(1000, 800)
+	wm := newWm(t, s, &wmutil.Config{
+		Rules: wmutil.Rules{
+			{
+				Match: wmutil.RuleMatch{
+					Class: &wmutil.Matcher{Exact: "Firefox"},
+					Role:  &wmutil.Matcher{Exact: "browser"},
+					Type:  "DIALOG",
+				},
+				Actions: wmutil.RuleActions{
+					Geometry: &wmutil.Rect{X: 10, Y: 20, Width: 300, Height: 200},
+				},
+			},
+		},
+	})
+	id := s.CreateWindow(WindowOptions{})
+	s.WaitIdle()
+	// set after the wm read the properties at creation
+	s.SetProperty(id, Class("Navigator", "Firefox"))
+	s.SetProperty(id, String("WM_WINDOW_ROLE", "browser"))
+	s.SetProperty(id, Atoms("_NET_WM_WINDOW_TYPE", "_NET_WM_WINDOW_TYPE_DIALOG"))
+	s.Map(id)
+	win := receive(t, wm.Map)
+	win.ReadLock(func() {
+		if win.Class != "Firefox" || win.Instance != "Navigator" || win.Role != "browser" {
+			t.Fatalf("got %+v", win)
+		}
+	})
+	if g, _ := s.Geometry(id); g != (wmutil.Rect{X: 10, Y: 20, Width: 300, Height: 200}) {
+		t.Fatalf("got %+v", g)
+	}
+}
+
+func TestBindingPressedAgain(t *testing.T) {
+	s := New(1000, 800)
+	stroke := wmutil.Stroke{Modifiers: xproto.ModMask4, Sym: wmutil.Key_a}
+	wm := newWm(t, s, &wmutil.Config{
+		Bindings: []wmutil.Binding{
+			{Stroke: stroke, Trigger: wmutil.TriggerPress},
+		},
+	})
+	a := s.Keycode(wmutil.Key_a)
+	s.KeyPress(a, xproto.ModMask4)
+	receive(t, wm.Binding)
+	s.KeyRelease(a, xproto.ModMask4)
+	s.WaitIdle()
+	// modifier released and pressed again, not seen without a keyboard grab
+	time.Sleep(time.Millisecond * 100)
+	s.KeyPress(a, xproto.ModMask4)
+	if ev := receive(t, wm.Binding); ev.Held >= time.Millisecond*100 {
+		t.Fatalf("got %v", ev.Held)
+	}
+}
+
+// failingMapBackend fails every MapWindow like a window destroyed before the map
+type failingMapBackend struct {
+	wmutil.Backend
+}
+
+func (b failingMapBackend) MapWindow(id xproto.Window) wmutil.VoidCookie {
+	b.Backend.MapWindow(id)
+	return fail[struct{}](badWindow(id))
+}
+
+func TestMapWindowError(t *testing.T) {
+	s := New(1000, 800)
+	buf := new(bytes.Buffer)
+	wm, err := wmutil.New(&wmutil.Config{
+		Backend: failingMapBackend{s.Backend()},
+		Logger:  slog.New(slog.NewTextHandler(buf, nil)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wm.Close()
+	s.Map(s.CreateWindow(WindowOptions{}))
+	receive(t, wm.Map)
+	s.WaitIdle()
+	if v := wm.Metrics.Errors.Get("BadWindow"); v == nil || v.String() != "1" {
+		t.Fatalf("got %v", v)
+	}
+	if out := buf.String(); !strings.Contains(out, "request=MapWindow") {
+		t.Fatalf("got %q", out)
+	}
+}
+
+func TestWorkAreaPartialStrut(t *testing.T) {
+	s := New(2000, 800)
+	left := wmutil.Rect{Width: 1000, Height: 800}
+	right := wmutil.Rect{X: 1000, Width: 1000, Height: 800}
+	s.SetScreens(left, right)
+	wm := newWm(t, s, &wmutil.Config{})
+	// a panel at the top of the left monitor
+	s.Map(s.CreateWindow(WindowOptions{
+		Width:  1000,
+		Height: 30,
+		Properties: []NamedProperty{
+			Cardinals("_NET_WM_STRUT_PARTIAL", 0, 0, 30, 0, 0, 0, 0, 0, 0, 999, 0, 0),
+		},
+	}))
+	receive(t, wm.Map)
+	if area := wm.WorkArea(left); area != (wmutil.Rect{Y: 30, Width: 1000, Height: 770}) {
+		t.Fatalf("got %v", area)
+	}
+	if area := wm.WorkArea(right); area != right {
+		t.Fatalf("got %v", area)
+	}
+}
+
+func TestCoalesceQuiet(t *testing.T) {
+	s := New(1000, 800)
+	wm := newWm(t, s, &wmutil.Config{
+		CoalesceLatency: time.Second * 10,
+	})
+	id := s.CreateWindow(WindowOptions{})
+	s.Map(id)
+	receive(t, wm.Map)
+	// a single update is not held for the full latency
+	s.SetProperty(id, String("_NET_WM_NAME", "title"))
+	receive(t, wm.NameChanged)
+}
+
+func TestSyncStrokeInSequence(t *testing.T) {
+	s := New(1000, 800)
+	prefix := wmutil.Stroke{Modifiers: xproto.ModMask4, Sym: wmutil.Key_w}
+	h := wmutil.Stroke{Sym: wmutil.Key_h}
+	wm := newWm(t, s, &wmutil.Config{
+		Sequences:   []wmutil.Sequence{{prefix, h}},
+		SyncStrokes: []wmutil.Stroke{h},
+	})
+	s.KeyPress(s.Keycode(wmutil.Key_w), xproto.ModMask4)
+	s.KeyPress(s.Keycode(wmutil.Key_h), 0)
+	if ev := receive(t, wm.Sequence); ev.Status != wmutil.SequenceMatched || len(ev.Sequence) != 2 {
+		t.Fatalf("got %+v", ev)
+	}
+}
+
+func TestRawModifiers(t *testing.T) {
+	s := New(1000, 800)
+	stroke := wmutil.Stroke{Modifiers: xproto.ModMask4, Sym: wmutil.Key_Return}
+	wm := newWm(t, s, &wmutil.Config{
+		Strokes:      []wmutil.Stroke{stroke},
+		RawModifiers: true,
+	})
+	s.KeyPress(s.Keycode(wmutil.Key_Return), xproto.ModMask4|xproto.ModMask2)
+	if got := receive(t, wm.Stroke); got.Modifiers != xproto.ModMask4|xproto.ModMask2 {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestSyncStrokeDefaultTimeout(t *testing.T) {
+	s := New(1000, 800)
+	stroke := wmutil.Stroke{Modifiers: xproto.ModMask4, Sym: wmutil.Key_a}
+	wm := newWm(t, s, &wmutil.Config{
+		SyncStrokes: []wmutil.Stroke{stroke},
+		SyncTimeout: -1,
+	})
+	s.KeyPress(s.Keycode(wmutil.Key_a), xproto.ModMask4)
+	sync := receive(t, wm.SyncStroke)
+	// replayed after the default timeout, not at once
+	if !s.Frozen() {
+		t.Fatal("not frozen")
+	}
+	for deadline := time.Now().Add(time.Second * 5); s.Frozen(); time.Sleep(time.Millisecond * 10) {
+		if time.Now().After(deadline) {
+			t.Fatal("not replayed")
+		}
+	}
+	sync.Consume()
+}
+
+func TestAutorepeat(t *testing.T) {
+	s := New(1000, 800)
+	f12 := wmutil.Stroke{Sym: wmutil.Key_F12}
+	wm := newWm(t, s, &wmutil.Config{
+		Bindings: []wmutil.Binding{
+			{Stroke: f12, Trigger: wmutil.TriggerRelease},
+		},
+	})
+	key := s.Keycode(wmutil.Key_F12)
+	s.KeyPress(key, 0)
+	for i := 0; i < 3; i++ {
+		s.KeyRepeat(key, 0)
+	}
+	select {
+	case ev := <-wm.Binding:
+		t.Fatalf("released by autorepeat %+v", ev)
+	case <-time.After(time.Millisecond * 100):
+	}
+	s.KeyRelease(key, 0)
+	if ev := receive(t, wm.Binding); ev.Binding.Trigger != wmutil.TriggerRelease {
+		t.Fatalf("got %+v", ev)
+	}
+}
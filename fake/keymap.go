@@ -0,0 +1,84 @@
+package fake
+
+import (
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+// names of predefined atoms, from PRIMARY (1) to WM_TRANSIENT_FOR (68)
+var predefinedAtoms = []string{
+	"PRIMARY", "SECONDARY", "ARC", "ATOM", "BITMAP", "CARDINAL", "COLORMAP", "CURSOR",
+	"CUT_BUFFER0", "CUT_BUFFER1", "CUT_BUFFER2", "CUT_BUFFER3",
+	"CUT_BUFFER4", "CUT_BUFFER5", "CUT_BUFFER6", "CUT_BUFFER7",
+	"DRAWABLE", "FONT", "INTEGER", "PIXMAP", "POINT", "RECTANGLE", "RESOURCE_MANAGER",
+	"RGB_COLOR_MAP", "RGB_BEST_MAP", "RGB_BLUE_MAP", "RGB_DEFAULT_MAP",
+	"RGB_GRAY_MAP", "RGB_GREEN_MAP", "RGB_RED_MAP",
+	"STRING", "VISUALID", "WINDOW", "WM_COMMAND", "WM_HINTS", "WM_CLIENT_MACHINE",
+	"WM_ICON_NAME", "WM_ICON_SIZE", "WM_NAME", "WM_NORMAL_HINTS", "WM_SIZE_HINTS", "WM_ZOOM_HINTS",
+	"MIN_SPACE", "NORM_SPACE", "MAX_SPACE", "END_SPACE",
+	"SUPERSCRIPT_X", "SUPERSCRIPT_Y", "SUBSCRIPT_X", "SUBSCRIPT_Y",
+	"UNDERLINE_POSITION", "UNDERLINE_THICKNESS", "STRIKEOUT_ASCENT", "STRIKEOUT_DESCENT",
+	"ITALIC_ANGLE", "X_HEIGHT", "QUAD_WIDTH", "WEIGHT", "POINT_SIZE", "RESOLUTION",
+	"COPYRIGHT", "NOTICE", "FONT_NAME", "FAMILY_NAME", "FULL_NAME", "CAP_HEIGHT",
+	"WM_CLASS", "WM_TRANSIENT_FOR",
+}
+
+// keysyms per keycode, lower and upper case
+const keysymsPerKeycode = 2
+
+// setKeymap assigns keycodes from 9 to a US-like set of keys
+func (s *Server) setKeymap() {
+	var keys [][keysymsPerKeycode]wmutil.Keysym
+	for c := wmutil.Key_a; c <= wmutil.Key_z; c++ {
+		keys = append(keys, [keysymsPerKeycode]wmutil.Keysym{c, c - wmutil.Key_a + wmutil.Key_A})
+	}
+	for c := wmutil.Key_0; c <= wmutil.Key_9; c++ {
+		keys = append(keys, [keysymsPerKeycode]wmutil.Keysym{c})
+	}
+	for c := wmutil.Key_F1; c <= wmutil.Key_F12; c++ {
+		keys = append(keys, [keysymsPerKeycode]wmutil.Keysym{c})
+	}
+	for _, c := range []wmutil.Keysym{
+		wmutil.Key_Return, wmutil.Key_Tab, wmutil.Key_Escape, wmutil.Key_space, wmutil.Key_BackSpace,
+		wmutil.Key_Left, wmutil.Key_Right, wmutil.Key_Up, wmutil.Key_Down,
+		wmutil.Key_Shift_L, wmutil.Key_Control_L, wmutil.Key_Alt_L, wmutil.Key_Super_L,
+		wmutil.Key_Num_Lock, wmutil.Key_Caps_Lock,
+	} {
+		keys = append(keys, [keysymsPerKeycode]wmutil.Keysym{c})
+	}
+	s.keysyms = make([][]xproto.Keysym, 256)
+	for i, syms := range keys {
+		code := 9 + i
+		for _, sym := range syms {
+			s.keysyms[code] = append(s.keysyms[code], xproto.Keysym(sym))
+		}
+	}
+	for index, sym := range map[int]wmutil.Keysym{
+		xproto.MapIndexShift:   wmutil.Key_Shift_L,
+		xproto.MapIndexLock:    wmutil.Key_Caps_Lock,
+		xproto.MapIndexControl: wmutil.Key_Control_L,
+		xproto.MapIndex1:       wmutil.Key_Alt_L,
+		xproto.MapIndex2:       wmutil.Key_Num_Lock,
+		xproto.MapIndex4:       wmutil.Key_Super_L,
+	} {
+		s.modifiers[index] = []xproto.Keycode{s.keycode(sym)}
+	}
+}
+
+func (s *Server) keycode(sym wmutil.Keysym) xproto.Keycode {
+	for code, syms := range s.keysyms {
+		for _, ks := range syms {
+			if ks == xproto.Keysym(sym) {
+				return xproto.Keycode(code)
+			}
+		}
+	}
+	return 0
+}
+
+// Keycode returns the keycode of the keysym, or 0 if not on the keyboard
+func (s *Server) Keycode(sym wmutil.Keysym) xproto.Keycode {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.keycode(sym)
+}
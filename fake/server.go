@@ -0,0 +1,302 @@
+package fake
+
+import (
+	"sync"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xinerama"
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+// Server is an in-memory X server with a single window manager connection, returned by Backend.
+// methods of Server act as other clients and as the user, to drive the window manager in tests
+type Server struct {
+	lock      sync.Mutex
+	cond      *sync.Cond
+	setup     *xproto.SetupInfo
+	root      xproto.Window
+	windows   map[xproto.Window]*window
+	nextId    xproto.Window
+	atoms     map[string]xproto.Atom
+	atomNames map[xproto.Atom]string
+	events    []xgb.Event
+	closed    bool
+	waiting   bool
+	time      xproto.Timestamp
+
+	focus              xproto.Window
+	pointerX, pointerY int
+	screens            []wmutil.Rect
+
+	keysyms         [][]xproto.Keysym
+	modifiers       [8][]xproto.Keycode
+	keyGrabs        map[keyGrab]byte
+	foreignKeyGrabs map[keyGrab]bool
+	buttonGrabs     map[buttonGrab]bool
+	keyboardGrabbed bool
+	frozen          bool
+
+	requests map[string]int
+	sent     []SentEvent
+}
+
+type window struct {
+	id, parent                  xproto.Window
+	x, y, width, height, border int
+	mapped, overrideRedirect    bool
+	borderPixel                 uint32
+	// selected by the wm
+	eventMask  uint32
+	properties map[xproto.Atom]Property
+	// bottom to top
+	children []xproto.Window
+}
+
+type keyGrab struct {
+	key       xproto.Keycode
+	modifiers uint16
+}
+
+type buttonGrab struct {
+	button    byte
+	modifiers uint16
+}
+
+// SentEvent is an event sent by the wm with SendEvent
+type SentEvent struct {
+	Destination xproto.Window
+	EventMask   uint32
+	Event       string
+}
+
+// New returns a server with a screen of width and height
+func New(width, height int) *Server {
+	s := &Server{
+		root:            1,
+		nextId:          0x200000,
+		windows:         make(map[xproto.Window]*window),
+		atoms:           make(map[string]xproto.Atom),
+		atomNames:       make(map[xproto.Atom]string),
+		focus:           xproto.InputFocusPointerRoot,
+		keyGrabs:        make(map[keyGrab]byte),
+		foreignKeyGrabs: make(map[keyGrab]bool),
+		buttonGrabs:     make(map[buttonGrab]bool),
+		requests:        make(map[string]int),
+	}
+	s.cond = sync.NewCond(&s.lock)
+	s.setup = &xproto.SetupInfo{
+		MinKeycode: 8,
+		MaxKeycode: 255,
+		Roots: []xproto.ScreenInfo{
+			{
+				Root:           s.root,
+				WidthInPixels:  uint16(width),
+				HeightInPixels: uint16(height),
+				RootDepth:      24,
+			},
+		},
+	}
+	s.windows[s.root] = &window{
+		id:         s.root,
+		width:      width,
+		height:     height,
+		mapped:     true,
+		properties: make(map[xproto.Atom]Property),
+	}
+	for i, name := range predefinedAtoms {
+		atom := xproto.Atom(i + 1)
+		s.atoms[name] = atom
+		s.atomNames[atom] = name
+	}
+	s.setKeymap()
+	return s
+}
+
+// Backend returns the window manager connection
+func (s *Server) Backend() wmutil.Backend {
+	return &backend{s}
+}
+
+func (s *Server) request(name string) {
+	s.requests[name]++
+}
+
+// Requests returns the number of requests of the name sent by the wm, like "GetProperty"
+func (s *Server) Requests(name string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests[name]
+}
+
+// ResetRequests clears request counts
+func (s *Server) ResetRequests() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.requests = make(map[string]int)
+}
+
+func (s *Server) now() xproto.Timestamp {
+	s.time++
+	return s.time
+}
+
+func (s *Server) push(ev xgb.Event) {
+	if s.closed {
+		return
+	}
+	s.events = append(s.events, ev)
+	s.cond.Broadcast()
+}
+
+// Pending returns the number of events not read by the wm
+func (s *Server) Pending() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return len(s.events)
+}
+
+// WaitIdle waits until the wm handled all events and is waiting for more
+func (s *Server) WaitIdle() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for !s.closed && !(s.waiting && len(s.events) == 0) {
+		s.cond.Wait()
+	}
+}
+
+// selected reports whether the wm selected mask on the window
+func (s *Server) selected(id xproto.Window, mask uint32) bool {
+	win, ok := s.windows[id]
+	return ok && win.eventMask&mask != 0
+}
+
+func (s *Server) redirected(win *window) bool {
+	return !win.overrideRedirect && s.selected(win.parent, xproto.EventMaskSubstructureRedirect)
+}
+
+func (s *Server) structureNotify(win *window, ev xgb.Event) {
+	if s.selected(win.parent, xproto.EventMaskSubstructureNotify) || s.selected(win.id, xproto.EventMaskStructureNotify) {
+		s.push(ev)
+	}
+}
+
+func (s *Server) atom(name string) xproto.Atom {
+	if atom, ok := s.atoms[name]; ok {
+		return atom
+	}
+	atom := xproto.Atom(len(s.atomNames) + 1)
+	s.atoms[name] = atom
+	s.atomNames[atom] = name
+	return atom
+}
+
+// Atom interns the atom
+func (s *Server) Atom(name string) xproto.Atom {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.atom(name)
+}
+
+// SetScreens makes xinerama available with the screens. xinerama is not available by default
+func (s *Server) SetScreens(screens ...wmutil.Rect) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.screens = screens
+}
+
+func (s *Server) xineramaScreens() (*xinerama.QueryScreensReply, bool) {
+	if len(s.screens) == 0 {
+		return nil, false
+	}
+	reply := &xinerama.QueryScreensReply{
+		Number: uint32(len(s.screens)),
+	}
+	for _, r := range s.screens {
+		reply.ScreenInfo = append(reply.ScreenInfo, xinerama.ScreenInfo{
+			XOrg:   int16(r.X),
+			YOrg:   int16(r.Y),
+			Width:  uint16(r.Width),
+			Height: uint16(r.Height),
+		})
+	}
+	return reply, true
+}
+
+// restack moves the window among its siblings by the stack mode, relative to sibling if not zero
+func (s *Server) restack(win *window, sibling xproto.Window, mode byte) {
+	parent := s.windows[win.parent]
+	if parent == nil {
+		return
+	}
+	var children []xproto.Window
+	for _, id := range parent.children {
+		if id != win.id {
+			children = append(children, id)
+		}
+	}
+	pos := len(children)
+	switch mode {
+	case xproto.StackModeAbove, xproto.StackModeTopIf:
+		if sibling != 0 {
+			for i, id := range children {
+				if id == sibling {
+					pos = i + 1
+				}
+			}
+		}
+	case xproto.StackModeBelow, xproto.StackModeBottomIf:
+		pos = 0
+		if sibling != 0 {
+			for i, id := range children {
+				if id == sibling {
+					pos = i
+				}
+			}
+		}
+	case xproto.StackModeOpposite:
+		// opposite of the current position
+		if len(parent.children) > 0 && parent.children[len(parent.children)-1] == win.id {
+			pos = 0
+		}
+	}
+	children = append(children[:pos], append([]xproto.Window{win.id}, children[pos:]...)...)
+	parent.children = children
+}
+
+func (s *Server) remove(win *window) {
+	for _, child := range win.children {
+		if c, ok := s.windows[child]; ok {
+			s.remove(c)
+		}
+	}
+	delete(s.windows, win.id)
+	if parent := s.windows[win.parent]; parent != nil {
+		for i, id := range parent.children {
+			if id == win.id {
+				parent.children = append(parent.children[:i:i], parent.children[i+1:]...)
+				break
+			}
+		}
+	}
+	if s.focus == win.id {
+		s.focus = xproto.InputFocusPointerRoot
+	}
+	s.structureNotify(win, xproto.DestroyNotifyEvent{
+		Event:  win.parent,
+		Window: win.id,
+	})
+}
+
+// childAt returns the topmost mapped child of root containing the point
+func (s *Server) childAt(x, y int) xproto.Window {
+	root := s.windows[s.root]
+	for i := len(root.children) - 1; i >= 0; i-- {
+		win := s.windows[root.children[i]]
+		if win.mapped && x >= win.x && y >= win.y &&
+			x < win.x+win.width+win.border*2 && y < win.y+win.height+win.border*2 {
+			return win.id
+		}
+	}
+	return 0
+}
@@ -8,13 +8,13 @@ import (
 	"go/format"
 	"io/ioutil"
 	"log"
-	"os/exec"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 func main() {
 	genSymDefs()
-	exec.Command("gofmt -w .").Run()
 }
 
 func genSymDefs() {
@@ -22,20 +22,61 @@ func genSymDefs() {
 	w := func(format string, args ...interface{}) {
 		fmt.Fprintf(buf, format, args...)
 	}
-	w(`package wmutil
+	w(`// Code generated by gen.go; DO NOT EDIT.
 
-var (
+package wmutil
+
+const (
 `)
-	content, err := ioutil.ReadFile("/usr/include/X11/keysymdef.h")
+	// keysymdef.h is a vendored copy of the xorgproto header
+	content, err := ioutil.ReadFile("keysymdef.h")
 	if err != nil {
 		log.Fatal(err)
 	}
-	defPattern := regexp.MustCompile(`(?m:^#define (?:XK_)([^\s]+)\s+(\S+))`)
-	matches := defPattern.FindAllSubmatch(content, -1)
-	for _, group := range matches {
-		w("\tKey_%s uint32 = %s\n", group[1], group[2])
+	defPattern := regexp.MustCompile(`(?m:^#define XK_([a-zA-Z_0-9]+)\s+(0x[0-9a-fA-F]+)\s*(?:/\*(.*)\*/)?\s*$)`)
+	runePattern := regexp.MustCompile(`^\s*\(?U\+([0-9A-F]{4,6})`)
+	type def struct {
+		name      string
+		sym       string
+		codepoint string
+		preferred bool
+	}
+	var defs []def
+	seen := make(map[string]bool)
+	for _, group := range defPattern.FindAllSubmatch(content, -1) {
+		d := def{
+			name: string(group[1]),
+			sym:  string(group[2]),
+		}
+		comment := strings.ToLower(string(group[3]))
+		if m := runePattern.FindSubmatch(group[3]); m != nil {
+			d.codepoint = string(m[1])
+		}
+		// the first non-deprecated name of a keysym is its canonical name
+		value, err := strconv.ParseUint(d.sym, 0, 32)
+		if err != nil {
+			log.Fatal(err)
+		}
+		key := strconv.FormatUint(value, 16)
+		if !seen[key] && !strings.Contains(comment, "deprecated") && !strings.Contains(comment, "alias for") {
+			d.preferred = true
+			seen[key] = true
+		}
+		defs = append(defs, d)
+		w("\tKey_%s Keysym = %s\n", d.name, d.sym)
 	}
-	w(")\n")
+	w(")\n\n")
+
+	w("var keysymDefs = []keysymDef{\n")
+	for _, d := range defs {
+		r := "-1"
+		if d.codepoint != "" {
+			r = "0x" + d.codepoint
+		}
+		w("\t{%q, Key_%s, %s, %v},\n", d.name, d.name, r, d.preferred)
+	}
+	w("}\n")
+
 	src, err := format.Source(buf.Bytes())
 	if err != nil {
 		log.Fatal(err)
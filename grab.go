@@ -0,0 +1,108 @@
+package wmutil
+
+import "github.com/BurntSushi/xgb/xproto"
+
+// GrabReport describes the outcome of grabbing configured strokes, a stroke not grabbed is not delivered
+type GrabReport struct {
+	Grabbed []Stroke
+	// already grabbed by another client
+	Conflicts []Stroke
+	// strokes failed with other errors
+	Failed []Stroke
+	// keysyms not on the current keyboard
+	Unmapped []Keysym
+	// buttons already grabbed by another client
+	ButtonConflicts []ButtonStroke
+	// error of Config.Rules.Validate, the rules are still applied
+	InvalidRules error
+}
+
+type keyGrab struct {
+	code      xproto.Keycode
+	modifiers uint16
+}
+
+func (w *Wm) grabKeys(config *wmConfig, strokes, syncStrokes []Stroke) *GrabReport {
+	report := new(GrabReport)
+	if err := w.Backend.UngrabKey(xproto.GrabAny, w.DefaultRootId, xproto.ModMaskAny).Check(); err != nil {
+		w.logger.Error("ungrab keys", requestAttr("UngrabKey"), errAttr(err))
+	}
+	ignoreModifiers := []uint16{
+		0,
+		xproto.ModMaskLock,
+		w.numlockModMask,
+		xproto.ModMaskLock | w.numlockModMask,
+	}
+	grab := func(stroke Stroke, keyboardMode byte) bool {
+		keycodes := w.SymToCodes[stroke.Sym]
+		if len(keycodes) == 0 {
+			report.Unmapped = append(report.Unmapped, stroke.Sym)
+			w.logger.Warn("keysym not on keyboard", "keysym", stroke.Sym)
+			return false
+		}
+		var grabbed []uint16
+		for _, code := range keycodes {
+			for _, mod := range ignoreModifiers {
+				err := w.Backend.GrabKey(true, w.DefaultRootId, stroke.Modifiers|mod,
+					xproto.Keycode(code), xproto.GrabModeAsync, keyboardMode).Check()
+				if err == nil {
+					grabbed = append(grabbed, uint16(code), stroke.Modifiers|mod)
+					continue
+				}
+				// release partial grabs of the stroke
+				for i := 0; i < len(grabbed); i += 2 {
+					if err := w.Backend.UngrabKey(xproto.Keycode(grabbed[i]), w.DefaultRootId, grabbed[i+1]).Check(); err != nil {
+						w.logger.Error("ungrab key", "stroke", stroke, requestAttr("UngrabKey"), errAttr(err))
+					}
+				}
+				if _, ok := err.(xproto.AccessError); ok {
+					report.Conflicts = append(report.Conflicts, stroke)
+					w.logger.Warn("stroke grabbed by another client", "stroke", stroke)
+				} else {
+					report.Failed = append(report.Failed, stroke)
+					w.logger.Error("grab key", "stroke", stroke, requestAttr("GrabKey"), errAttr(err))
+				}
+				return false
+			}
+		}
+		report.Grabbed = append(report.Grabbed, stroke)
+		return true
+	}
+	for _, stroke := range strokes {
+		grab(stroke, xproto.GrabModeAsync)
+	}
+	for _, stroke := range syncStrokes {
+		if !grab(stroke, xproto.GrabModeSync) {
+			continue
+		}
+		// presses are matched by keycode, the keysym of the event may differ, like a for A
+		for _, code := range w.SymToCodes[stroke.Sym] {
+			config.syncKeys[keyGrab{xproto.Keycode(code), stroke.Modifiers}] = stroke
+		}
+	}
+	return report
+}
+
+func (w *Wm) grabButtons(config *wmConfig, buttons []ButtonStroke, report *GrabReport) {
+	if err := w.Backend.UngrabButton(xproto.ButtonIndexAny, w.DefaultRootId, xproto.ModMaskAny).Check(); err != nil {
+		w.logger.Error("ungrab buttons", requestAttr("UngrabButton"), errAttr(err))
+	}
+loop:
+	for _, stroke := range buttons {
+		for _, mod := range []uint16{
+			0,
+			xproto.ModMaskLock,
+			w.numlockModMask,
+			xproto.ModMaskLock | w.numlockModMask,
+		} {
+			if err := w.Backend.GrabButton(false, w.DefaultRootId, xproto.EventMaskButtonPress,
+				xproto.GrabModeAsync, xproto.GrabModeAsync, xproto.WindowNone, xproto.CursorNone,
+				stroke.Button, stroke.Modifiers|mod).Check(); err != nil {
+				report.ButtonConflicts = append(report.ButtonConflicts, stroke)
+				w.logger.Warn("grab button", "button", stroke, requestAttr("GrabButton"), errAttr(err))
+				continue loop
+			}
+		}
+		config.buttons[stroke] = true
+	}
+}
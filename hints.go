@@ -0,0 +1,94 @@
+package wmutil
+
+// WM_NORMAL_HINTS flags
+const (
+	HintUSPosition = 1 << iota
+	HintUSSize
+	HintPPosition
+	HintPSize
+	HintPMinSize
+	HintPMaxSize
+	HintPResizeInc
+	HintPAspect
+	HintPBaseSize
+	HintPWinGravity
+)
+
+type NormalHints struct {
+	Flags                      uint32
+	MinWidth, MinHeight        int
+	MaxWidth, MaxHeight        int
+	WidthInc, HeightInc        int
+	MinAspectNum, MinAspectDen int
+	MaxAspectNum, MaxAspectDen int
+	BaseWidth, BaseHeight      int
+	WinGravity                 int
+}
+
+func parseNormalHints(values []uint32) (hints NormalHints) {
+	if len(values) == 0 {
+		return
+	}
+	// pad to 18 fields of the ICCCM structure
+	values = append(values, make([]uint32, 18)...)
+	hints.Flags = values[0]
+	// values[1:5] are obsolete x, y, width, height
+	ints := make([]int, 18)
+	for i, v := range values[:18] {
+		ints[i] = int(int32(v))
+	}
+	hints.MinWidth, hints.MinHeight = ints[5], ints[6]
+	hints.MaxWidth, hints.MaxHeight = ints[7], ints[8]
+	hints.WidthInc, hints.HeightInc = ints[9], ints[10]
+	hints.MinAspectNum, hints.MinAspectDen = ints[11], ints[12]
+	hints.MaxAspectNum, hints.MaxAspectDen = ints[13], ints[14]
+	hints.BaseWidth, hints.BaseHeight = ints[15], ints[16]
+	hints.WinGravity = ints[17]
+	return
+}
+
+// Strut is the space reserved at the screen edges by docks and panels.
+// ranges are the inclusive extents along the edges from _NET_WM_STRUT_PARTIAL, a zero range covers the whole edge
+type Strut struct {
+	Left, Right, Top, Bottom int
+
+	LeftStartY, LeftEndY     int
+	RightStartY, RightEndY   int
+	TopStartX, TopEndX       int
+	BottomStartX, BottomEndX int
+}
+
+func parseStrut(values []uint32) (strut Strut) {
+	if len(values) < 4 {
+		return
+	}
+	strut = Strut{
+		Left:   int(values[0]),
+		Right:  int(values[1]),
+		Top:    int(values[2]),
+		Bottom: int(values[3]),
+	}
+	if len(values) >= 12 {
+		strut.LeftStartY, strut.LeftEndY = int(values[4]), int(values[5])
+		strut.RightStartY, strut.RightEndY = int(values[6]), int(values[7])
+		strut.TopStartX, strut.TopEndX = int(values[8]), int(values[9])
+		strut.BottomStartX, strut.BottomEndX = int(values[10]), int(values[11])
+	}
+	return
+}
+
+// strutCovers reports whether the range of a strut edge overlaps [start, start+length)
+func strutCovers(rangeStart, rangeEnd, start, length int) bool {
+	if rangeStart == 0 && rangeEnd == 0 {
+		return true
+	}
+	return rangeStart < start+length && rangeEnd >= start
+}
+
+func (w *Wm) getStrut(win *Window) Strut {
+	values := win.GetUint32sProperty(w.Atom("_NET_WM_STRUT_PARTIAL"))
+	if len(values) == 0 {
+		values = win.GetUint32sProperty(w.Atom("_NET_WM_STRUT"))
+	}
+	return parseStrut(values)
+}
@@ -0,0 +1,171 @@
+package hook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/reusee/wmutil"
+)
+
+const (
+	EventMap       = "map"
+	EventUnmap     = "unmap"
+	EventFocus     = "focus"
+	EventName      = "name"
+	EventWorkspace = "workspace"
+)
+
+// Runner executes hook scripts in background. scripts of an event are executables in Dir/<event>.d, run in name order,
+// plus those in Scripts[event]. the directory is read by the worker at each event, so scripts can be added without restarting
+type Runner struct {
+	Dir     string
+	Scripts map[string][]string
+	// events handled at the same time, default 4
+	Concurrency int
+	// killed after, default 5s
+	Timeout time.Duration
+	// events waiting for a free worker, more are dropped, default 256
+	QueueSize int
+	Logger    *slog.Logger
+
+	once   sync.Once
+	jobs   chan job
+	wg     sync.WaitGroup
+	lock   sync.Mutex
+	closed bool
+}
+
+type job struct {
+	event string
+	env   []string
+}
+
+func (r *Runner) start() {
+	r.once.Do(func() {
+		concurrency := r.Concurrency
+		if concurrency <= 0 {
+			concurrency = 4
+		}
+		size := r.QueueSize
+		if size <= 0 {
+			size = 256
+		}
+		r.jobs = make(chan job, size)
+		for i := 0; i < concurrency; i++ {
+			r.wg.Add(1)
+			go func() {
+				defer r.wg.Done()
+				for j := range r.jobs {
+					for _, path := range r.scripts(j.event) {
+						r.exec(path, j.env)
+					}
+				}
+			}()
+		}
+	})
+}
+
+// Close waits for queued scripts to finish, later events are ignored
+func (r *Runner) Close() {
+	r.start()
+	r.lock.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.jobs)
+	}
+	r.lock.Unlock()
+	r.wg.Wait()
+}
+
+func (r *Runner) logError(msg string, args ...interface{}) {
+	if r.Logger != nil {
+		r.Logger.Error(msg, args...)
+	}
+}
+
+func (r *Runner) exec(path string, env []string) {
+	timeout := r.Timeout
+	if timeout <= 0 {
+		timeout = time.Second * 5
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.WaitDelay = time.Second
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if ctx.Err() != nil {
+			err = ctx.Err()
+		}
+		r.logError("hook failed", "path", path, "err", err, "output", string(out))
+	}
+}
+
+// scripts returns scripts to run for the event
+func (r *Runner) scripts(event string) (paths []string) {
+	if r.Dir != "" {
+		dir := filepath.Join(r.Dir, event+".d")
+		entries, _ := os.ReadDir(dir)
+		for _, entry := range entries {
+			info, err := entry.Info()
+			if err != nil || !info.Mode().IsRegular() || info.Mode()&0111 == 0 {
+				continue
+			}
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+		sort.Strings(paths)
+	}
+	return append(paths, r.Scripts[event]...)
+}
+
+// Run queues scripts of the event without blocking. win and workspace may be empty
+func (r *Runner) Run(event string, win *wmutil.Window, workspace string) {
+	r.start()
+	if r.Dir == "" && len(r.Scripts[event]) == 0 {
+		return
+	}
+	j := job{event: event, env: Env(event, win, workspace)}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.closed {
+		return
+	}
+	select {
+	case r.jobs <- j:
+	default:
+		r.logError("hook queue full, dropped", "event", event)
+	}
+}
+
+// Env returns environment variables describing the event
+func Env(event string, win *wmutil.Window, workspace string) []string {
+	env := []string{
+		"WM_EVENT=" + event,
+	}
+	if workspace != "" {
+		env = append(env, "WM_WORKSPACE="+workspace)
+	}
+	if win != nil {
+		win.ReadLock(func() {
+			env = append(env,
+				fmt.Sprintf("WM_WINDOW_ID=0x%x", win.Id),
+				"WM_WINDOW_CLASS="+win.Class,
+				"WM_WINDOW_INSTANCE="+win.Instance,
+				"WM_WINDOW_NAME="+win.Name,
+				"WM_WINDOW_X="+strconv.Itoa(win.X),
+				"WM_WINDOW_Y="+strconv.Itoa(win.Y),
+				"WM_WINDOW_WIDTH="+strconv.Itoa(win.Width),
+				"WM_WINDOW_HEIGHT="+strconv.Itoa(win.Height),
+			)
+		})
+	}
+	return env
+}
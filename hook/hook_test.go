@@ -0,0 +1,61 @@
+package hook
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/reusee/wmutil"
+)
+
+func TestRunner(t *testing.T) {
+	dir := t.TempDir()
+	out := filepath.Join(dir, "out")
+	if err := os.Mkdir(filepath.Join(dir, "map.d"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "map.d", "10-log"),
+		[]byte("#!/bin/sh\necho \"$WM_EVENT $WM_WINDOW_ID $WM_WINDOW_CLASS $WM_WINDOW_WIDTH\" >> "+out+"\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	hang := filepath.Join(dir, "hang")
+	if err := os.WriteFile(hang, []byte("#!/bin/sh\nsleep 10\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &Runner{
+		Dir: dir,
+		Scripts: map[string][]string{
+			EventMap: {hang},
+		},
+		Timeout: time.Millisecond * 200,
+	}
+	win := &wmutil.Window{
+		RWMutex: new(sync.RWMutex),
+		Id:      0x42,
+		Class:   "XTerm",
+		Width:   80,
+	}
+	t0 := time.Now()
+	r.Run(EventMap, win, "")
+	r.Run(EventUnmap, win, "")
+	if time.Since(t0) > time.Millisecond*100 {
+		t.Fatal("Run blocked")
+	}
+	r.Close()
+	if time.Since(t0) > time.Second*5 {
+		t.Fatal("hung script not killed")
+	}
+	// ignored after close
+	r.Run(EventMap, win, "")
+	data, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(string(data)); got != "map 0x42 XTerm 80" {
+		t.Fatalf("got %q", got)
+	}
+}
@@ -0,0 +1,106 @@
+package ipc
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+)
+
+type Client struct {
+	conn    net.Conn
+	scanner *bufio.Scanner
+	enc     *json.Encoder
+}
+
+func Dial(path string) (*Client, error) {
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(nil, 64<<20)
+	return &Client{
+		conn:    conn,
+		scanner: scanner,
+		enc:     json.NewEncoder(conn),
+	}, nil
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// CommandError is returned when the server replied a failure
+type CommandError struct {
+	Msg string
+}
+
+func (e *CommandError) Error() string {
+	return e.Msg
+}
+
+// Call sends the request and returns the data of the response. failed requests return *CommandError
+func (c *Client) Call(req Request) (json.RawMessage, error) {
+	if err := c.enc.Encode(req); err != nil {
+		return nil, err
+	}
+	return c.read()
+}
+
+func (c *Client) read() (json.RawMessage, error) {
+	if !c.scanner.Scan() {
+		if err := c.scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("connection closed")
+	}
+	var res struct {
+		Success bool
+		Error   string
+		Data    json.RawMessage
+	}
+	if err := json.Unmarshal(c.scanner.Bytes(), &res); err != nil {
+		return nil, err
+	}
+	if !res.Success {
+		return nil, &CommandError{Msg: res.Error}
+	}
+	return res.Data, nil
+}
+
+// Command runs a command line on the server
+func (c *Client) Command(line string) error {
+	_, err := c.Call(Request{
+		Type:    RequestCommand,
+		Command: line,
+	})
+	return err
+}
+
+// ErrStop stops Subscribe without error
+var ErrStop = errors.New("stop")
+
+// Subscribe calls fn with each event line until the connection closes or fn returns an error
+func (c *Client) Subscribe(types []string, fn func(ev Event, line []byte) error) error {
+	if _, err := c.Call(Request{
+		Type:   RequestSubscribe,
+		Events: types,
+	}); err != nil {
+		return err
+	}
+	for c.scanner.Scan() {
+		var ev Event
+		if err := json.Unmarshal(c.scanner.Bytes(), &ev); err != nil {
+			return err
+		}
+		if err := fn(ev, c.scanner.Bytes()); err != nil {
+			if err == ErrStop {
+				return nil
+			}
+			return err
+		}
+	}
+	return c.scanner.Err()
+}
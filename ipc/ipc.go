@@ -0,0 +1,127 @@
+package ipc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/reusee/wmutil"
+)
+
+// Request is a line of JSON sent by clients
+//
+//	{"type": "command", "command": "move-to-workspace 3"}
+//	{"type": "windows"}
+//	{"type": "monitors"}
+//	{"type": "workspaces"}
+//	{"type": "dump"}
+//	{"type": "subscribe", "events": ["map", "unmap"]}
+type Request struct {
+	Type    string `json:"type"`
+	Command string `json:"command,omitempty"`
+	// event types to subscribe, all if empty
+	Events []string `json:"events,omitempty"`
+}
+
+const (
+	RequestCommand    = "command"
+	RequestWindows    = "windows"
+	RequestMonitors   = "monitors"
+	RequestWorkspaces = "workspaces"
+	RequestDump       = "dump"
+	RequestSubscribe  = "subscribe"
+)
+
+// Response is a line of JSON replied for each request. a subscribe request is replied once, then followed by Event lines
+type Response struct {
+	Success bool        `json:"success"`
+	Error   string      `json:"error,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+const (
+	EventMap       = "map"
+	EventUnmap     = "unmap"
+	EventFocus     = "focus"
+	EventName      = "name"
+	EventWorkspace = "workspace"
+)
+
+var EventTypes = []string{EventMap, EventUnmap, EventFocus, EventName, EventWorkspace}
+
+type Event struct {
+	Type      string      `json:"type"`
+	Window    *WindowInfo `json:"window,omitempty"`
+	Workspace string      `json:"workspace,omitempty"`
+}
+
+type WindowInfo struct {
+	Id        uint32 `json:"id"`
+	Class     string `json:"class"`
+	Instance  string `json:"instance"`
+	Name      string `json:"name"`
+	X         int    `json:"x"`
+	Y         int    `json:"y"`
+	Width     int    `json:"width"`
+	Height    int    `json:"height"`
+	Mapped    bool   `json:"mapped"`
+	Workspace string `json:"workspace,omitempty"`
+}
+
+type Workspace struct {
+	Name    string `json:"name"`
+	Focused bool   `json:"focused"`
+	// index in Wm.Monitors of the monitor showing the workspace
+	Monitor int      `json:"monitor"`
+	Windows []uint32 `json:"windows"`
+}
+
+// NewWindowInfo snapshots the window, workspace is left for the caller
+func NewWindowInfo(win *wmutil.Window) *WindowInfo {
+	info := new(WindowInfo)
+	win.ReadLock(func() {
+		*info = WindowInfo{
+			Id:       uint32(win.Id),
+			Class:    win.Class,
+			Instance: win.Instance,
+			Name:     win.Name,
+			X:        win.X,
+			Y:        win.Y,
+			Width:    win.Width,
+			Height:   win.Height,
+			Mapped:   win.Mapped,
+		}
+	})
+	return info
+}
+
+// SocketPath returns the socket path of the display number, in XDG_RUNTIME_DIR or the temp dir
+func SocketPath(display int) string {
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return filepath.Join(dir, fmt.Sprintf("wmutil-%d.sock", display))
+	}
+	return filepath.Join(os.TempDir(), fmt.Sprintf("wmutil-%d-%d.sock", os.Getuid(), display))
+}
+
+// DefaultSocketPath returns WMUTIL_SOCKET, or the socket path of DISPLAY
+func DefaultSocketPath() (string, error) {
+	if path := os.Getenv("WMUTIL_SOCKET"); path != "" {
+		return path, nil
+	}
+	display := os.Getenv("DISPLAY")
+	i := strings.LastIndex(display, ":")
+	if i < 0 {
+		return "", fmt.Errorf("bad DISPLAY %q", display)
+	}
+	display = display[i+1:]
+	if i := strings.Index(display, "."); i >= 0 {
+		display = display[:i]
+	}
+	n, err := strconv.Atoi(display)
+	if err != nil {
+		return "", fmt.Errorf("bad DISPLAY %q", os.Getenv("DISPLAY"))
+	}
+	return SocketPath(n), nil
+}
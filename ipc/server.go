@@ -0,0 +1,258 @@
+package ipc
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/command"
+)
+
+// Server serves requests on a Unix socket. fields should be set before Listen
+type Server struct {
+	Wm       *wmutil.Wm
+	Commands *command.Registry
+	// runs parsed commands, defaults to running in the connection goroutine. set to hand them to the event loop
+	Run func(*command.Invocation) error
+	// optional workspace providers
+	Workspaces      func() []Workspace
+	WindowWorkspace func(*wmutil.Window) string
+	// events buffered for each subscriber, slower subscribers are disconnected
+	EventBuffer int
+
+	listener    net.Listener
+	path        string
+	lock        sync.Mutex
+	subscribers map[*subscriber]bool
+	closed      bool
+}
+
+type subscriber struct {
+	types  map[string]bool
+	events chan Event
+}
+
+// Listen creates the socket at path and serves in background. a stale socket file is removed
+func (s *Server) Listen(path string) error {
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return fmt.Errorf("%s is in use", path)
+	}
+	os.Remove(path)
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		ln.Close()
+		return err
+	}
+	s.lock.Lock()
+	s.listener = ln
+	s.path = path
+	s.subscribers = make(map[*subscriber]bool)
+	s.lock.Unlock()
+	go s.serve()
+	return nil
+}
+
+func (s *Server) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed || s.listener == nil {
+		return nil
+	}
+	s.closed = true
+	for sub := range s.subscribers {
+		close(sub.events)
+		delete(s.subscribers, sub)
+	}
+	err := s.listener.Close()
+	os.Remove(s.path)
+	return err
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			continue
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(nil, 1<<20)
+	enc := json.NewEncoder(conn)
+	for scanner.Scan() {
+		var req Request
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			enc.Encode(Response{Error: fmt.Sprintf("bad request: %v", err)})
+			continue
+		}
+		if req.Type == RequestSubscribe {
+			s.subscribe(conn, enc, req.Events)
+			return
+		}
+		data, err := s.do(req)
+		if err != nil {
+			enc.Encode(Response{Error: err.Error()})
+			continue
+		}
+		if err := enc.Encode(Response{Success: true, Data: data}); err != nil {
+			return
+		}
+	}
+}
+
+func (s *Server) do(req Request) (interface{}, error) {
+	switch req.Type {
+
+	case RequestCommand:
+		if s.Commands == nil {
+			return nil, fmt.Errorf("no commands")
+		}
+		inv, err := s.Commands.Parse(req.Command)
+		if err != nil {
+			return nil, err
+		}
+		if s.Run != nil {
+			return nil, s.Run(inv)
+		}
+		return nil, inv.Run()
+
+	case RequestWindows:
+		if s.Wm == nil {
+			return nil, fmt.Errorf("no wm")
+		}
+		infos := []*WindowInfo{}
+		for _, win := range s.Wm.AllWindows() {
+			infos = append(infos, s.WindowInfo(win))
+		}
+		return infos, nil
+
+	case RequestMonitors:
+		if s.Wm == nil {
+			return nil, fmt.Errorf("no wm")
+		}
+		return s.Wm.Monitors(), nil
+
+	case RequestDump:
+		if s.Wm == nil {
+			return nil, fmt.Errorf("no wm")
+		}
+		return s.Wm.State(), nil
+
+	case RequestWorkspaces:
+		if s.Workspaces == nil {
+			return []Workspace{}, nil
+		}
+		return s.Workspaces(), nil
+
+	}
+	return nil, fmt.Errorf("unknown request type %q", req.Type)
+}
+
+// WindowInfo is NewWindowInfo with workspace filled by WindowWorkspace
+func (s *Server) WindowInfo(win *wmutil.Window) *WindowInfo {
+	info := NewWindowInfo(win)
+	if s.WindowWorkspace != nil {
+		info.Workspace = s.WindowWorkspace(win)
+	}
+	return info
+}
+
+func (s *Server) subscribe(conn net.Conn, enc *json.Encoder, types []string) {
+	sub := &subscriber{
+		types: make(map[string]bool),
+	}
+	for _, t := range types {
+		known := false
+		for _, e := range EventTypes {
+			known = known || e == t
+		}
+		if !known {
+			enc.Encode(Response{Error: fmt.Sprintf("unknown event type %q", t)})
+			return
+		}
+		sub.types[t] = true
+	}
+	size := s.EventBuffer
+	if size <= 0 {
+		size = 256
+	}
+	sub.events = make(chan Event, size)
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return
+	}
+	s.subscribers[sub] = true
+	s.lock.Unlock()
+	if err := enc.Encode(Response{Success: true}); err != nil {
+		s.unsubscribe(sub)
+		return
+	}
+	// detect client close
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			if _, err := conn.Read(buf); err != nil {
+				s.unsubscribe(sub)
+				return
+			}
+		}
+	}()
+	for ev := range sub.events {
+		if err := enc.Encode(ev); err != nil {
+			s.unsubscribe(sub)
+			return
+		}
+	}
+}
+
+func (s *Server) unsubscribe(sub *subscriber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.subscribers[sub] {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
+
+// Publish sends the event to subscribers without blocking
+func (s *Server) Publish(ev Event) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for sub := range s.subscribers {
+		if len(sub.types) > 0 && !sub.types[ev.Type] {
+			continue
+		}
+		select {
+		case sub.events <- ev:
+		default:
+			// too slow
+			delete(s.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+// PublishWindow publishes a window event like EventMap
+func (s *Server) PublishWindow(typ string, win *wmutil.Window) {
+	s.Publish(Event{
+		Type:   typ,
+		Window: s.WindowInfo(win),
+	})
+}
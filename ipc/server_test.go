@@ -0,0 +1,83 @@
+package ipc
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/reusee/wmutil/command"
+)
+
+func TestServer(t *testing.T) {
+	commands := command.NewRegistry()
+	ran := 0
+	commands.MustRegister(&command.Command{
+		Name: "inc",
+		Func: func(command.Args) error {
+			ran++
+			return nil
+		},
+	})
+	server := &Server{
+		Commands: commands,
+	}
+	path := filepath.Join(t.TempDir(), "sock")
+	if err := server.Listen(path); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+
+	dial := func() (net.Conn, *bufio.Scanner, *json.Encoder) {
+		conn, err := net.Dial("unix", path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return conn, bufio.NewScanner(conn), json.NewEncoder(conn)
+	}
+	conn, scanner, enc := dial()
+	defer conn.Close()
+	call := func(req Request) (res Response) {
+		enc.Encode(req)
+		if !scanner.Scan() {
+			t.Fatal("no response")
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if res := call(Request{Type: RequestCommand, Command: "inc"}); !res.Success || ran != 1 {
+		t.Fatalf("got %+v", res)
+	}
+	if res := call(Request{Type: RequestCommand, Command: "nope"}); res.Success || res.Error == "" {
+		t.Fatalf("got %+v", res)
+	}
+	if res := call(Request{Type: "foo"}); res.Success {
+		t.Fatalf("got %+v", res)
+	}
+
+	sub, subScanner, subEnc := dial()
+	defer sub.Close()
+	subEnc.Encode(Request{Type: RequestSubscribe, Events: []string{EventWorkspace}})
+	if !subScanner.Scan() {
+		t.Fatal("no response")
+	}
+	server.Publish(Event{Type: EventMap})
+	server.Publish(Event{Type: EventWorkspace, Workspace: "web"})
+	if !subScanner.Scan() {
+		t.Fatal("no event")
+	}
+	var ev Event
+	if err := json.Unmarshal(subScanner.Bytes(), &ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.Type != EventWorkspace || ev.Workspace != "web" {
+		t.Fatalf("got %+v", ev)
+	}
+
+	if err := (&Server{}).Listen(path); err == nil {
+		t.Fatal("expecting in use error")
+	}
+}
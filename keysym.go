@@ -0,0 +1,85 @@
+package wmutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type Keysym uint32
+
+type keysymDef struct {
+	name      string
+	sym       Keysym
+	r         rune
+	preferred bool
+}
+
+var (
+	keysymToName = make(map[Keysym]string)
+	nameToKeysym = make(map[string]Keysym)
+	keysymToRune = make(map[Keysym]rune)
+)
+
+func init() {
+	for _, def := range keysymDefs {
+		nameToKeysym[def.name] = def.sym
+		if def.preferred {
+			keysymToName[def.sym] = def.name
+		}
+		if _, ok := keysymToRune[def.sym]; !ok && def.r >= 0 {
+			keysymToRune[def.sym] = def.r
+		}
+	}
+	// keysyms with deprecated names only
+	for _, def := range keysymDefs {
+		if _, ok := keysymToName[def.sym]; !ok {
+			keysymToName[def.sym] = def.name
+		}
+	}
+}
+
+// unicode keysyms are the codepoint plus 0x01000000
+const (
+	unicodeKeysymMin Keysym = 0x01000100
+	unicodeKeysymMax Keysym = 0x0110ffff
+)
+
+func (k Keysym) String() string {
+	if name, ok := keysymToName[k]; ok {
+		return name
+	}
+	if k >= unicodeKeysymMin && k <= unicodeKeysymMax {
+		return fmt.Sprintf("U%04X", uint32(k-0x01000000))
+	}
+	return fmt.Sprintf("0x%x", uint32(k))
+}
+
+// Rune returns the unicode character of the keysym, or -1 if it does not represent one
+func (k Keysym) Rune() rune {
+	if r, ok := keysymToRune[k]; ok {
+		return r
+	}
+	if k >= unicodeKeysymMin && k <= unicodeKeysymMax {
+		return rune(k - 0x01000000)
+	}
+	return -1
+}
+
+// KeysymByName accepts names as in keysymdef.h without the XK_ prefix, and the U+hex form for unicode characters
+func KeysymByName(name string) (Keysym, bool) {
+	if sym, ok := nameToKeysym[name]; ok {
+		return sym, true
+	}
+	if len(name) > 1 && name[0] == 'U' {
+		cp, err := strconv.ParseUint(strings.TrimPrefix(name[1:], "+"), 16, 32)
+		if err == nil && cp >= 0x20 && cp <= 0x10ffff {
+			// latin-1 characters have legacy keysyms equal to the codepoint
+			if cp < 0x100 {
+				return Keysym(cp), true
+			}
+			return Keysym(cp) + 0x01000000, true
+		}
+	}
+	return 0, false
+}
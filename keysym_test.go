@@ -0,0 +1,31 @@
+package wmutil
+
+import "testing"
+
+func TestKeysym(t *testing.T) {
+	if s := Key_Return.String(); s != "Return" {
+		t.Fatalf("got %s", s)
+	}
+	// deprecated alias resolves to the canonical name
+	if s := Key_quoteright.String(); s != "apostrophe" {
+		t.Fatalf("got %s", s)
+	}
+	if sym, ok := KeysymByName("Super_L"); !ok || sym != Key_Super_L {
+		t.Fatalf("got %v %v", sym, ok)
+	}
+	if _, ok := KeysymByName("no_such_key"); ok {
+		t.Fatal("should not resolve")
+	}
+	if sym, ok := KeysymByName("U263A"); !ok || sym.Rune() != '☺' {
+		t.Fatalf("got %v %v", sym, ok)
+	}
+	if r := Key_a.Rune(); r != 'a' {
+		t.Fatalf("got %c", r)
+	}
+	if r := Key_eacute.Rune(); r != 'é' {
+		t.Fatalf("got %c", r)
+	}
+	if r := Key_F1.Rune(); r != -1 {
+		t.Fatalf("got %c", r)
+	}
+}
@@ -0,0 +1,283 @@
+package layout
+
+import (
+	"math"
+
+	"github.com/reusee/wmutil"
+)
+
+type Rect = wmutil.Rect
+
+// Layout computes geometries of n windows in an area, in the order of the window list
+type Layout interface {
+	Arrange(area Rect, n int) []Rect
+}
+
+// Apply arranges windows in area, window borders are subtracted from the computed geometries. failed requests are returned joined.
+// windows without a rect from the layout are left in place
+func Apply(layout Layout, area Rect, windows []*wmutil.Window) error {
+	rects := layout.Arrange(area, len(windows))
+	var b wmutil.Batch
+	for i, win := range windows {
+		if i >= len(rects) {
+			b.Wait()
+			return ef("%T arranged %d rects for %d windows", layout, len(rects), len(windows))
+		}
+		r := rects[i]
+		var x, y, width, height, border int
+		win.ReadLock(func() {
+			x, y, width, height, border = win.X, win.Y, win.Width, win.Height, win.Border
+		})
+		r.Width -= border * 2
+		r.Height -= border * 2
+		if r.Width < 1 {
+			r.Width = 1
+		}
+		if r.Height < 1 {
+			r.Height = 1
+		}
+		if r.X == x && r.Y == y && r.Width == width && r.Height == height {
+			continue
+		}
+		b.SetGeometry(win, r.X, r.Y, r.Width, r.Height)
+	}
+	return b.Wait()
+}
+
+// split divides length into n segments separated by gap, returns offsets and sizes
+func split(length, n, gap int) (offsets, sizes []int) {
+	if n <= 0 {
+		return
+	}
+	avail := length - gap*(n-1)
+	if avail < n {
+		avail = n
+	}
+	offset := 0
+	for i := 0; i < n; i++ {
+		size := avail / n
+		if i < avail%n {
+			size++
+		}
+		offsets = append(offsets, offset)
+		sizes = append(sizes, size)
+		offset += size + gap
+	}
+	return
+}
+
+// stack places n windows in area vertically or horizontally
+func stack(area Rect, n, gap int, vertical bool) (ret []Rect) {
+	if vertical {
+		offsets, sizes := split(area.Height, n, gap)
+		for i := range offsets {
+			ret = append(ret, Rect{X: area.X, Y: area.Y + offsets[i], Width: area.Width, Height: sizes[i]})
+		}
+	} else {
+		offsets, sizes := split(area.Width, n, gap)
+		for i := range offsets {
+			ret = append(ret, Rect{X: area.X + offsets[i], Y: area.Y, Width: sizes[i], Height: area.Height})
+		}
+	}
+	return
+}
+
+// divide cuts area into two parts by ratio with gap in between
+func divide(area Rect, ratio float64, gap int, vertical bool) (a, b Rect) {
+	ratio = clampRatio(ratio)
+	a, b = area, area
+	if vertical {
+		avail := area.Height - gap
+		a.Height = int(math.Round(float64(avail) * ratio))
+		b.Y = area.Y + a.Height + gap
+		b.Height = avail - a.Height
+	} else {
+		avail := area.Width - gap
+		a.Width = int(math.Round(float64(avail) * ratio))
+		b.X = area.X + a.Width + gap
+		b.Width = avail - a.Width
+	}
+	return
+}
+
+func clampRatio(ratio float64) float64 {
+	if ratio <= 0 {
+		return 0.5
+	}
+	if ratio < 0.05 {
+		return 0.05
+	}
+	if ratio > 0.95 {
+		return 0.95
+	}
+	return ratio
+}
+
+func inset(area Rect, gap int) Rect {
+	if area.Width > gap*2 && area.Height > gap*2 {
+		area.X += gap
+		area.Y += gap
+		area.Width -= gap * 2
+		area.Height -= gap * 2
+	}
+	return area
+}
+
+// Tile puts Masters windows in the master area at the left, and stacks the others at the right
+type Tile struct {
+	// default 1
+	Masters int
+	// width of master area
+	Ratio float64
+	Gap   int
+}
+
+var _ Layout = new(Tile)
+
+func (t *Tile) Arrange(area Rect, n int) []Rect {
+	area = inset(area, t.Gap)
+	masters := t.Masters
+	if masters <= 0 {
+		masters = 1
+	}
+	if n <= masters {
+		return stack(area, n, t.Gap, true)
+	}
+	masterArea, stackArea := divide(area, t.Ratio, t.Gap, false)
+	return append(stack(masterArea, masters, t.Gap, true),
+		stack(stackArea, n-masters, t.Gap, true)...)
+}
+
+func (t *Tile) IncMasters(delta int) {
+	// zero means one master
+	if t.Masters <= 0 {
+		t.Masters = 1
+	}
+	t.Masters += delta
+	if t.Masters < 1 {
+		t.Masters = 1
+	}
+}
+
+func (t *Tile) IncRatio(delta float64) {
+	t.Ratio = clampRatio(clampRatio(t.Ratio) + delta)
+}
+
+// Columns places windows side by side in equal widths
+type Columns struct {
+	Gap int
+}
+
+var _ Layout = new(Columns)
+
+func (c *Columns) Arrange(area Rect, n int) []Rect {
+	return stack(inset(area, c.Gap), n, c.Gap, false)
+}
+
+// Grid places windows in rows and columns, the last row is filled by fewer windows
+type Grid struct {
+	Gap int
+}
+
+var _ Layout = new(Grid)
+
+func (g *Grid) Arrange(area Rect, n int) (ret []Rect) {
+	if n == 0 {
+		return
+	}
+	area = inset(area, g.Gap)
+	cols := int(math.Ceil(math.Sqrt(float64(n))))
+	rows := (n + cols - 1) / cols
+	for i, row := range stack(area, rows, g.Gap, true) {
+		count := cols
+		if i == rows-1 {
+			count = n - cols*(rows-1)
+		}
+		ret = append(ret, stack(row, count, g.Gap, false)...)
+	}
+	return
+}
+
+// Monocle makes all windows fill the area
+type Monocle struct {
+	Gap int
+}
+
+var _ Layout = new(Monocle)
+
+func (m *Monocle) Arrange(area Rect, n int) (ret []Rect) {
+	area = inset(area, m.Gap)
+	for i := 0; i < n; i++ {
+		ret = append(ret, area)
+	}
+	return
+}
+
+// Dwindle gives each window Ratio of the remaining area, splitting alternately horizontally and vertically towards the bottom right
+type Dwindle struct {
+	Ratio float64
+	Gap   int
+}
+
+var _ Layout = new(Dwindle)
+
+func (d *Dwindle) Arrange(area Rect, n int) []Rect {
+	return fibonacci(inset(area, d.Gap), n, d.Ratio, d.Gap, false)
+}
+
+// Spiral is like Dwindle, but the remaining area turns clockwise
+type Spiral struct {
+	Ratio float64
+	Gap   int
+}
+
+var _ Layout = new(Spiral)
+
+func (s *Spiral) Arrange(area Rect, n int) []Rect {
+	return fibonacci(inset(area, s.Gap), n, s.Ratio, s.Gap, true)
+}
+
+func fibonacci(area Rect, n int, ratio float64, gap int, spiral bool) (ret []Rect) {
+	for i := 0; i < n; i++ {
+		if i == n-1 {
+			ret = append(ret, area)
+			break
+		}
+		vertical := i%2 == 1
+		// spiral takes the far side at every third and fourth step
+		reverse := spiral && i%4 >= 2
+		r := ratio
+		if reverse {
+			r = 1 - clampRatio(ratio)
+		}
+		a, b := divide(area, r, gap, vertical)
+		if reverse {
+			a, b = b, a
+		}
+		ret = append(ret, a)
+		area = b
+	}
+	return
+}
+
+// Names lists layouts accepted by New
+var Names = []string{"tile", "columns", "grid", "monocle", "dwindle", "spiral"}
+
+// New returns the named layout with parameters, parameters not used by the layout are ignored
+func New(name string, masters int, ratio float64, gap int) (Layout, bool) {
+	switch name {
+	case "tile":
+		return &Tile{Masters: masters, Ratio: ratio, Gap: gap}, true
+	case "columns":
+		return &Columns{Gap: gap}, true
+	case "grid":
+		return &Grid{Gap: gap}, true
+	case "monocle":
+		return &Monocle{Gap: gap}, true
+	case "dwindle":
+		return &Dwindle{Ratio: ratio, Gap: gap}, true
+	case "spiral":
+		return &Spiral{Ratio: ratio, Gap: gap}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,134 @@
+package layout
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/reusee/wmutil"
+)
+
+func TestTile(t *testing.T) {
+	tile := &Tile{
+		Masters: 1,
+		Ratio:   0.5,
+	}
+	rects := tile.Arrange(Rect{X: 0, Y: 0, Width: 100, Height: 100}, 3)
+	expected := []Rect{
+		{X: 0, Y: 0, Width: 50, Height: 100},
+		{X: 50, Y: 0, Width: 50, Height: 50},
+		{X: 50, Y: 50, Width: 50, Height: 50},
+	}
+	if !reflect.DeepEqual(rects, expected) {
+		t.Fatalf("got %v", rects)
+	}
+
+	tile.Gap = 10
+	rects = tile.Arrange(Rect{X: 0, Y: 0, Width: 110, Height: 110}, 2)
+	expected = []Rect{
+		{X: 10, Y: 10, Width: 40, Height: 90},
+		{X: 60, Y: 10, Width: 40, Height: 90},
+	}
+	if !reflect.DeepEqual(rects, expected) {
+		t.Fatalf("got %v", rects)
+	}
+
+	// all masters
+	tile.Gap = 0
+	tile.IncMasters(2)
+	rects = tile.Arrange(Rect{X: 0, Y: 0, Width: 100, Height: 90}, 3)
+	expected = []Rect{
+		{X: 0, Y: 0, Width: 100, Height: 30},
+		{X: 0, Y: 30, Width: 100, Height: 30},
+		{X: 0, Y: 60, Width: 100, Height: 30},
+	}
+	if !reflect.DeepEqual(rects, expected) {
+		t.Fatalf("got %v", rects)
+	}
+}
+
+func TestTileDefaultMasters(t *testing.T) {
+	rects := new(Tile).Arrange(Rect{Width: 100, Height: 100}, 2)
+	expected := []Rect{
+		{X: 0, Y: 0, Width: 50, Height: 100},
+		{X: 50, Y: 0, Width: 50, Height: 100},
+	}
+	if !reflect.DeepEqual(rects, expected) {
+		t.Fatalf("got %v", rects)
+	}
+
+	tile := new(Tile)
+	tile.IncMasters(1)
+	if tile.Masters != 2 {
+		t.Fatalf("got %d", tile.Masters)
+	}
+}
+
+// short arranges no windows
+type short struct{}
+
+func (short) Arrange(area Rect, n int) []Rect {
+	return nil
+}
+
+func TestApplyShortLayout(t *testing.T) {
+	win := &wmutil.Window{
+		RWMutex: new(sync.RWMutex),
+	}
+	if err := Apply(short{}, Rect{Width: 100, Height: 100}, []*wmutil.Window{win}); err == nil {
+		t.Fatal("expecting error")
+	}
+}
+
+func TestGrid(t *testing.T) {
+	rects := new(Grid).Arrange(Rect{X: 0, Y: 0, Width: 100, Height: 100}, 3)
+	expected := []Rect{
+		{X: 0, Y: 0, Width: 50, Height: 50},
+		{X: 50, Y: 0, Width: 50, Height: 50},
+		{X: 0, Y: 50, Width: 100, Height: 50},
+	}
+	if !reflect.DeepEqual(rects, expected) {
+		t.Fatalf("got %v", rects)
+	}
+}
+
+func TestSpiral(t *testing.T) {
+	rects := (&Spiral{Ratio: 0.5}).Arrange(Rect{X: 0, Y: 0, Width: 100, Height: 100}, 4)
+	expected := []Rect{
+		{X: 0, Y: 0, Width: 50, Height: 100},
+		{X: 50, Y: 0, Width: 50, Height: 50},
+		{X: 75, Y: 50, Width: 25, Height: 50},
+		{X: 50, Y: 50, Width: 25, Height: 50},
+	}
+	if !reflect.DeepEqual(rects, expected) {
+		t.Fatalf("got %v", rects)
+	}
+}
+
+func TestNoOverlap(t *testing.T) {
+	area := Rect{X: 10, Y: 20, Width: 1000, Height: 700}
+	for _, layout := range []Layout{
+		&Tile{Masters: 2, Ratio: 0.6, Gap: 5},
+		&Columns{Gap: 3},
+		&Grid{Gap: 4},
+		&Dwindle{Ratio: 0.5, Gap: 2},
+		&Spiral{Ratio: 0.6, Gap: 2},
+	} {
+		for n := 0; n < 12; n++ {
+			rects := layout.Arrange(area, n)
+			if len(rects) != n {
+				t.Fatalf("%T: %d rects for %d windows", layout, len(rects), n)
+			}
+			for i, a := range rects {
+				if a.Intersect(area) != a {
+					t.Fatalf("%T: %v out of area", layout, a)
+				}
+				for _, b := range rects[i+1:] {
+					if a.Intersect(b).Area() > 0 {
+						t.Fatalf("%T: %v overlaps %v", layout, a, b)
+					}
+				}
+			}
+		}
+	}
+}
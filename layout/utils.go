@@ -0,0 +1,7 @@
+package layout
+
+import "fmt"
+
+var (
+	ef = fmt.Errorf
+)
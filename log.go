@@ -0,0 +1,27 @@
+package wmutil
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// common attributes of log records
+
+func windowAttr(id xproto.Window) slog.Attr {
+	return slog.String("window", fmt.Sprintf("0x%x", id))
+}
+
+func atomAttr(name string) slog.Attr {
+	return slog.String("atom", name)
+}
+
+// requestAttr is the X request type, like ConfigureWindow
+func requestAttr(request string) slog.Attr {
+	return slog.String("request", request)
+}
+
+func errAttr(err error) slog.Attr {
+	return slog.Any("err", err)
+}
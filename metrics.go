@@ -0,0 +1,336 @@
+package wmutil
+
+import (
+	"errors"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xinerama"
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Metrics are counters and histograms of the event loop, as expvar variables. Var returns all of them as one
+type Metrics struct {
+	// events by type, like MapRequest
+	Events *expvar.Map
+	// time handling each event, by event type
+	EventTime *expvar.Map
+	// time blocked sending on Wm channels, by channel like Map
+	SendTime *expvar.Map
+	// requests by type, like ConfigureWindow
+	Requests *expvar.Map
+	// time from sending a request to its reply or check returned, by request type.
+	// it includes the time the wm did other work before waiting, requests never waited are not observed
+	ReplyTime *expvar.Map
+	// X errors by kind, like BadWindow
+	Errors *expvar.Map
+}
+
+func newMetrics() *Metrics {
+	return &Metrics{
+		Events:    new(expvar.Map).Init(),
+		EventTime: new(expvar.Map).Init(),
+		SendTime:  new(expvar.Map).Init(),
+		Requests:  new(expvar.Map).Init(),
+		ReplyTime: new(expvar.Map).Init(),
+		Errors:    new(expvar.Map).Init(),
+	}
+}
+
+// Var returns the metrics as a single expvar variable
+func (m *Metrics) Var() expvar.Var {
+	v := new(expvar.Map).Init()
+	v.Set("events", m.Events)
+	v.Set("event_time", m.EventTime)
+	v.Set("send_time", m.SendTime)
+	v.Set("requests", m.Requests)
+	v.Set("reply_time", m.ReplyTime)
+	v.Set("errors", m.Errors)
+	return v
+}
+
+// Publish publishes the metrics in expvar under name. it panics if the name is used, like expvar.Publish
+func (m *Metrics) Publish(name string) {
+	expvar.Publish(name, m.Var())
+}
+
+var histogramsLock sync.Mutex
+
+func observe(m *expvar.Map, key string, d time.Duration) {
+	h, ok := m.Get(key).(*Histogram)
+	if !ok {
+		histogramsLock.Lock()
+		if h, ok = m.Get(key).(*Histogram); !ok {
+			h = new(Histogram)
+			m.Set(key, h)
+		}
+		histogramsLock.Unlock()
+	}
+	h.Observe(d)
+}
+
+func (m *Metrics) countError(err error) {
+	if err == nil {
+		return
+	}
+	m.Errors.Add(errorKind(err), 1)
+}
+
+// errorKind returns names like BadWindow for X errors
+func errorKind(err error) string {
+	var xerr xgb.Error
+	if errors.As(err, &xerr) {
+		if kind, _, _ := strings.Cut(xerr.Error(), " "); kind != "" {
+			return kind
+		}
+	}
+	return "other"
+}
+
+// upper bounds of histogram buckets
+var histogramBounds = []time.Duration{
+	time.Microsecond * 10,
+	time.Microsecond * 100,
+	time.Millisecond,
+	time.Millisecond * 5,
+	time.Millisecond * 10,
+	time.Millisecond * 50,
+	time.Millisecond * 100,
+	time.Millisecond * 500,
+	time.Second,
+}
+
+// Histogram counts durations in fixed buckets from 10µs to 1s. it is an expvar.Var
+type Histogram struct {
+	count   atomic.Int64
+	sum     atomic.Int64
+	max     atomic.Int64
+	buckets [10]atomic.Int64
+}
+
+func (h *Histogram) Observe(d time.Duration) {
+	h.count.Add(1)
+	h.sum.Add(int64(d))
+	for {
+		m := h.max.Load()
+		if int64(d) <= m || h.max.CompareAndSwap(m, int64(d)) {
+			break
+		}
+	}
+	i := 0
+	for i < len(histogramBounds) && d > histogramBounds[i] {
+		i++
+	}
+	h.buckets[i].Add(1)
+}
+
+func (h *Histogram) Count() int64 {
+	return h.count.Load()
+}
+
+// String returns JSON like {"count": 3, "sum_us": 120, "max_us": 80, "buckets": {"10us": 1, "100us": 2, ...}}, buckets are not cumulative
+func (h *Histogram) String() string {
+	b := new(strings.Builder)
+	fmt.Fprintf(b, `{"count": %d, "sum_us": %d, "max_us": %d, "buckets": {`,
+		h.count.Load(), time.Duration(h.sum.Load()).Microseconds(), time.Duration(h.max.Load()).Microseconds())
+	for i := range h.buckets {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		bound := "inf"
+		if i < len(histogramBounds) {
+			bound = histogramBounds[i].String()
+		}
+		fmt.Fprintf(b, "%s: %d", strconv.Quote(bound), h.buckets[i].Load())
+	}
+	b.WriteString("}}")
+	return b.String()
+}
+
+// eventName returns names like MapRequest
+func eventName(ev xgb.Event) string {
+	name := fmt.Sprintf("%T", ev)
+	name = name[strings.LastIndex(name, ".")+1:]
+	return strings.TrimSuffix(name, "Event")
+}
+
+// send delivers v on ch, recording the time blocked
+func send[T any](w *Wm, name string, ch chan T, v T) {
+	start := time.Now()
+	ch <- v
+	observe(w.Metrics.SendTime, name, time.Since(start))
+}
+
+// ServeMetrics serves the expvar variables of the process over HTTP on a Unix socket at path,
+// like curl --unix-socket path http://wm/debug/vars. a stale socket at path is replaced, other files are not
+func ServeMetrics(path string) (*http.Server, error) {
+	if conn, err := net.Dial("unix", path); err == nil {
+		conn.Close()
+		return nil, ef("%s is in use", path)
+	}
+	if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		os.Remove(path)
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		ln.Close()
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+	server := &http.Server{Handler: mux}
+	go server.Serve(ln)
+	return server, nil
+}
+
+// meteredBackend counts requests, reply times and errors
+type meteredBackend struct {
+	Backend
+	metrics *Metrics
+}
+
+type meteredVoid struct {
+	cookie  VoidCookie
+	name    string
+	start   time.Time
+	metrics *Metrics
+}
+
+func (c meteredVoid) Check() error {
+	err := c.cookie.Check()
+	observe(c.metrics.ReplyTime, c.name, time.Since(c.start))
+	c.metrics.countError(err)
+	return err
+}
+
+type meteredCookie[T any] struct {
+	cookie  Cookie[T]
+	name    string
+	start   time.Time
+	metrics *Metrics
+}
+
+func (c meteredCookie[T]) Reply() (T, error) {
+	reply, err := c.cookie.Reply()
+	observe(c.metrics.ReplyTime, c.name, time.Since(c.start))
+	c.metrics.countError(err)
+	return reply, err
+}
+
+func (b *meteredBackend) void(name string, cookie VoidCookie) VoidCookie {
+	b.metrics.Requests.Add(name, 1)
+	return meteredVoid{cookie, name, time.Now(), b.metrics}
+}
+
+func metered[T any](b *meteredBackend, name string, cookie Cookie[T]) Cookie[T] {
+	b.metrics.Requests.Add(name, 1)
+	return meteredCookie[T]{cookie, name, time.Now(), b.metrics}
+}
+
+func (b *meteredBackend) ChangeWindowAttributes(window xproto.Window, valueMask uint32, valueList []uint32) VoidCookie {
+	return b.void("ChangeWindowAttributes", b.Backend.ChangeWindowAttributes(window, valueMask, valueList))
+}
+
+func (b *meteredBackend) ConfigureWindow(window xproto.Window, valueMask uint16, valueList []uint32) VoidCookie {
+	return b.void("ConfigureWindow", b.Backend.ConfigureWindow(window, valueMask, valueList))
+}
+
+func (b *meteredBackend) MapWindow(window xproto.Window) VoidCookie {
+	return b.void("MapWindow", b.Backend.MapWindow(window))
+}
+
+func (b *meteredBackend) DestroyWindow(window xproto.Window) VoidCookie {
+	return b.void("DestroyWindow", b.Backend.DestroyWindow(window))
+}
+
+func (b *meteredBackend) SendEvent(propagate bool, destination xproto.Window, eventMask uint32, event string) VoidCookie {
+	return b.void("SendEvent", b.Backend.SendEvent(propagate, destination, eventMask, event))
+}
+
+func (b *meteredBackend) ChangeProperty(mode byte, window xproto.Window, property, typ xproto.Atom, format byte, dataLen uint32, data []byte) VoidCookie {
+	return b.void("ChangeProperty", b.Backend.ChangeProperty(mode, window, property, typ, format, dataLen, data))
+}
+
+func (b *meteredBackend) GetProperty(delete bool, window xproto.Window, property, typ xproto.Atom, longOffset, longLength uint32) Cookie[*xproto.GetPropertyReply] {
+	return metered(b, "GetProperty", b.Backend.GetProperty(delete, window, property, typ, longOffset, longLength))
+}
+
+func (b *meteredBackend) InternAtom(onlyIfExists bool, name string) Cookie[*xproto.InternAtomReply] {
+	return metered(b, "InternAtom", b.Backend.InternAtom(onlyIfExists, name))
+}
+
+func (b *meteredBackend) GetAtomName(atom xproto.Atom) Cookie[*xproto.GetAtomNameReply] {
+	return metered(b, "GetAtomName", b.Backend.GetAtomName(atom))
+}
+
+func (b *meteredBackend) GetInputFocus() Cookie[*xproto.GetInputFocusReply] {
+	return metered(b, "GetInputFocus", b.Backend.GetInputFocus())
+}
+
+func (b *meteredBackend) SetInputFocus(revertTo byte, focus xproto.Window, time xproto.Timestamp) VoidCookie {
+	return b.void("SetInputFocus", b.Backend.SetInputFocus(revertTo, focus, time))
+}
+
+func (b *meteredBackend) QueryTree(window xproto.Window) Cookie[*xproto.QueryTreeReply] {
+	return metered(b, "QueryTree", b.Backend.QueryTree(window))
+}
+
+func (b *meteredBackend) QueryPointer(window xproto.Window) Cookie[*xproto.QueryPointerReply] {
+	return metered(b, "QueryPointer", b.Backend.QueryPointer(window))
+}
+
+func (b *meteredBackend) WarpPointer(srcWindow, dstWindow xproto.Window, srcX, srcY int16, srcWidth, srcHeight uint16, dstX, dstY int16) VoidCookie {
+	return b.void("WarpPointer", b.Backend.WarpPointer(srcWindow, dstWindow, srcX, srcY, srcWidth, srcHeight, dstX, dstY))
+}
+
+func (b *meteredBackend) GetKeyboardMapping(firstKeycode xproto.Keycode, count byte) Cookie[*xproto.GetKeyboardMappingReply] {
+	return metered(b, "GetKeyboardMapping", b.Backend.GetKeyboardMapping(firstKeycode, count))
+}
+
+func (b *meteredBackend) GetModifierMapping() Cookie[*xproto.GetModifierMappingReply] {
+	return metered(b, "GetModifierMapping", b.Backend.GetModifierMapping())
+}
+
+func (b *meteredBackend) GrabKey(ownerEvents bool, grabWindow xproto.Window, modifiers uint16, key xproto.Keycode, pointerMode, keyboardMode byte) VoidCookie {
+	return b.void("GrabKey", b.Backend.GrabKey(ownerEvents, grabWindow, modifiers, key, pointerMode, keyboardMode))
+}
+
+func (b *meteredBackend) UngrabKey(key xproto.Keycode, grabWindow xproto.Window, modifiers uint16) VoidCookie {
+	return b.void("UngrabKey", b.Backend.UngrabKey(key, grabWindow, modifiers))
+}
+
+func (b *meteredBackend) GrabKeyboard(ownerEvents bool, grabWindow xproto.Window, time xproto.Timestamp, pointerMode, keyboardMode byte) Cookie[*xproto.GrabKeyboardReply] {
+	return metered(b, "GrabKeyboard", b.Backend.GrabKeyboard(ownerEvents, grabWindow, time, pointerMode, keyboardMode))
+}
+
+func (b *meteredBackend) UngrabKeyboard(time xproto.Timestamp) VoidCookie {
+	return b.void("UngrabKeyboard", b.Backend.UngrabKeyboard(time))
+}
+
+func (b *meteredBackend) AllowEvents(mode byte, time xproto.Timestamp) VoidCookie {
+	return b.void("AllowEvents", b.Backend.AllowEvents(mode, time))
+}
+
+func (b *meteredBackend) GrabButton(ownerEvents bool, grabWindow xproto.Window, eventMask uint16, pointerMode, keyboardMode byte, confineTo xproto.Window, cursor xproto.Cursor, button byte, modifiers uint16) VoidCookie {
+	return b.void("GrabButton", b.Backend.GrabButton(ownerEvents, grabWindow, eventMask, pointerMode, keyboardMode, confineTo, cursor, button, modifiers))
+}
+
+func (b *meteredBackend) UngrabButton(button byte, grabWindow xproto.Window, modifiers uint16) VoidCookie {
+	return b.void("UngrabButton", b.Backend.UngrabButton(button, grabWindow, modifiers))
+}
+
+func (b *meteredBackend) QueryScreens() Cookie[*xinerama.QueryScreensReply] {
+	return metered(b, "QueryScreens", b.Backend.QueryScreens())
+}
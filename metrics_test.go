@@ -0,0 +1,21 @@
+package wmutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestServeMetrics(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.sock")
+	if err := os.WriteFile(path, []byte("data"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// not a socket, kept
+	if _, err := ServeMetrics(path); err == nil {
+		t.Fatal("expecting error")
+	}
+	if data, err := os.ReadFile(path); err != nil || string(data) != "data" {
+		t.Fatalf("got %q %v", data, err)
+	}
+}
@@ -0,0 +1,85 @@
+package wmutil
+
+// Monitors returns the geometries of physical monitors, or the whole screen if xinerama is not available
+func (w *Wm) Monitors() (ret []Rect) {
+	if w.hasXinerama {
+		reply, err := w.Backend.QueryScreens().Reply()
+		if err != nil {
+			w.logger.Error("query screens", requestAttr("QueryScreens"), errAttr(err))
+		} else {
+			for _, screen := range reply.ScreenInfo {
+				ret = append(ret, Rect{int(screen.XOrg), int(screen.YOrg), int(screen.Width), int(screen.Height)})
+			}
+		}
+	}
+	if len(ret) == 0 {
+		ret = append(ret, Rect{0, 0, int(w.DefaultScreen.WidthInPixels), int(w.DefaultScreen.HeightInPixels)})
+	}
+	return
+}
+
+// MonitorAt returns the monitor containing the point, or the nearest one
+func (w *Wm) MonitorAt(x, y int) Rect {
+	monitors := w.Monitors()
+	best, bestDist := monitors[0], -1
+	for _, m := range monitors {
+		if m.Contains(x, y) {
+			return m
+		}
+		dx := max(m.X-x, 0, x-(m.X+m.Width-1))
+		dy := max(m.Y-y, 0, y-(m.Y+m.Height-1))
+		if dist := dx*dx + dy*dy; bestDist < 0 || dist < bestDist {
+			best, bestDist = m, dist
+		}
+	}
+	return best
+}
+
+func (w *Wm) Pointer() (x, y int) {
+	reply, err := w.Backend.QueryPointer(w.DefaultRootId).Reply()
+	if err != nil {
+		w.logger.Error("query pointer", requestAttr("QueryPointer"), errAttr(err))
+		return
+	}
+	return int(reply.RootX), int(reply.RootY)
+}
+
+// WorkArea returns the part of the monitor not reserved by struts of mapped windows, a strut applies to the monitors its range overlaps
+func (w *Wm) WorkArea(monitor Rect) Rect {
+	screenWidth := int(w.DefaultScreen.WidthInPixels)
+	screenHeight := int(w.DefaultScreen.HeightInPixels)
+	area := monitor
+	for _, win := range w.AllWindows() {
+		var strut Strut
+		var mapped bool
+		win.ReadLock(func() {
+			strut, mapped = win.Strut, win.Mapped
+		})
+		if !mapped || strut == (Strut{}) {
+			continue
+		}
+		// struts are relative to screen edges
+		if left := strut.Left - area.X; left > 0 && strut.Left < area.X+area.Width &&
+			strutCovers(strut.LeftStartY, strut.LeftEndY, monitor.Y, monitor.Height) {
+			area.X += left
+			area.Width -= left
+		}
+		if right := (area.X + area.Width) - (screenWidth - strut.Right); right > 0 && strut.Right > 0 &&
+			strutCovers(strut.RightStartY, strut.RightEndY, monitor.Y, monitor.Height) {
+			area.Width -= right
+		}
+		if top := strut.Top - area.Y; top > 0 && strut.Top < area.Y+area.Height &&
+			strutCovers(strut.TopStartX, strut.TopEndX, monitor.X, monitor.Width) {
+			area.Y += top
+			area.Height -= top
+		}
+		if bottom := (area.Y + area.Height) - (screenHeight - strut.Bottom); bottom > 0 && strut.Bottom > 0 &&
+			strutCovers(strut.BottomStartX, strut.BottomEndX, monitor.X, monitor.Width) {
+			area.Height -= bottom
+		}
+	}
+	if area.Width <= 0 || area.Height <= 0 {
+		return monitor
+	}
+	return area
+}
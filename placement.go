@@ -0,0 +1,144 @@
+package wmutil
+
+import "github.com/BurntSushi/xgb/xproto"
+
+type Placement int
+
+const (
+	// keep the position requested by the client
+	PlaceNone Placement = iota
+	// center on the monitor containing the pointer
+	PlaceCenter
+	// center over the transient parent, or PlaceCenter if not transient
+	PlaceCenterParent
+	// center under the pointer
+	PlaceUnderPointer
+	// cascade from the top left corner of the work area
+	PlaceCascade
+	// find the position overlapping least with other mapped windows
+	PlaceSmart
+)
+
+const cascadeStep = 24
+
+// Place moves the window by the placement policy, within the work area of its monitor
+func (w *Wm) Place(win *Window, placement Placement) {
+	var b Batch
+	w.place(&b, win, placement)
+	b.Wait()
+}
+
+func (w *Wm) place(b *Batch, win *Window, placement Placement) {
+	if placement == PlaceNone {
+		return
+	}
+	var x, y, width, height int
+	var transientFor xproto.Window
+	win.ReadLock(func() {
+		width = win.Width + win.Border*2
+		height = win.Height + win.Border*2
+		transientFor = win.TransientFor
+	})
+	px, py := w.Pointer()
+	monitor := w.MonitorAt(px, py)
+
+	var parent *Window
+	if placement == PlaceCenterParent {
+		parent = w.Window(transientFor)
+		if parent == nil {
+			placement = PlaceCenter
+		} else {
+			parent.ReadLock(func() {
+				monitor = w.MonitorAt(parent.X+parent.Width/2, parent.Y+parent.Height/2)
+			})
+		}
+	}
+	area := w.WorkArea(monitor)
+
+	switch placement {
+	case PlaceCenter:
+		x = area.X + (area.Width-width)/2
+		y = area.Y + (area.Height-height)/2
+	case PlaceCenterParent:
+		parent.ReadLock(func() {
+			x = parent.X + (parent.Width+parent.Border*2-width)/2
+			y = parent.Y + (parent.Height+parent.Border*2-height)/2
+		})
+	case PlaceUnderPointer:
+		x = px - width/2
+		y = py - height/2
+	case PlaceCascade:
+		w.cascadeLock.Lock()
+		offset := w.cascadeIndex * cascadeStep
+		if offset+width > area.Width || offset+height > area.Height {
+			w.cascadeIndex = 0
+			offset = 0
+		}
+		w.cascadeIndex++
+		w.cascadeLock.Unlock()
+		x = area.X + offset
+		y = area.Y + offset
+	case PlaceSmart:
+		x, y = w.smartPosition(win, area, width, height)
+	}
+
+	// keep in work area
+	if x+width > area.X+area.Width {
+		x = area.X + area.Width - width
+	}
+	if y+height > area.Y+area.Height {
+		y = area.Y + area.Height - height
+	}
+	if x < area.X {
+		x = area.X
+	}
+	if y < area.Y {
+		y = area.Y
+	}
+	b.SetPos(win, x, y)
+}
+
+// smartPosition tries positions aligned to the work area and edges of other windows
+func (w *Wm) smartPosition(win *Window, area Rect, width, height int) (x, y int) {
+	var others []Rect
+	xs := []int{area.X, area.X + area.Width - width}
+	ys := []int{area.Y, area.Y + area.Height - height}
+	for _, other := range w.AllWindows() {
+		if other == win {
+			continue
+		}
+		other.ReadLock(func() {
+			if !other.Mapped {
+				return
+			}
+			r := Rect{other.X, other.Y, other.Width + other.Border*2, other.Height + other.Border*2}
+			if r.Intersect(area).Area() == 0 {
+				return
+			}
+			others = append(others, r)
+			xs = append(xs, r.X+r.Width, r.X-width)
+			ys = append(ys, r.Y+r.Height, r.Y-height)
+		})
+	}
+	best := -1
+	x, y = area.X, area.Y
+	for _, cy := range ys {
+		for _, cx := range xs {
+			candidate := Rect{cx, cy, width, height}
+			if cx < area.X || cy < area.Y || cx+width > area.X+area.Width || cy+height > area.Y+area.Height {
+				continue
+			}
+			overlap := 0
+			for _, r := range others {
+				overlap += candidate.Intersect(r).Area()
+			}
+			// prefer top left positions on ties
+			if best < 0 || overlap < best ||
+				(overlap == best && (cy < y || (cy == y && cx < x))) {
+				best = overlap
+				x, y = cx, cy
+			}
+		}
+	}
+	return
+}
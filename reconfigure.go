@@ -0,0 +1,87 @@
+package wmutil
+
+import (
+	"time"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// wmConfig holds the parts of Config that can be changed by Reconfigure
+type wmConfig struct {
+	sequencer *sequencer
+	binder    *binder
+	// sync grabbed strokes by keycode and cleaned modifiers
+	syncKeys    map[keyGrab]Stroke
+	syncTimeout time.Duration
+	buttons     map[ButtonStroke]bool
+	placement   func(*Window) Placement
+	rules       Rules
+	report      *GrabReport
+}
+
+func (w *Wm) currentConfig() *wmConfig {
+	w.configLock.RLock()
+	defer w.configLock.RUnlock()
+	return w.config
+}
+
+// Reconfigure regrabs strokes and buttons, and replaces rules and placement policy. Logger is not changed
+func (w *Wm) Reconfigure(config *Config) *GrabReport {
+	c := &wmConfig{
+		syncKeys:    make(map[keyGrab]Stroke),
+		syncTimeout: config.SyncTimeout,
+		buttons:     make(map[ButtonStroke]bool),
+		placement:   config.Placement,
+		rules:       config.Rules,
+	}
+	if c.syncTimeout <= 0 {
+		c.syncTimeout = time.Millisecond * 500
+	}
+	strokes := config.Strokes
+	if len(config.Sequences) > 0 {
+		c.sequencer = newSequencer(config.Sequences, config.SequenceTimeout)
+		strokes = append(strokes[:len(strokes):len(strokes)], c.sequencer.prefixes()...)
+	}
+	if len(config.Bindings) > 0 {
+		c.binder = newBinder(config.Bindings)
+		strokes = append(strokes[:len(strokes):len(strokes)], c.binder.strokes()...)
+	}
+
+	// the config is complete before the loop sees it
+	c.report = w.grabKeys(c, strokes, config.SyncStrokes)
+	w.grabButtons(c, config.Buttons, c.report)
+	if err := config.Rules.Validate(); err != nil {
+		w.logger.Error("invalid rules", errAttr(err))
+		c.report.InvalidRules = err
+	}
+
+	w.configLock.Lock()
+	old := w.config
+	w.config = c
+	w.configLock.Unlock()
+	if old != nil {
+		// a press frozen by a sync grab of the old config may not match the new one
+		if len(old.syncKeys) > 0 {
+			if err := w.Backend.AllowEvents(xproto.AllowReplayKeyboard, xproto.TimeCurrentTime).Check(); err != nil {
+				w.logger.Error("allow events", requestAttr("AllowEvents"), errAttr(err))
+			}
+		}
+		// drop keyboard grabs of pending sequence or held stroke
+		if s := old.sequencer; s != nil {
+			s.Lock()
+			if s.node != nil {
+				s.finish(w, SequenceAborted)
+			}
+			s.Unlock()
+		}
+		if b := old.binder; b != nil {
+			b.Lock()
+			if b.held != nil {
+				w.endStroke(b, b.held)
+			}
+			b.Unlock()
+		}
+	}
+
+	return c.report
+}
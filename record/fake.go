@@ -0,0 +1,80 @@
+package record
+
+import (
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/fake"
+)
+
+// Fake returns a target replaying to the fake server
+func Fake(s *fake.Server) Target {
+	return fakeTarget{s}
+}
+
+type fakeTarget struct {
+	s *fake.Server
+}
+
+func namedProperty(p *Property) fake.NamedProperty {
+	if p.Atoms != nil {
+		return fake.Atoms(p.Name, p.Atoms...)
+	}
+	return fake.NamedProperty{
+		Name:   p.Name,
+		Type:   p.Type,
+		Format: p.Format,
+		Data:   p.Data,
+	}
+}
+
+func (f fakeTarget) CreateWindow(x, y, width, height, border int, overrideRedirect bool, properties []*Property) xproto.Window {
+	opts := fake.WindowOptions{
+		X:                x,
+		Y:                y,
+		Width:            width,
+		Height:           height,
+		Border:           border,
+		OverrideRedirect: overrideRedirect,
+	}
+	for _, p := range properties {
+		opts.Properties = append(opts.Properties, namedProperty(p))
+	}
+	return f.s.CreateWindow(opts)
+}
+
+func (f fakeTarget) Map(id xproto.Window) {
+	f.s.Map(id)
+}
+
+func (f fakeTarget) Unmap(id xproto.Window) {
+	f.s.Unmap(id)
+}
+
+func (f fakeTarget) Destroy(id xproto.Window) {
+	f.s.Destroy(id)
+}
+
+func (f fakeTarget) Configure(id xproto.Window, x, y, width, height int) {
+	f.s.Configure(id, x, y, width, height)
+}
+
+func (f fakeTarget) SetProperty(id xproto.Window, p *Property) {
+	f.s.SetProperty(id, namedProperty(p))
+}
+
+func (f fakeTarget) KeyPress(sym wmutil.Keysym, state uint16) {
+	f.s.KeyPress(f.s.Keycode(sym), state)
+}
+
+func (f fakeTarget) KeyRelease(sym wmutil.Keysym, state uint16) {
+	f.s.KeyRelease(f.s.Keycode(sym), state)
+}
+
+func (f fakeTarget) ButtonPress(x, y int, button byte, state uint16) {
+	f.s.MovePointer(x, y)
+	f.s.ButtonPress(button, state)
+}
+
+func (f fakeTarget) Wait() {
+	f.s.WaitIdle()
+}
@@ -0,0 +1,72 @@
+package record
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+// Record is a line of a recording, an event received by the wm or a property value read by it
+type Record struct {
+	// since the start of recording
+	Time time.Duration `json:"time"`
+	// event type like MapRequest, empty for property records
+	Event  string        `json:"event,omitempty"`
+	Window xproto.Window `json:"window,omitempty"`
+
+	X                int    `json:"x,omitempty"`
+	Y                int    `json:"y,omitempty"`
+	Width            int    `json:"width,omitempty"`
+	Height           int    `json:"height,omitempty"`
+	Border           int    `json:"border,omitempty"`
+	OverrideRedirect bool   `json:"override_redirect,omitempty"`
+	ValueMask        uint16 `json:"value_mask,omitempty"`
+
+	// PropertyNotify, the new value is in Property
+	Atom    string `json:"atom,omitempty"`
+	Deleted bool   `json:"deleted,omitempty"`
+
+	// key and button events, X and Y are relative to root
+	Keysym wmutil.Keysym `json:"keysym,omitempty"`
+	Button byte          `json:"button,omitempty"`
+	State  uint16        `json:"state,omitempty"`
+
+	Property *Property `json:"property,omitempty"`
+}
+
+// Property is a window property value, atoms are stored by name
+type Property struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Format byte   `json:"format"`
+	Data   []byte `json:"data,omitempty"`
+	// values of ATOM properties, instead of Data
+	Atoms []string `json:"atoms,omitempty"`
+}
+
+// Read reads a recording written by Recorder
+func Read(r io.Reader) ([]*Record, error) {
+	var records []*Record
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		record := new(Record)
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return nil, ef("line %d: %v", line, err)
+		}
+		records = append(records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,137 @@
+package record
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/fake"
+)
+
+// run starts a wm on backend and returns a function returning what it delivered, until a window named end is mapped
+func run(t *testing.T, backend wmutil.Backend) func() []string {
+	wm, err := wmutil.New(&wmutil.Config{
+		Backend: backend,
+		Logger:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+		Strokes: []wmutil.Stroke{
+			{Modifiers: xproto.ModMask4, Sym: wmutil.Key_F1},
+		},
+		Placement: func(*wmutil.Window) wmutil.Placement {
+			return wmutil.PlaceCenter
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(wm.Close)
+	done := make(chan []string)
+	go func() {
+		var got []string
+		for {
+			select {
+			case win := <-wm.Map:
+				if win.Name == "end" {
+					done <- got
+					return
+				}
+				win.ReadLock(func() {
+					got = append(got, fmt.Sprintf("map %s %s %d,%d %dx%d %v",
+						win.Class, win.Name, win.X, win.Y, win.Width, win.Height, win.IsTransient))
+				})
+			case win := <-wm.Unmap:
+				got = append(got, "unmap "+win.Name)
+			case win := <-wm.NameChanged:
+				got = append(got, "name "+win.Name)
+			case stroke := <-wm.Stroke:
+				got = append(got, "stroke "+stroke.String())
+			}
+		}
+	}()
+	return func() []string {
+		select {
+		case got := <-done:
+			return got
+		case <-time.After(time.Second * 5):
+			t.Fatal("timeout")
+		}
+		return nil
+	}
+}
+
+func TestRecordReplay(t *testing.T) {
+	buf := new(bytes.Buffer)
+	s := fake.New(1000, 800)
+	recorder := NewRecorder(s.Backend(), buf)
+	wait := run(t, recorder)
+	// each step is handled before the next, like a real session
+
+	parent := s.CreateWindow(fake.WindowOptions{
+		Width:  200,
+		Height: 100,
+		Properties: []fake.NamedProperty{
+			fake.Class("xterm", "XTerm"),
+			fake.String("WM_NAME", "shell"),
+			fake.Atoms("WM_PROTOCOLS", "WM_DELETE_WINDOW"),
+		},
+	})
+	s.Map(parent)
+	s.WaitIdle()
+	dialog := s.CreateWindow(fake.WindowOptions{
+		Properties: []fake.NamedProperty{
+			fake.TransientFor(parent),
+			fake.String("WM_NAME", "dialog"),
+		},
+	})
+	s.Configure(dialog, 0, 0, 300, 50)
+	s.WaitIdle()
+	s.Map(dialog)
+	s.WaitIdle()
+	s.SetProperty(parent, fake.UTF8String("_NET_WM_NAME", "vim"))
+	s.WaitIdle()
+	s.KeyPress(s.Keycode(wmutil.Key_F1), xproto.ModMask4|xproto.ModMask2)
+	s.WaitIdle()
+	s.Unmap(dialog)
+	s.WaitIdle()
+	s.Destroy(dialog)
+	s.WaitIdle()
+	end := s.CreateWindow(fake.WindowOptions{
+		Properties: []fake.NamedProperty{
+			fake.String("WM_NAME", "end"),
+		},
+	})
+	s.Map(end)
+	recorded := wait()
+	if err := recorder.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(recorded) != 5 {
+		t.Fatalf("got %q", recorded)
+	}
+
+	records, err := Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the value is recorded with the notify, the wm may not read it
+	found := false
+	for _, record := range records {
+		if record.Event == "PropertyNotify" && record.Atom == "_NET_WM_NAME" {
+			found = record.Property != nil && string(record.Property.Data) == "vim"
+		}
+	}
+	if !found {
+		t.Fatal("no property value in PropertyNotify record")
+	}
+	s = fake.New(1000, 800)
+	wait = run(t, s.Backend())
+	Replay(records, Fake(s), 0)
+	if replayed := wait(); !reflect.DeepEqual(replayed, recorded) {
+		t.Fatalf("replayed %q\nrecorded %q", replayed, recorded)
+	}
+}
@@ -0,0 +1,202 @@
+package record
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+// Recorder wraps a backend, writing events read by the wm and property values it reads as JSON lines.
+// use it as Config.Backend. the value of a changed property is written with its PropertyNotify, the wm may not read it after coalescing
+type Recorder struct {
+	wmutil.Backend
+	lock    sync.Mutex
+	enc     *json.Encoder
+	start   time.Time
+	err     error
+	names   map[xproto.Atom]string
+	keysyms *xproto.GetKeyboardMappingReply
+}
+
+func NewRecorder(backend wmutil.Backend, w io.Writer) *Recorder {
+	return &Recorder{
+		Backend: backend,
+		enc:     json.NewEncoder(w),
+		start:   time.Now(),
+		names: map[xproto.Atom]string{
+			xproto.AtomNone: "",
+		},
+	}
+}
+
+// Err returns the first error writing the recording
+func (r *Recorder) Err() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+func (r *Recorder) write(record *Record) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.err != nil {
+		return
+	}
+	record.Time = time.Since(r.start)
+	r.err = r.enc.Encode(record)
+}
+
+func (r *Recorder) WaitForEvent() (xgb.Event, xgb.Error) {
+	ev, err := r.Backend.WaitForEvent()
+	if ev != nil {
+		r.event(ev)
+	}
+	return ev, err
+}
+
+func (r *Recorder) PollForEvent() (xgb.Event, xgb.Error) {
+	ev, err := r.Backend.PollForEvent()
+	if ev != nil {
+		r.event(ev)
+	}
+	return ev, err
+}
+
+func (r *Recorder) GetProperty(delete bool, window xproto.Window, property, typ xproto.Atom, longOffset, longLength uint32) wmutil.Cookie[*xproto.GetPropertyReply] {
+	return propertyCookie{
+		Cookie:   r.Backend.GetProperty(delete, window, property, typ, longOffset, longLength),
+		r:        r,
+		window:   window,
+		property: property,
+	}
+}
+
+// propertyCookie writes the value when the wm waits for the reply, requests are still pipelined
+type propertyCookie struct {
+	wmutil.Cookie[*xproto.GetPropertyReply]
+	r        *Recorder
+	window   xproto.Window
+	property xproto.Atom
+}
+
+func (c propertyCookie) Reply() (*xproto.GetPropertyReply, error) {
+	reply, err := c.Cookie.Reply()
+	if err == nil {
+		c.r.write(&Record{
+			Window:   c.window,
+			Property: c.r.property(c.property, reply),
+		})
+	}
+	return reply, err
+}
+
+func (r *Recorder) property(atom xproto.Atom, reply *xproto.GetPropertyReply) *Property {
+	p := &Property{
+		Name:   r.atomName(atom),
+		Type:   r.atomName(reply.Type),
+		Format: reply.Format,
+	}
+	if reply.Type == xproto.AtomAtom && reply.Format == 32 {
+		for i := 0; i+4 <= len(reply.Value); i += 4 {
+			p.Atoms = append(p.Atoms, r.atomName(xproto.Atom(xgb.Get32(reply.Value[i:]))))
+		}
+	} else {
+		p.Data = reply.Value
+	}
+	return p
+}
+
+func (r *Recorder) atomName(atom xproto.Atom) string {
+	r.lock.Lock()
+	name, ok := r.names[atom]
+	r.lock.Unlock()
+	if ok {
+		return name
+	}
+	reply, err := r.Backend.GetAtomName(atom).Reply()
+	if err != nil {
+		return fmt.Sprintf("%d", atom)
+	}
+	r.lock.Lock()
+	r.names[atom] = reply.Name
+	r.lock.Unlock()
+	return reply.Name
+}
+
+func (r *Recorder) keysym(code xproto.Keycode) wmutil.Keysym {
+	setup := r.Setup()
+	if r.keysyms == nil {
+		reply, err := r.Backend.GetKeyboardMapping(setup.MinKeycode, byte(setup.MaxKeycode-setup.MinKeycode+1)).Reply()
+		if err != nil {
+			return 0
+		}
+		r.keysyms = reply
+	}
+	i := (int(code) - int(setup.MinKeycode)) * int(r.keysyms.KeysymsPerKeycode)
+	if code < setup.MinKeycode || i >= len(r.keysyms.Keysyms) {
+		return 0
+	}
+	return wmutil.Keysym(r.keysyms.Keysyms[i])
+}
+
+func (r *Recorder) event(ev xgb.Event) {
+	record := &Record{
+		Event: strings.TrimSuffix(strings.TrimPrefix(fmt.Sprintf("%T", ev), "xproto."), "Event"),
+	}
+	switch ev := ev.(type) {
+	case xproto.CreateNotifyEvent:
+		record.Window = ev.Window
+		record.X, record.Y = int(ev.X), int(ev.Y)
+		record.Width, record.Height, record.Border = int(ev.Width), int(ev.Height), int(ev.BorderWidth)
+		record.OverrideRedirect = ev.OverrideRedirect
+	case xproto.ConfigureRequestEvent:
+		record.Window = ev.Window
+		record.X, record.Y = int(ev.X), int(ev.Y)
+		record.Width, record.Height, record.Border = int(ev.Width), int(ev.Height), int(ev.BorderWidth)
+		record.ValueMask = ev.ValueMask
+	case xproto.ConfigureNotifyEvent:
+		record.Window = ev.Window
+		record.X, record.Y = int(ev.X), int(ev.Y)
+		record.Width, record.Height, record.Border = int(ev.Width), int(ev.Height), int(ev.BorderWidth)
+	case xproto.MapRequestEvent:
+		record.Window = ev.Window
+	case xproto.MapNotifyEvent:
+		record.Window = ev.Window
+	case xproto.UnmapNotifyEvent:
+		record.Window = ev.Window
+	case xproto.DestroyNotifyEvent:
+		record.Window = ev.Window
+	case xproto.PropertyNotifyEvent:
+		record.Window = ev.Window
+		record.Atom = r.atomName(ev.Atom)
+		record.Deleted = ev.State == xproto.PropertyDelete
+		if !record.Deleted {
+			reply, err := r.Backend.GetProperty(false, ev.Window, ev.Atom, xproto.GetPropertyTypeAny, 0, (1<<32)-1).Reply()
+			if err == nil {
+				record.Property = r.property(ev.Atom, reply)
+			}
+		}
+	case xproto.KeyPressEvent:
+		record.Keysym = r.keysym(ev.Detail)
+		record.State = ev.State
+		record.X, record.Y = int(ev.RootX), int(ev.RootY)
+	case xproto.KeyReleaseEvent:
+		record.Keysym = r.keysym(ev.Detail)
+		record.State = ev.State
+		record.X, record.Y = int(ev.RootX), int(ev.RootY)
+	case xproto.ButtonPressEvent:
+		record.Button = byte(ev.Detail)
+		record.State = ev.State
+		record.X, record.Y = int(ev.RootX), int(ev.RootY)
+	case xproto.MappingNotifyEvent:
+		r.keysyms = nil
+	}
+	r.write(record)
+}
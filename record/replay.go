@@ -0,0 +1,169 @@
+package record
+
+import (
+	"time"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+// Target performs the client and user actions of a recording. Fake replays to the fake server, wmtest.Env.ReplayTarget to Xvfb
+type Target interface {
+	// CreateWindow creates an unmapped top level window, properties are set before the wm is notified
+	CreateWindow(x, y, width, height, border int, overrideRedirect bool, properties []*Property) xproto.Window
+	Map(id xproto.Window)
+	Unmap(id xproto.Window)
+	Destroy(id xproto.Window)
+	Configure(id xproto.Window, x, y, width, height int)
+	SetProperty(id xproto.Window, p *Property)
+	KeyPress(sym wmutil.Keysym, state uint16)
+	KeyRelease(sym wmutil.Keysym, state uint16)
+	ButtonPress(x, y int, button byte, state uint16)
+	// Wait waits for the wm to handle the actions performed
+	Wait()
+}
+
+// Replay performs the recording on target, with the recorded timing scaled by speed, or waiting for the wm after each event if speed is 0.
+// events are turned back into the client requests causing them, windows existing before the recording started are skipped
+func Replay(records []*Record, target Target, speed float64) {
+	r := &replayer{
+		target:           target,
+		ids:              make(map[xproto.Window]xproto.Window),
+		geometries:       make(map[xproto.Window]wmutil.Rect),
+		overrideRedirect: make(map[xproto.Window]bool),
+	}
+	var last time.Duration
+	for i, record := range records {
+		if record.Event == "" {
+			continue
+		}
+		if speed > 0 && record.Time > last {
+			time.Sleep(time.Duration(float64(record.Time-last) / speed))
+			last = record.Time
+		}
+		// property values read by the wm while handling the event
+		var properties []*Property
+		for _, next := range records[i+1:] {
+			if next.Event != "" {
+				break
+			}
+			if next.Window == record.Window && next.Property != nil {
+				properties = append(properties, next.Property)
+			}
+		}
+		r.replay(record, properties)
+		if speed == 0 {
+			target.Wait()
+		}
+	}
+}
+
+type replayer struct {
+	target Target
+	// recorded ids to target ids
+	ids              map[xproto.Window]xproto.Window
+	geometries       map[xproto.Window]wmutil.Rect
+	overrideRedirect map[xproto.Window]bool
+}
+
+func (r *replayer) replay(record *Record, properties []*Property) {
+	t := r.target
+	if record.Event == "CreateNotify" {
+		if _, ok := r.ids[record.Window]; ok {
+			return
+		}
+		var initial []*Property
+		for _, p := range properties {
+			if p.Type != "" { // not existing
+				initial = append(initial, r.translate(p))
+			}
+		}
+		r.ids[record.Window] = t.CreateWindow(record.X, record.Y, record.Width, record.Height, record.Border,
+			record.OverrideRedirect, initial)
+		r.geometries[record.Window] = wmutil.Rect{X: record.X, Y: record.Y, Width: record.Width, Height: record.Height}
+		r.overrideRedirect[record.Window] = record.OverrideRedirect
+		return
+	}
+
+	switch record.Event {
+	case "KeyPress":
+		t.KeyPress(record.Keysym, record.State)
+		return
+	case "KeyRelease":
+		t.KeyRelease(record.Keysym, record.State)
+		return
+	case "ButtonPress":
+		t.ButtonPress(record.X, record.Y, record.Button, record.State)
+		return
+	}
+
+	id, ok := r.ids[record.Window]
+	if !ok {
+		return
+	}
+	switch record.Event {
+	case "MapRequest":
+		t.Map(id)
+	case "MapNotify":
+		// others are mapped by the wm
+		if r.overrideRedirect[record.Window] {
+			t.Map(id)
+		}
+	case "UnmapNotify":
+		t.Unmap(id)
+	case "DestroyNotify":
+		t.Destroy(id)
+		delete(r.ids, record.Window)
+	case "ConfigureRequest":
+		g := r.geometries[record.Window]
+		if record.ValueMask&xproto.ConfigWindowX != 0 {
+			g.X = record.X
+		}
+		if record.ValueMask&xproto.ConfigWindowY != 0 {
+			g.Y = record.Y
+		}
+		if record.ValueMask&xproto.ConfigWindowWidth != 0 {
+			g.Width = record.Width
+		}
+		if record.ValueMask&xproto.ConfigWindowHeight != 0 {
+			g.Height = record.Height
+		}
+		t.Configure(id, g.X, g.Y, g.Width, g.Height)
+	case "ConfigureNotify":
+		r.geometries[record.Window] = wmutil.Rect{X: record.X, Y: record.Y, Width: record.Width, Height: record.Height}
+	case "PropertyNotify":
+		if record.Deleted {
+			return
+		}
+		if p := record.Property; p != nil {
+			if p.Type != "" {
+				t.SetProperty(id, r.translate(p))
+			}
+			return
+		}
+		// recorded without the value, use the one read by the wm
+		for _, p := range properties {
+			if p.Name == record.Atom && p.Type != "" {
+				t.SetProperty(id, r.translate(p))
+				return
+			}
+		}
+	}
+}
+
+// translate maps window ids in WINDOW properties to the target ids
+func (r *replayer) translate(p *Property) *Property {
+	if p.Type != "WINDOW" || p.Format != 32 {
+		return p
+	}
+	ret := *p
+	ret.Data = make([]byte, len(p.Data))
+	copy(ret.Data, p.Data)
+	for i := 0; i+4 <= len(ret.Data); i += 4 {
+		if id, ok := r.ids[xproto.Window(xgb.Get32(ret.Data[i:]))]; ok {
+			xgb.Put32(ret.Data[i:], uint32(id))
+		}
+	}
+	return &ret
+}
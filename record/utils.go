@@ -0,0 +1,7 @@
+package record
+
+import "fmt"
+
+var (
+	ef = fmt.Errorf
+)
@@ -0,0 +1,23 @@
+package wmutil
+
+type Rect struct {
+	X, Y, Width, Height int
+}
+
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// Intersect returns the overlapping area, zero-sized if not overlapped
+func (r Rect) Intersect(o Rect) Rect {
+	x1, y1 := max(r.X, o.X), max(r.Y, o.Y)
+	x2, y2 := min(r.X+r.Width, o.X+o.Width), min(r.Y+r.Height, o.Y+o.Height)
+	if x2 <= x1 || y2 <= y1 {
+		return Rect{}
+	}
+	return Rect{x1, y1, x2 - x1, y2 - y1}
+}
+
+func (r Rect) Area() int {
+	return r.Width * r.Height
+}
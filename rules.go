@@ -0,0 +1,227 @@
+package wmutil
+
+import (
+	"encoding/json"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Matcher matches a string exactly, by shell glob, or by regular expression. a JSON string is an exact matcher
+type Matcher struct {
+	Exact string `json:"exact,omitempty"`
+	Glob  string `json:"glob,omitempty"`
+	Regex string `json:"regex,omitempty"`
+
+	re *regexp.Regexp
+}
+
+func (m *Matcher) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*m = Matcher{
+			Exact: s,
+		}
+		return nil
+	}
+	type matcher Matcher
+	if err := json.Unmarshal(data, (*matcher)(m)); err != nil {
+		return err
+	}
+	return m.compile()
+}
+
+func (m *Matcher) compile() error {
+	if m.Glob != "" {
+		if _, err := path.Match(m.Glob, ""); err != nil {
+			return ef("bad glob %q: %v", m.Glob, err)
+		}
+	}
+	if m.Regex != "" && m.re == nil {
+		re, err := regexp.Compile(m.Regex)
+		if err != nil {
+			return ef("bad regex %q: %v", m.Regex, err)
+		}
+		m.re = re
+	}
+	return nil
+}
+
+func (m *Matcher) Match(s string) bool {
+	if m == nil {
+		return true
+	}
+	if m.Exact != "" && s != m.Exact {
+		return false
+	}
+	if m.Glob != "" {
+		if ok, _ := path.Match(m.Glob, s); !ok {
+			return false
+		}
+	}
+	if m.Regex != "" {
+		if m.compile() != nil || !m.re.MatchString(s) {
+			return false
+		}
+	}
+	return true
+}
+
+type RuleMatch struct {
+	Class    *Matcher `json:"class,omitempty"`
+	Instance *Matcher `json:"instance,omitempty"`
+	Name     *Matcher `json:"name,omitempty"`
+	Role     *Matcher `json:"role,omitempty"`
+	// window type like DIALOG, with or without the _NET_WM_WINDOW_TYPE_ prefix
+	Type      string `json:"type,omitempty"`
+	Transient *bool  `json:"transient,omitempty"`
+}
+
+// RuleActions are applied before the window is first mapped. Workspace, Floating and Focus are applied by command.Desktop
+type RuleActions struct {
+	Workspace  string   `json:"workspace,omitempty"`
+	Floating   *bool    `json:"floating,omitempty"`
+	Geometry   *Rect    `json:"geometry,omitempty"`
+	Fullscreen *bool    `json:"fullscreen,omitempty"`
+	Opacity    *float64 `json:"opacity,omitempty"`
+	// map the window but do not deliver it on Map and Unmap
+	Ignore bool `json:"ignore,omitempty"`
+	// whether to focus the window when mapped
+	Focus *bool `json:"focus,omitempty"`
+}
+
+type Rule struct {
+	Match   RuleMatch   `json:"match"`
+	Actions RuleActions `json:"actions"`
+}
+
+type Rules []Rule
+
+const windowTypePrefix = "_NET_WM_WINDOW_TYPE_"
+
+func (r *Rule) matches(win *Window) bool {
+	m := r.Match
+	var class, instance, name, role string
+	var transient bool
+	var types []xproto.Atom
+	win.ReadLock(func() {
+		class, instance, name, role = win.Class, win.Instance, win.Name, win.Role
+		transient = win.IsTransient
+		types = win.Types
+	})
+	if !m.Class.Match(class) || !m.Instance.Match(instance) || !m.Name.Match(name) || !m.Role.Match(role) {
+		return false
+	}
+	if m.Transient != nil && *m.Transient != transient {
+		return false
+	}
+	if m.Type != "" {
+		if win.wm == nil {
+			return false
+		}
+		want := strings.TrimPrefix(strings.ToUpper(m.Type), windowTypePrefix)
+		found := false
+		for _, atom := range types {
+			if strings.TrimPrefix(win.wm.AtomName(atom), windowTypePrefix) == want {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Match merges actions of all matching rules, later rules override earlier ones
+func (rules Rules) Match(win *Window) (ret RuleActions) {
+	for i := range rules {
+		rule := &rules[i]
+		if !rule.matches(win) {
+			continue
+		}
+		a := rule.Actions
+		if a.Workspace != "" {
+			ret.Workspace = a.Workspace
+		}
+		if a.Floating != nil {
+			ret.Floating = a.Floating
+		}
+		if a.Geometry != nil {
+			ret.Geometry = a.Geometry
+		}
+		if a.Fullscreen != nil {
+			ret.Fullscreen = a.Fullscreen
+		}
+		if a.Opacity != nil {
+			ret.Opacity = a.Opacity
+		}
+		if a.Ignore {
+			ret.Ignore = true
+		}
+		if a.Focus != nil {
+			ret.Focus = a.Focus
+		}
+	}
+	return
+}
+
+func (rules Rules) Validate() error {
+	for i, rule := range rules {
+		for _, m := range []*Matcher{rule.Match.Class, rule.Match.Instance, rule.Match.Name, rule.Match.Role} {
+			if m == nil {
+				continue
+			}
+			if err := m.compile(); err != nil {
+				return ef("rule %d: %v", i, err)
+			}
+		}
+		if o := rule.Actions.Opacity; o != nil && (*o < 0 || *o > 1) {
+			return ef("rule %d: opacity %v not in [0, 1]", i, *o)
+		}
+	}
+	return nil
+}
+
+// LoadRules reads rules in JSON array
+func LoadRules(r io.Reader) (rules Rules, err error) {
+	if err = json.NewDecoder(r).Decode(&rules); err != nil {
+		return nil, ef("decode rules: %v", err)
+	}
+	if err = rules.Validate(); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// applyRules is called before the window is first mapped, returns false if the window should not be placed
+func (w *Wm) applyRules(b *Batch, win *Window, rules Rules) bool {
+	actions := rules.Match(win)
+	win.WriteLock(func() {
+		win.Rule = actions
+	})
+	if actions.Opacity != nil {
+		b.ChangeInt32sProperty(win, w.Atom("_NET_WM_WINDOW_OPACITY"), xproto.AtomCardinal,
+			uint32(*actions.Opacity*0xffffffff))
+	}
+	if actions.Fullscreen != nil && *actions.Fullscreen {
+		b.ChangeInt32sProperty(win, w.Atom("_NET_WM_STATE"), xproto.AtomAtom,
+			uint32(w.Atom("_NET_WM_STATE_FULLSCREEN")))
+		var x, y int
+		win.ReadLock(func() {
+			x, y = win.X, win.Y
+		})
+		m := w.MonitorAt(x, y)
+		b.SetGeometry(win, m.X, m.Y, m.Width, m.Height)
+		return false
+	}
+	if g := actions.Geometry; g != nil {
+		b.SetGeometry(win, g.X, g.Y, g.Width, g.Height)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package wmutil
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestRules(t *testing.T) {
+	rules, err := LoadRules(strings.NewReader(`[
+		{"match": {"class": "Gimp"}, "actions": {"floating": true, "workspace": "gfx"}},
+		{"match": {"name": {"glob": "*Preferences*"}}, "actions": {"geometry": {"X": 10, "Y": 20, "Width": 300, "Height": 200}}},
+		{"match": {"class": {"regex": "^Gimp"}, "transient": true}, "actions": {"workspace": "dialogs", "opacity": 0.9}}
+	]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	win := &Window{
+		RWMutex: new(sync.RWMutex),
+		Class:   "Gimp",
+		Name:    "GIMP Preferences",
+	}
+	actions := rules.Match(win)
+	if actions.Workspace != "gfx" || actions.Floating == nil || !*actions.Floating {
+		t.Fatalf("got %+v", actions)
+	}
+	if g := actions.Geometry; g == nil || *g != (Rect{10, 20, 300, 200}) {
+		t.Fatalf("got %+v", g)
+	}
+	win.IsTransient = true
+	actions = rules.Match(win)
+	if actions.Workspace != "dialogs" || actions.Opacity == nil {
+		t.Fatalf("got %+v", actions)
+	}
+	win.Class = "Firefox"
+	win.Name = ""
+	if actions := rules.Match(win); actions != (RuleActions{}) {
+		t.Fatalf("got %+v", actions)
+	}
+
+	if _, err := LoadRules(strings.NewReader(`[{"match": {"class": {"regex": "("}}}]`)); err == nil {
+		t.Fatal("should fail")
+	}
+}
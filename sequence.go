@@ -0,0 +1,179 @@
+package wmutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// Sequence is a chain of strokes like emacs prefix keys, its first stroke is grabbed and the following are read with the keyboard grabbed
+type Sequence []Stroke
+
+type SequenceStatus int
+
+const (
+	SequenceMatched SequenceStatus = iota
+	SequenceUnmatched
+	SequenceTimeout
+	SequenceAborted
+)
+
+type SequenceEvent struct {
+	Sequence Sequence
+	Status   SequenceStatus
+}
+
+type seqNode struct {
+	next     map[Stroke]*seqNode
+	terminal bool
+}
+
+type sequencer struct {
+	sync.Mutex
+	root    *seqNode
+	timeout time.Duration
+	// current state
+	node    *seqNode
+	strokes Sequence
+	timer   *time.Timer
+	serial  int
+}
+
+func newSequencer(sequences []Sequence, timeout time.Duration) *sequencer {
+	root := &seqNode{
+		next: make(map[Stroke]*seqNode),
+	}
+	for _, seq := range sequences {
+		node := root
+		for _, stroke := range seq {
+			next, ok := node.next[stroke]
+			if !ok {
+				next = &seqNode{
+					next: make(map[Stroke]*seqNode),
+				}
+				node.next[stroke] = next
+			}
+			node = next
+		}
+		node.terminal = true
+	}
+	if timeout == 0 {
+		timeout = time.Second * 2
+	}
+	return &sequencer{
+		root:    root,
+		timeout: timeout,
+	}
+}
+
+// prefixes returns the strokes that start a sequence
+func (s *sequencer) prefixes() (ret []Stroke) {
+	for stroke := range s.root.next {
+		ret = append(ret, stroke)
+	}
+	return
+}
+
+// active reports whether the keyboard is grabbed for the following strokes of a sequence
+func (s *sequencer) active() bool {
+	if s == nil {
+		return false
+	}
+	s.Lock()
+	defer s.Unlock()
+	return s.node != nil
+}
+
+// feedSequence returns false if the stroke is not part of a sequence
+func (w *Wm) feedSequence(stroke Stroke, isModifier bool) bool {
+	s := w.currentConfig().sequencer
+	if s == nil {
+		return false
+	}
+	s.Lock()
+	if s.node != nil && isModifier { // modifier keys pressed while keyboard grabbed
+		s.Unlock()
+		return true
+	}
+	var ev *SequenceEvent
+	if s.node == nil { // not in sequence
+		next, ok := s.root.next[stroke]
+		if !ok {
+			s.Unlock()
+			return false
+		}
+		s.strokes = Sequence{stroke}
+		if reply, err := w.Backend.GrabKeyboard(true, w.DefaultRootId, xproto.TimeCurrentTime,
+			xproto.GrabModeAsync, xproto.GrabModeAsync).Reply(); err != nil || reply.Status != xproto.GrabStatusSuccess {
+			w.logger.Error("grab keyboard", requestAttr("GrabKeyboard"), errAttr(err))
+			ev = s.finish(w, SequenceAborted)
+		} else {
+			s.node = next
+		}
+	} else {
+		s.strokes = append(s.strokes, stroke)
+		if next, ok := s.node.next[stroke]; ok {
+			s.node = next
+		} else if stroke.Sym == Key_Escape {
+			ev = s.finish(w, SequenceAborted)
+		} else {
+			ev = s.finish(w, SequenceUnmatched)
+		}
+	}
+	if ev == nil {
+		if len(s.node.next) == 0 {
+			ev = s.finish(w, SequenceMatched)
+		} else {
+			// wait for next stroke
+			if s.timer != nil {
+				s.timer.Stop()
+			}
+			s.serial++
+			serial := s.serial
+			s.timer = time.AfterFunc(s.timeout, func() {
+				w.sequenceTimeout(s, serial)
+			})
+		}
+	}
+	s.Unlock()
+	if ev != nil {
+		send(w, "Sequence", w.Sequence, *ev)
+	}
+	return true
+}
+
+func (w *Wm) sequenceTimeout(s *sequencer, serial int) {
+	s.Lock()
+	if s.node == nil || s.serial != serial { // finished or advanced
+		s.Unlock()
+		return
+	}
+	status := SequenceTimeout
+	if s.node.terminal { // a complete sequence that is also a prefix of longer ones
+		status = SequenceMatched
+	}
+	ev := s.finish(w, status)
+	s.Unlock()
+	send(w, "Sequence", w.Sequence, *ev)
+}
+
+// finish must be called with lock held
+func (s *sequencer) finish(w *Wm, status SequenceStatus) *SequenceEvent {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	if s.node != nil {
+		if err := w.Backend.UngrabKeyboard(xproto.TimeCurrentTime).Check(); err != nil {
+			w.logger.Error("ungrab keyboard", requestAttr("UngrabKeyboard"), errAttr(err))
+		}
+	}
+	ev := &SequenceEvent{
+		Sequence: s.strokes,
+		Status:   status,
+	}
+	s.node = nil
+	s.strokes = nil
+	return ev
+}
@@ -0,0 +1,141 @@
+package wmutil
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+var modifierNames = []struct {
+	name string
+	mask uint16
+}{
+	{"Shift", xproto.ModMaskShift},
+	{"Lock", xproto.ModMaskLock},
+	{"Control", xproto.ModMaskControl},
+	{"Mod1", xproto.ModMask1},
+	{"Mod2", xproto.ModMask2},
+	{"Mod3", xproto.ModMask3},
+	{"Mod4", xproto.ModMask4},
+	{"Mod5", xproto.ModMask5},
+}
+
+var modifierAliases = map[string]uint16{
+	"Ctrl":  xproto.ModMaskControl,
+	"Alt":   xproto.ModMask1,
+	"Super": xproto.ModMask4,
+}
+
+func parseModifiers(names []string) (mods uint16, err error) {
+loop:
+	for _, name := range names {
+		if mask, ok := modifierAliases[name]; ok {
+			mods |= mask
+			continue
+		}
+		for _, m := range modifierNames {
+			if strings.EqualFold(m.name, name) {
+				mods |= m.mask
+				continue loop
+			}
+		}
+		return 0, ef("unknown modifier %q", name)
+	}
+	return
+}
+
+func modifiersString(mods uint16) string {
+	var parts []string
+	for _, m := range modifierNames {
+		if mods&m.mask != 0 {
+			parts = append(parts, m.name)
+		}
+	}
+	return strings.Join(parts, "+")
+}
+
+// ParseStroke parses strings like Mod4+Shift+Return, keys are named as in keysymdef.h
+func ParseStroke(s string) (stroke Stroke, err error) {
+	parts := strings.Split(s, "+")
+	key := parts[len(parts)-1]
+	if key == "" && len(parts) > 1 { // Mod4++ means plus
+		key = "plus"
+		parts = parts[:len(parts)-1]
+	}
+	if stroke.Modifiers, err = parseModifiers(parts[:len(parts)-1]); err != nil {
+		return
+	}
+	sym, ok := KeysymByName(key)
+	if !ok {
+		return stroke, ef("unknown key %q", key)
+	}
+	stroke.Sym = sym
+	return
+}
+
+func (s Stroke) String() string {
+	if s.Modifiers == 0 {
+		return s.Sym.String()
+	}
+	return modifiersString(s.Modifiers) + "+" + s.Sym.String()
+}
+
+// ParseSequence parses space separated strokes
+func ParseSequence(s string) (seq Sequence, err error) {
+	for _, field := range strings.Fields(s) {
+		stroke, err := ParseStroke(field)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, stroke)
+	}
+	if len(seq) == 0 {
+		return nil, ef("empty sequence")
+	}
+	return
+}
+
+func (s Sequence) String() string {
+	var parts []string
+	for _, stroke := range s {
+		parts = append(parts, stroke.String())
+	}
+	return strings.Join(parts, " ")
+}
+
+type ButtonStroke struct {
+	Modifiers uint16
+	Button    byte
+}
+
+// ParseButtonStroke parses strings like Mod4+Button1
+func ParseButtonStroke(s string) (stroke ButtonStroke, err error) {
+	parts := strings.Split(s, "+")
+	button := parts[len(parts)-1]
+	if stroke.Modifiers, err = parseModifiers(parts[:len(parts)-1]); err != nil {
+		return
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(button, "Button"))
+	if err != nil || n < 1 || n > 255 {
+		return stroke, ef("bad button %q", button)
+	}
+	stroke.Button = byte(n)
+	return
+}
+
+func (s ButtonStroke) String() string {
+	button := "Button" + strconv.Itoa(int(s.Button))
+	if s.Modifiers == 0 {
+		return button
+	}
+	return modifiersString(s.Modifiers) + "+" + button
+}
+
+type ButtonEvent struct {
+	ButtonStroke
+	// managed top-level window under the pointer, may be nil
+	Window *Window
+	// pointer position relative to root
+	X, Y int
+}
@@ -1,2103 +1,4217 @@
+// Code generated by gen.go; DO NOT EDIT.
+
 package wmutil
 
-var (
-	Key_VoidSymbol                  uint32 = 0xffffff
-	Key_BackSpace                   uint32 = 0xff08
-	Key_Tab                         uint32 = 0xff09
-	Key_Linefeed                    uint32 = 0xff0a
-	Key_Clear                       uint32 = 0xff0b
-	Key_Return                      uint32 = 0xff0d
-	Key_Pause                       uint32 = 0xff13
-	Key_Scroll_Lock                 uint32 = 0xff14
-	Key_Sys_Req                     uint32 = 0xff15
-	Key_Escape                      uint32 = 0xff1b
-	Key_Delete                      uint32 = 0xffff
-	Key_Multi_key                   uint32 = 0xff20
-	Key_Codeinput                   uint32 = 0xff37
-	Key_SingleCandidate             uint32 = 0xff3c
-	Key_MultipleCandidate           uint32 = 0xff3d
-	Key_PreviousCandidate           uint32 = 0xff3e
-	Key_Kanji                       uint32 = 0xff21
-	Key_Muhenkan                    uint32 = 0xff22
-	Key_Henkan_Mode                 uint32 = 0xff23
-	Key_Henkan                      uint32 = 0xff23
-	Key_Romaji                      uint32 = 0xff24
-	Key_Hiragana                    uint32 = 0xff25
-	Key_Katakana                    uint32 = 0xff26
-	Key_Hiragana_Katakana           uint32 = 0xff27
-	Key_Zenkaku                     uint32 = 0xff28
-	Key_Hankaku                     uint32 = 0xff29
-	Key_Zenkaku_Hankaku             uint32 = 0xff2a
-	Key_Touroku                     uint32 = 0xff2b
-	Key_Massyo                      uint32 = 0xff2c
-	Key_Kana_Lock                   uint32 = 0xff2d
-	Key_Kana_Shift                  uint32 = 0xff2e
-	Key_Eisu_Shift                  uint32 = 0xff2f
-	Key_Eisu_toggle                 uint32 = 0xff30
-	Key_Kanji_Bangou                uint32 = 0xff37
-	Key_Zen_Koho                    uint32 = 0xff3d
-	Key_Mae_Koho                    uint32 = 0xff3e
-	Key_Home                        uint32 = 0xff50
-	Key_Left                        uint32 = 0xff51
-	Key_Up                          uint32 = 0xff52
-	Key_Right                       uint32 = 0xff53
-	Key_Down                        uint32 = 0xff54
-	Key_Prior                       uint32 = 0xff55
-	Key_Page_Up                     uint32 = 0xff55
-	Key_Next                        uint32 = 0xff56
-	Key_Page_Down                   uint32 = 0xff56
-	Key_End                         uint32 = 0xff57
-	Key_Begin                       uint32 = 0xff58
-	Key_Select                      uint32 = 0xff60
-	Key_Print                       uint32 = 0xff61
-	Key_Execute                     uint32 = 0xff62
-	Key_Insert                      uint32 = 0xff63
-	Key_Undo                        uint32 = 0xff65
-	Key_Redo                        uint32 = 0xff66
-	Key_Menu                        uint32 = 0xff67
-	Key_Find                        uint32 = 0xff68
-	Key_Cancel                      uint32 = 0xff69
-	Key_Help                        uint32 = 0xff6a
-	Key_Break                       uint32 = 0xff6b
-	Key_Mode_switch                 uint32 = 0xff7e
-	Key_script_switch               uint32 = 0xff7e
-	Key_Num_Lock                    uint32 = 0xff7f
-	Key_KP_Space                    uint32 = 0xff80
-	Key_KP_Tab                      uint32 = 0xff89
-	Key_KP_Enter                    uint32 = 0xff8d
-	Key_KP_F1                       uint32 = 0xff91
-	Key_KP_F2                       uint32 = 0xff92
-	Key_KP_F3                       uint32 = 0xff93
-	Key_KP_F4                       uint32 = 0xff94
-	Key_KP_Home                     uint32 = 0xff95
-	Key_KP_Left                     uint32 = 0xff96
-	Key_KP_Up                       uint32 = 0xff97
-	Key_KP_Right                    uint32 = 0xff98
-	Key_KP_Down                     uint32 = 0xff99
-	Key_KP_Prior                    uint32 = 0xff9a
-	Key_KP_Page_Up                  uint32 = 0xff9a
-	Key_KP_Next                     uint32 = 0xff9b
-	Key_KP_Page_Down                uint32 = 0xff9b
-	Key_KP_End                      uint32 = 0xff9c
-	Key_KP_Begin                    uint32 = 0xff9d
-	Key_KP_Insert                   uint32 = 0xff9e
-	Key_KP_Delete                   uint32 = 0xff9f
-	Key_KP_Equal                    uint32 = 0xffbd
-	Key_KP_Multiply                 uint32 = 0xffaa
-	Key_KP_Add                      uint32 = 0xffab
-	Key_KP_Separator                uint32 = 0xffac
-	Key_KP_Subtract                 uint32 = 0xffad
-	Key_KP_Decimal                  uint32 = 0xffae
-	Key_KP_Divide                   uint32 = 0xffaf
-	Key_KP_0                        uint32 = 0xffb0
-	Key_KP_1                        uint32 = 0xffb1
-	Key_KP_2                        uint32 = 0xffb2
-	Key_KP_3                        uint32 = 0xffb3
-	Key_KP_4                        uint32 = 0xffb4
-	Key_KP_5                        uint32 = 0xffb5
-	Key_KP_6                        uint32 = 0xffb6
-	Key_KP_7                        uint32 = 0xffb7
-	Key_KP_8                        uint32 = 0xffb8
-	Key_KP_9                        uint32 = 0xffb9
-	Key_F1                          uint32 = 0xffbe
-	Key_F2                          uint32 = 0xffbf
-	Key_F3                          uint32 = 0xffc0
-	Key_F4                          uint32 = 0xffc1
-	Key_F5                          uint32 = 0xffc2
-	Key_F6                          uint32 = 0xffc3
-	Key_F7                          uint32 = 0xffc4
-	Key_F8                          uint32 = 0xffc5
-	Key_F9                          uint32 = 0xffc6
-	Key_F10                         uint32 = 0xffc7
-	Key_F11                         uint32 = 0xffc8
-	Key_L1                          uint32 = 0xffc8
-	Key_F12                         uint32 = 0xffc9
-	Key_L2                          uint32 = 0xffc9
-	Key_F13                         uint32 = 0xffca
-	Key_L3                          uint32 = 0xffca
-	Key_F14                         uint32 = 0xffcb
-	Key_L4                          uint32 = 0xffcb
-	Key_F15                         uint32 = 0xffcc
-	Key_L5                          uint32 = 0xffcc
-	Key_F16                         uint32 = 0xffcd
-	Key_L6                          uint32 = 0xffcd
-	Key_F17                         uint32 = 0xffce
-	Key_L7                          uint32 = 0xffce
-	Key_F18                         uint32 = 0xffcf
-	Key_L8                          uint32 = 0xffcf
-	Key_F19                         uint32 = 0xffd0
-	Key_L9                          uint32 = 0xffd0
-	Key_F20                         uint32 = 0xffd1
-	Key_L10                         uint32 = 0xffd1
-	Key_F21                         uint32 = 0xffd2
-	Key_R1                          uint32 = 0xffd2
-	Key_F22                         uint32 = 0xffd3
-	Key_R2                          uint32 = 0xffd3
-	Key_F23                         uint32 = 0xffd4
-	Key_R3                          uint32 = 0xffd4
-	Key_F24                         uint32 = 0xffd5
-	Key_R4                          uint32 = 0xffd5
-	Key_F25                         uint32 = 0xffd6
-	Key_R5                          uint32 = 0xffd6
-	Key_F26                         uint32 = 0xffd7
-	Key_R6                          uint32 = 0xffd7
-	Key_F27                         uint32 = 0xffd8
-	Key_R7                          uint32 = 0xffd8
-	Key_F28                         uint32 = 0xffd9
-	Key_R8                          uint32 = 0xffd9
-	Key_F29                         uint32 = 0xffda
-	Key_R9                          uint32 = 0xffda
-	Key_F30                         uint32 = 0xffdb
-	Key_R10                         uint32 = 0xffdb
-	Key_F31                         uint32 = 0xffdc
-	Key_R11                         uint32 = 0xffdc
-	Key_F32                         uint32 = 0xffdd
-	Key_R12                         uint32 = 0xffdd
-	Key_F33                         uint32 = 0xffde
-	Key_R13                         uint32 = 0xffde
-	Key_F34                         uint32 = 0xffdf
-	Key_R14                         uint32 = 0xffdf
-	Key_F35                         uint32 = 0xffe0
-	Key_R15                         uint32 = 0xffe0
-	Key_Shift_L                     uint32 = 0xffe1
-	Key_Shift_R                     uint32 = 0xffe2
-	Key_Control_L                   uint32 = 0xffe3
-	Key_Control_R                   uint32 = 0xffe4
-	Key_Caps_Lock                   uint32 = 0xffe5
-	Key_Shift_Lock                  uint32 = 0xffe6
-	Key_Meta_L                      uint32 = 0xffe7
-	Key_Meta_R                      uint32 = 0xffe8
-	Key_Alt_L                       uint32 = 0xffe9
-	Key_Alt_R                       uint32 = 0xffea
-	Key_Super_L                     uint32 = 0xffeb
-	Key_Super_R                     uint32 = 0xffec
-	Key_Hyper_L                     uint32 = 0xffed
-	Key_Hyper_R                     uint32 = 0xffee
-	Key_ISO_Lock                    uint32 = 0xfe01
-	Key_ISO_Level2_Latch            uint32 = 0xfe02
-	Key_ISO_Level3_Shift            uint32 = 0xfe03
-	Key_ISO_Level3_Latch            uint32 = 0xfe04
-	Key_ISO_Level3_Lock             uint32 = 0xfe05
-	Key_ISO_Level5_Shift            uint32 = 0xfe11
-	Key_ISO_Level5_Latch            uint32 = 0xfe12
-	Key_ISO_Level5_Lock             uint32 = 0xfe13
-	Key_ISO_Group_Shift             uint32 = 0xff7e
-	Key_ISO_Group_Latch             uint32 = 0xfe06
-	Key_ISO_Group_Lock              uint32 = 0xfe07
-	Key_ISO_Next_Group              uint32 = 0xfe08
-	Key_ISO_Next_Group_Lock         uint32 = 0xfe09
-	Key_ISO_Prev_Group              uint32 = 0xfe0a
-	Key_ISO_Prev_Group_Lock         uint32 = 0xfe0b
-	Key_ISO_First_Group             uint32 = 0xfe0c
-	Key_ISO_First_Group_Lock        uint32 = 0xfe0d
-	Key_ISO_Last_Group              uint32 = 0xfe0e
-	Key_ISO_Last_Group_Lock         uint32 = 0xfe0f
-	Key_ISO_Left_Tab                uint32 = 0xfe20
-	Key_ISO_Move_Line_Up            uint32 = 0xfe21
-	Key_ISO_Move_Line_Down          uint32 = 0xfe22
-	Key_ISO_Partial_Line_Up         uint32 = 0xfe23
-	Key_ISO_Partial_Line_Down       uint32 = 0xfe24
-	Key_ISO_Partial_Space_Left      uint32 = 0xfe25
-	Key_ISO_Partial_Space_Right     uint32 = 0xfe26
-	Key_ISO_Set_Margin_Left         uint32 = 0xfe27
-	Key_ISO_Set_Margin_Right        uint32 = 0xfe28
-	Key_ISO_Release_Margin_Left     uint32 = 0xfe29
-	Key_ISO_Release_Margin_Right    uint32 = 0xfe2a
-	Key_ISO_Release_Both_Margins    uint32 = 0xfe2b
-	Key_ISO_Fast_Cursor_Left        uint32 = 0xfe2c
-	Key_ISO_Fast_Cursor_Right       uint32 = 0xfe2d
-	Key_ISO_Fast_Cursor_Up          uint32 = 0xfe2e
-	Key_ISO_Fast_Cursor_Down        uint32 = 0xfe2f
-	Key_ISO_Continuous_Underline    uint32 = 0xfe30
-	Key_ISO_Discontinuous_Underline uint32 = 0xfe31
-	Key_ISO_Emphasize               uint32 = 0xfe32
-	Key_ISO_Center_Object           uint32 = 0xfe33
-	Key_ISO_Enter                   uint32 = 0xfe34
-	Key_dead_grave                  uint32 = 0xfe50
-	Key_dead_acute                  uint32 = 0xfe51
-	Key_dead_circumflex             uint32 = 0xfe52
-	Key_dead_tilde                  uint32 = 0xfe53
-	Key_dead_perispomeni            uint32 = 0xfe53
-	Key_dead_macron                 uint32 = 0xfe54
-	Key_dead_breve                  uint32 = 0xfe55
-	Key_dead_abovedot               uint32 = 0xfe56
-	Key_dead_diaeresis              uint32 = 0xfe57
-	Key_dead_abovering              uint32 = 0xfe58
-	Key_dead_doubleacute            uint32 = 0xfe59
-	Key_dead_caron                  uint32 = 0xfe5a
-	Key_dead_cedilla                uint32 = 0xfe5b
-	Key_dead_ogonek                 uint32 = 0xfe5c
-	Key_dead_iota                   uint32 = 0xfe5d
-	Key_dead_voiced_sound           uint32 = 0xfe5e
-	Key_dead_semivoiced_sound       uint32 = 0xfe5f
-	Key_dead_belowdot               uint32 = 0xfe60
-	Key_dead_hook                   uint32 = 0xfe61
-	Key_dead_horn                   uint32 = 0xfe62
-	Key_dead_stroke                 uint32 = 0xfe63
-	Key_dead_abovecomma             uint32 = 0xfe64
-	Key_dead_psili                  uint32 = 0xfe64
-	Key_dead_abovereversedcomma     uint32 = 0xfe65
-	Key_dead_dasia                  uint32 = 0xfe65
-	Key_dead_doublegrave            uint32 = 0xfe66
-	Key_dead_belowring              uint32 = 0xfe67
-	Key_dead_belowmacron            uint32 = 0xfe68
-	Key_dead_belowcircumflex        uint32 = 0xfe69
-	Key_dead_belowtilde             uint32 = 0xfe6a
-	Key_dead_belowbreve             uint32 = 0xfe6b
-	Key_dead_belowdiaeresis         uint32 = 0xfe6c
-	Key_dead_invertedbreve          uint32 = 0xfe6d
-	Key_dead_belowcomma             uint32 = 0xfe6e
-	Key_dead_currency               uint32 = 0xfe6f
-	Key_dead_lowline                uint32 = 0xfe90
-	Key_dead_aboveverticalline      uint32 = 0xfe91
-	Key_dead_belowverticalline      uint32 = 0xfe92
-	Key_dead_longsolidusoverlay     uint32 = 0xfe93
-	Key_dead_a                      uint32 = 0xfe80
-	Key_dead_A                      uint32 = 0xfe81
-	Key_dead_e                      uint32 = 0xfe82
-	Key_dead_E                      uint32 = 0xfe83
-	Key_dead_i                      uint32 = 0xfe84
-	Key_dead_I                      uint32 = 0xfe85
-	Key_dead_o                      uint32 = 0xfe86
-	Key_dead_O                      uint32 = 0xfe87
-	Key_dead_u                      uint32 = 0xfe88
-	Key_dead_U                      uint32 = 0xfe89
-	Key_dead_small_schwa            uint32 = 0xfe8a
-	Key_dead_capital_schwa          uint32 = 0xfe8b
-	Key_dead_greek                  uint32 = 0xfe8c
-	Key_First_Virtual_Screen        uint32 = 0xfed0
-	Key_Prev_Virtual_Screen         uint32 = 0xfed1
-	Key_Next_Virtual_Screen         uint32 = 0xfed2
-	Key_Last_Virtual_Screen         uint32 = 0xfed4
-	Key_Terminate_Server            uint32 = 0xfed5
-	Key_AccessX_Enable              uint32 = 0xfe70
-	Key_AccessX_Feedback_Enable     uint32 = 0xfe71
-	Key_RepeatKeys_Enable           uint32 = 0xfe72
-	Key_SlowKeys_Enable             uint32 = 0xfe73
-	Key_BounceKeys_Enable           uint32 = 0xfe74
-	Key_StickyKeys_Enable           uint32 = 0xfe75
-	Key_MouseKeys_Enable            uint32 = 0xfe76
-	Key_MouseKeys_Accel_Enable      uint32 = 0xfe77
-	Key_Overlay1_Enable             uint32 = 0xfe78
-	Key_Overlay2_Enable             uint32 = 0xfe79
-	Key_AudibleBell_Enable          uint32 = 0xfe7a
-	Key_Pointer_Left                uint32 = 0xfee0
-	Key_Pointer_Right               uint32 = 0xfee1
-	Key_Pointer_Up                  uint32 = 0xfee2
-	Key_Pointer_Down                uint32 = 0xfee3
-	Key_Pointer_UpLeft              uint32 = 0xfee4
-	Key_Pointer_UpRight             uint32 = 0xfee5
-	Key_Pointer_DownLeft            uint32 = 0xfee6
-	Key_Pointer_DownRight           uint32 = 0xfee7
-	Key_Pointer_Button_Dflt         uint32 = 0xfee8
-	Key_Pointer_Button1             uint32 = 0xfee9
-	Key_Pointer_Button2             uint32 = 0xfeea
-	Key_Pointer_Button3             uint32 = 0xfeeb
-	Key_Pointer_Button4             uint32 = 0xfeec
-	Key_Pointer_Button5             uint32 = 0xfeed
-	Key_Pointer_DblClick_Dflt       uint32 = 0xfeee
-	Key_Pointer_DblClick1           uint32 = 0xfeef
-	Key_Pointer_DblClick2           uint32 = 0xfef0
-	Key_Pointer_DblClick3           uint32 = 0xfef1
-	Key_Pointer_DblClick4           uint32 = 0xfef2
-	Key_Pointer_DblClick5           uint32 = 0xfef3
-	Key_Pointer_Drag_Dflt           uint32 = 0xfef4
-	Key_Pointer_Drag1               uint32 = 0xfef5
-	Key_Pointer_Drag2               uint32 = 0xfef6
-	Key_Pointer_Drag3               uint32 = 0xfef7
-	Key_Pointer_Drag4               uint32 = 0xfef8
-	Key_Pointer_Drag5               uint32 = 0xfefd
-	Key_Pointer_EnableKeys          uint32 = 0xfef9
-	Key_Pointer_Accelerate          uint32 = 0xfefa
-	Key_Pointer_DfltBtnNext         uint32 = 0xfefb
-	Key_Pointer_DfltBtnPrev         uint32 = 0xfefc
-	Key_ch                          uint32 = 0xfea0
-	Key_Ch                          uint32 = 0xfea1
-	Key_CH                          uint32 = 0xfea2
-	Key_c_h                         uint32 = 0xfea3
-	Key_C_h                         uint32 = 0xfea4
-	Key_C_H                         uint32 = 0xfea5
-	Key_3270_Duplicate              uint32 = 0xfd01
-	Key_3270_FieldMark              uint32 = 0xfd02
-	Key_3270_Right2                 uint32 = 0xfd03
-	Key_3270_Left2                  uint32 = 0xfd04
-	Key_3270_BackTab                uint32 = 0xfd05
-	Key_3270_EraseEOF               uint32 = 0xfd06
-	Key_3270_EraseInput             uint32 = 0xfd07
-	Key_3270_Reset                  uint32 = 0xfd08
-	Key_3270_Quit                   uint32 = 0xfd09
-	Key_3270_PA1                    uint32 = 0xfd0a
-	Key_3270_PA2                    uint32 = 0xfd0b
-	Key_3270_PA3                    uint32 = 0xfd0c
-	Key_3270_Test                   uint32 = 0xfd0d
-	Key_3270_Attn                   uint32 = 0xfd0e
-	Key_3270_CursorBlink            uint32 = 0xfd0f
-	Key_3270_AltCursor              uint32 = 0xfd10
-	Key_3270_KeyClick               uint32 = 0xfd11
-	Key_3270_Jump                   uint32 = 0xfd12
-	Key_3270_Ident                  uint32 = 0xfd13
-	Key_3270_Rule                   uint32 = 0xfd14
-	Key_3270_Copy                   uint32 = 0xfd15
-	Key_3270_Play                   uint32 = 0xfd16
-	Key_3270_Setup                  uint32 = 0xfd17
-	Key_3270_Record                 uint32 = 0xfd18
-	Key_3270_ChangeScreen           uint32 = 0xfd19
-	Key_3270_DeleteWord             uint32 = 0xfd1a
-	Key_3270_ExSelect               uint32 = 0xfd1b
-	Key_3270_CursorSelect           uint32 = 0xfd1c
-	Key_3270_PrintScreen            uint32 = 0xfd1d
-	Key_3270_Enter                  uint32 = 0xfd1e
-	Key_space                       uint32 = 0x0020
-	Key_exclam                      uint32 = 0x0021
-	Key_quotedbl                    uint32 = 0x0022
-	Key_numbersign                  uint32 = 0x0023
-	Key_dollar                      uint32 = 0x0024
-	Key_percent                     uint32 = 0x0025
-	Key_ampersand                   uint32 = 0x0026
-	Key_apostrophe                  uint32 = 0x0027
-	Key_quoteright                  uint32 = 0x0027
-	Key_parenleft                   uint32 = 0x0028
-	Key_parenright                  uint32 = 0x0029
-	Key_asterisk                    uint32 = 0x002a
-	Key_plus                        uint32 = 0x002b
-	Key_comma                       uint32 = 0x002c
-	Key_minus                       uint32 = 0x002d
-	Key_period                      uint32 = 0x002e
-	Key_slash                       uint32 = 0x002f
-	Key_0                           uint32 = 0x0030
-	Key_1                           uint32 = 0x0031
-	Key_2                           uint32 = 0x0032
-	Key_3                           uint32 = 0x0033
-	Key_4                           uint32 = 0x0034
-	Key_5                           uint32 = 0x0035
-	Key_6                           uint32 = 0x0036
-	Key_7                           uint32 = 0x0037
-	Key_8                           uint32 = 0x0038
-	Key_9                           uint32 = 0x0039
-	Key_colon                       uint32 = 0x003a
-	Key_semicolon                   uint32 = 0x003b
-	Key_less                        uint32 = 0x003c
-	Key_equal                       uint32 = 0x003d
-	Key_greater                     uint32 = 0x003e
-	Key_question                    uint32 = 0x003f
-	Key_at                          uint32 = 0x0040
-	Key_A                           uint32 = 0x0041
-	Key_B                           uint32 = 0x0042
-	Key_C                           uint32 = 0x0043
-	Key_D                           uint32 = 0x0044
-	Key_E                           uint32 = 0x0045
-	Key_F                           uint32 = 0x0046
-	Key_G                           uint32 = 0x0047
-	Key_H                           uint32 = 0x0048
-	Key_I                           uint32 = 0x0049
-	Key_J                           uint32 = 0x004a
-	Key_K                           uint32 = 0x004b
-	Key_L                           uint32 = 0x004c
-	Key_M                           uint32 = 0x004d
-	Key_N                           uint32 = 0x004e
-	Key_O                           uint32 = 0x004f
-	Key_P                           uint32 = 0x0050
-	Key_Q                           uint32 = 0x0051
-	Key_R                           uint32 = 0x0052
-	Key_S                           uint32 = 0x0053
-	Key_T                           uint32 = 0x0054
-	Key_U                           uint32 = 0x0055
-	Key_V                           uint32 = 0x0056
-	Key_W                           uint32 = 0x0057
-	Key_X                           uint32 = 0x0058
-	Key_Y                           uint32 = 0x0059
-	Key_Z                           uint32 = 0x005a
-	Key_bracketleft                 uint32 = 0x005b
-	Key_backslash                   uint32 = 0x005c
-	Key_bracketright                uint32 = 0x005d
-	Key_asciicircum                 uint32 = 0x005e
-	Key_underscore                  uint32 = 0x005f
-	Key_grave                       uint32 = 0x0060
-	Key_quoteleft                   uint32 = 0x0060
-	Key_a                           uint32 = 0x0061
-	Key_b                           uint32 = 0x0062
-	Key_c                           uint32 = 0x0063
-	Key_d                           uint32 = 0x0064
-	Key_e                           uint32 = 0x0065
-	Key_f                           uint32 = 0x0066
-	Key_g                           uint32 = 0x0067
-	Key_h                           uint32 = 0x0068
-	Key_i                           uint32 = 0x0069
-	Key_j                           uint32 = 0x006a
-	Key_k                           uint32 = 0x006b
-	Key_l                           uint32 = 0x006c
-	Key_m                           uint32 = 0x006d
-	Key_n                           uint32 = 0x006e
-	Key_o                           uint32 = 0x006f
-	Key_p                           uint32 = 0x0070
-	Key_q                           uint32 = 0x0071
-	Key_r                           uint32 = 0x0072
-	Key_s                           uint32 = 0x0073
-	Key_t                           uint32 = 0x0074
-	Key_u                           uint32 = 0x0075
-	Key_v                           uint32 = 0x0076
-	Key_w                           uint32 = 0x0077
-	Key_x                           uint32 = 0x0078
-	Key_y                           uint32 = 0x0079
-	Key_z                           uint32 = 0x007a
-	Key_braceleft                   uint32 = 0x007b
-	Key_bar                         uint32 = 0x007c
-	Key_braceright                  uint32 = 0x007d
-	Key_asciitilde                  uint32 = 0x007e
-	Key_nobreakspace                uint32 = 0x00a0
-	Key_exclamdown                  uint32 = 0x00a1
-	Key_cent                        uint32 = 0x00a2
-	Key_sterling                    uint32 = 0x00a3
-	Key_currency                    uint32 = 0x00a4
-	Key_yen                         uint32 = 0x00a5
-	Key_brokenbar                   uint32 = 0x00a6
-	Key_section                     uint32 = 0x00a7
-	Key_diaeresis                   uint32 = 0x00a8
-	Key_copyright                   uint32 = 0x00a9
-	Key_ordfeminine                 uint32 = 0x00aa
-	Key_guillemotleft               uint32 = 0x00ab
-	Key_notsign                     uint32 = 0x00ac
-	Key_hyphen                      uint32 = 0x00ad
-	Key_registered                  uint32 = 0x00ae
-	Key_macron                      uint32 = 0x00af
-	Key_degree                      uint32 = 0x00b0
-	Key_plusminus                   uint32 = 0x00b1
-	Key_twosuperior                 uint32 = 0x00b2
-	Key_threesuperior               uint32 = 0x00b3
-	Key_acute                       uint32 = 0x00b4
-	Key_mu                          uint32 = 0x00b5
-	Key_paragraph                   uint32 = 0x00b6
-	Key_periodcentered              uint32 = 0x00b7
-	Key_cedilla                     uint32 = 0x00b8
-	Key_onesuperior                 uint32 = 0x00b9
-	Key_masculine                   uint32 = 0x00ba
-	Key_guillemotright              uint32 = 0x00bb
-	Key_onequarter                  uint32 = 0x00bc
-	Key_onehalf                     uint32 = 0x00bd
-	Key_threequarters               uint32 = 0x00be
-	Key_questiondown                uint32 = 0x00bf
-	Key_Agrave                      uint32 = 0x00c0
-	Key_Aacute                      uint32 = 0x00c1
-	Key_Acircumflex                 uint32 = 0x00c2
-	Key_Atilde                      uint32 = 0x00c3
-	Key_Adiaeresis                  uint32 = 0x00c4
-	Key_Aring                       uint32 = 0x00c5
-	Key_AE                          uint32 = 0x00c6
-	Key_Ccedilla                    uint32 = 0x00c7
-	Key_Egrave                      uint32 = 0x00c8
-	Key_Eacute                      uint32 = 0x00c9
-	Key_Ecircumflex                 uint32 = 0x00ca
-	Key_Ediaeresis                  uint32 = 0x00cb
-	Key_Igrave                      uint32 = 0x00cc
-	Key_Iacute                      uint32 = 0x00cd
-	Key_Icircumflex                 uint32 = 0x00ce
-	Key_Idiaeresis                  uint32 = 0x00cf
-	Key_ETH                         uint32 = 0x00d0
-	Key_Eth                         uint32 = 0x00d0
-	Key_Ntilde                      uint32 = 0x00d1
-	Key_Ograve                      uint32 = 0x00d2
-	Key_Oacute                      uint32 = 0x00d3
-	Key_Ocircumflex                 uint32 = 0x00d4
-	Key_Otilde                      uint32 = 0x00d5
-	Key_Odiaeresis                  uint32 = 0x00d6
-	Key_multiply                    uint32 = 0x00d7
-	Key_Oslash                      uint32 = 0x00d8
-	Key_Ooblique                    uint32 = 0x00d8
-	Key_Ugrave                      uint32 = 0x00d9
-	Key_Uacute                      uint32 = 0x00da
-	Key_Ucircumflex                 uint32 = 0x00db
-	Key_Udiaeresis                  uint32 = 0x00dc
-	Key_Yacute                      uint32 = 0x00dd
-	Key_THORN                       uint32 = 0x00de
-	Key_Thorn                       uint32 = 0x00de
-	Key_ssharp                      uint32 = 0x00df
-	Key_agrave                      uint32 = 0x00e0
-	Key_aacute                      uint32 = 0x00e1
-	Key_acircumflex                 uint32 = 0x00e2
-	Key_atilde                      uint32 = 0x00e3
-	Key_adiaeresis                  uint32 = 0x00e4
-	Key_aring                       uint32 = 0x00e5
-	Key_ae                          uint32 = 0x00e6
-	Key_ccedilla                    uint32 = 0x00e7
-	Key_egrave                      uint32 = 0x00e8
-	Key_eacute                      uint32 = 0x00e9
-	Key_ecircumflex                 uint32 = 0x00ea
-	Key_ediaeresis                  uint32 = 0x00eb
-	Key_igrave                      uint32 = 0x00ec
-	Key_iacute                      uint32 = 0x00ed
-	Key_icircumflex                 uint32 = 0x00ee
-	Key_idiaeresis                  uint32 = 0x00ef
-	Key_eth                         uint32 = 0x00f0
-	Key_ntilde                      uint32 = 0x00f1
-	Key_ograve                      uint32 = 0x00f2
-	Key_oacute                      uint32 = 0x00f3
-	Key_ocircumflex                 uint32 = 0x00f4
-	Key_otilde                      uint32 = 0x00f5
-	Key_odiaeresis                  uint32 = 0x00f6
-	Key_division                    uint32 = 0x00f7
-	Key_oslash                      uint32 = 0x00f8
-	Key_ooblique                    uint32 = 0x00f8
-	Key_ugrave                      uint32 = 0x00f9
-	Key_uacute                      uint32 = 0x00fa
-	Key_ucircumflex                 uint32 = 0x00fb
-	Key_udiaeresis                  uint32 = 0x00fc
-	Key_yacute                      uint32 = 0x00fd
-	Key_thorn                       uint32 = 0x00fe
-	Key_ydiaeresis                  uint32 = 0x00ff
-	Key_Aogonek                     uint32 = 0x01a1
-	Key_breve                       uint32 = 0x01a2
-	Key_Lstroke                     uint32 = 0x01a3
-	Key_Lcaron                      uint32 = 0x01a5
-	Key_Sacute                      uint32 = 0x01a6
-	Key_Scaron                      uint32 = 0x01a9
-	Key_Scedilla                    uint32 = 0x01aa
-	Key_Tcaron                      uint32 = 0x01ab
-	Key_Zacute                      uint32 = 0x01ac
-	Key_Zcaron                      uint32 = 0x01ae
-	Key_Zabovedot                   uint32 = 0x01af
-	Key_aogonek                     uint32 = 0x01b1
-	Key_ogonek                      uint32 = 0x01b2
-	Key_lstroke                     uint32 = 0x01b3
-	Key_lcaron                      uint32 = 0x01b5
-	Key_sacute                      uint32 = 0x01b6
-	Key_caron                       uint32 = 0x01b7
-	Key_scaron                      uint32 = 0x01b9
-	Key_scedilla                    uint32 = 0x01ba
-	Key_tcaron                      uint32 = 0x01bb
-	Key_zacute                      uint32 = 0x01bc
-	Key_doubleacute                 uint32 = 0x01bd
-	Key_zcaron                      uint32 = 0x01be
-	Key_zabovedot                   uint32 = 0x01bf
-	Key_Racute                      uint32 = 0x01c0
-	Key_Abreve                      uint32 = 0x01c3
-	Key_Lacute                      uint32 = 0x01c5
-	Key_Cacute                      uint32 = 0x01c6
-	Key_Ccaron                      uint32 = 0x01c8
-	Key_Eogonek                     uint32 = 0x01ca
-	Key_Ecaron                      uint32 = 0x01cc
-	Key_Dcaron                      uint32 = 0x01cf
-	Key_Dstroke                     uint32 = 0x01d0
-	Key_Nacute                      uint32 = 0x01d1
-	Key_Ncaron                      uint32 = 0x01d2
-	Key_Odoubleacute                uint32 = 0x01d5
-	Key_Rcaron                      uint32 = 0x01d8
-	Key_Uring                       uint32 = 0x01d9
-	Key_Udoubleacute                uint32 = 0x01db
-	Key_Tcedilla                    uint32 = 0x01de
-	Key_racute                      uint32 = 0x01e0
-	Key_abreve                      uint32 = 0x01e3
-	Key_lacute                      uint32 = 0x01e5
-	Key_cacute                      uint32 = 0x01e6
-	Key_ccaron                      uint32 = 0x01e8
-	Key_eogonek                     uint32 = 0x01ea
-	Key_ecaron                      uint32 = 0x01ec
-	Key_dcaron                      uint32 = 0x01ef
-	Key_dstroke                     uint32 = 0x01f0
-	Key_nacute                      uint32 = 0x01f1
-	Key_ncaron                      uint32 = 0x01f2
-	Key_odoubleacute                uint32 = 0x01f5
-	Key_rcaron                      uint32 = 0x01f8
-	Key_uring                       uint32 = 0x01f9
-	Key_udoubleacute                uint32 = 0x01fb
-	Key_tcedilla                    uint32 = 0x01fe
-	Key_abovedot                    uint32 = 0x01ff
-	Key_Hstroke                     uint32 = 0x02a1
-	Key_Hcircumflex                 uint32 = 0x02a6
-	Key_Iabovedot                   uint32 = 0x02a9
-	Key_Gbreve                      uint32 = 0x02ab
-	Key_Jcircumflex                 uint32 = 0x02ac
-	Key_hstroke                     uint32 = 0x02b1
-	Key_hcircumflex                 uint32 = 0x02b6
-	Key_idotless                    uint32 = 0x02b9
-	Key_gbreve                      uint32 = 0x02bb
-	Key_jcircumflex                 uint32 = 0x02bc
-	Key_Cabovedot                   uint32 = 0x02c5
-	Key_Ccircumflex                 uint32 = 0x02c6
-	Key_Gabovedot                   uint32 = 0x02d5
-	Key_Gcircumflex                 uint32 = 0x02d8
-	Key_Ubreve                      uint32 = 0x02dd
-	Key_Scircumflex                 uint32 = 0x02de
-	Key_cabovedot                   uint32 = 0x02e5
-	Key_ccircumflex                 uint32 = 0x02e6
-	Key_gabovedot                   uint32 = 0x02f5
-	Key_gcircumflex                 uint32 = 0x02f8
-	Key_ubreve                      uint32 = 0x02fd
-	Key_scircumflex                 uint32 = 0x02fe
-	Key_kra                         uint32 = 0x03a2
-	Key_kappa                       uint32 = 0x03a2
-	Key_Rcedilla                    uint32 = 0x03a3
-	Key_Itilde                      uint32 = 0x03a5
-	Key_Lcedilla                    uint32 = 0x03a6
-	Key_Emacron                     uint32 = 0x03aa
-	Key_Gcedilla                    uint32 = 0x03ab
-	Key_Tslash                      uint32 = 0x03ac
-	Key_rcedilla                    uint32 = 0x03b3
-	Key_itilde                      uint32 = 0x03b5
-	Key_lcedilla                    uint32 = 0x03b6
-	Key_emacron                     uint32 = 0x03ba
-	Key_gcedilla                    uint32 = 0x03bb
-	Key_tslash                      uint32 = 0x03bc
-	Key_ENG                         uint32 = 0x03bd
-	Key_eng                         uint32 = 0x03bf
-	Key_Amacron                     uint32 = 0x03c0
-	Key_Iogonek                     uint32 = 0x03c7
-	Key_Eabovedot                   uint32 = 0x03cc
-	Key_Imacron                     uint32 = 0x03cf
-	Key_Ncedilla                    uint32 = 0x03d1
-	Key_Omacron                     uint32 = 0x03d2
-	Key_Kcedilla                    uint32 = 0x03d3
-	Key_Uogonek                     uint32 = 0x03d9
-	Key_Utilde                      uint32 = 0x03dd
-	Key_Umacron                     uint32 = 0x03de
-	Key_amacron                     uint32 = 0x03e0
-	Key_iogonek                     uint32 = 0x03e7
-	Key_eabovedot                   uint32 = 0x03ec
-	Key_imacron                     uint32 = 0x03ef
-	Key_ncedilla                    uint32 = 0x03f1
-	Key_omacron                     uint32 = 0x03f2
-	Key_kcedilla                    uint32 = 0x03f3
-	Key_uogonek                     uint32 = 0x03f9
-	Key_utilde                      uint32 = 0x03fd
-	Key_umacron                     uint32 = 0x03fe
-	Key_Wcircumflex                 uint32 = 0x1000174
-	Key_wcircumflex                 uint32 = 0x1000175
-	Key_Ycircumflex                 uint32 = 0x1000176
-	Key_ycircumflex                 uint32 = 0x1000177
-	Key_Babovedot                   uint32 = 0x1001e02
-	Key_babovedot                   uint32 = 0x1001e03
-	Key_Dabovedot                   uint32 = 0x1001e0a
-	Key_dabovedot                   uint32 = 0x1001e0b
-	Key_Fabovedot                   uint32 = 0x1001e1e
-	Key_fabovedot                   uint32 = 0x1001e1f
-	Key_Mabovedot                   uint32 = 0x1001e40
-	Key_mabovedot                   uint32 = 0x1001e41
-	Key_Pabovedot                   uint32 = 0x1001e56
-	Key_pabovedot                   uint32 = 0x1001e57
-	Key_Sabovedot                   uint32 = 0x1001e60
-	Key_sabovedot                   uint32 = 0x1001e61
-	Key_Tabovedot                   uint32 = 0x1001e6a
-	Key_tabovedot                   uint32 = 0x1001e6b
-	Key_Wgrave                      uint32 = 0x1001e80
-	Key_wgrave                      uint32 = 0x1001e81
-	Key_Wacute                      uint32 = 0x1001e82
-	Key_wacute                      uint32 = 0x1001e83
-	Key_Wdiaeresis                  uint32 = 0x1001e84
-	Key_wdiaeresis                  uint32 = 0x1001e85
-	Key_Ygrave                      uint32 = 0x1001ef2
-	Key_ygrave                      uint32 = 0x1001ef3
-	Key_OE                          uint32 = 0x13bc
-	Key_oe                          uint32 = 0x13bd
-	Key_Ydiaeresis                  uint32 = 0x13be
-	Key_overline                    uint32 = 0x047e
-	Key_kana_fullstop               uint32 = 0x04a1
-	Key_kana_openingbracket         uint32 = 0x04a2
-	Key_kana_closingbracket         uint32 = 0x04a3
-	Key_kana_comma                  uint32 = 0x04a4
-	Key_kana_conjunctive            uint32 = 0x04a5
-	Key_kana_middledot              uint32 = 0x04a5
-	Key_kana_WO                     uint32 = 0x04a6
-	Key_kana_a                      uint32 = 0x04a7
-	Key_kana_i                      uint32 = 0x04a8
-	Key_kana_u                      uint32 = 0x04a9
-	Key_kana_e                      uint32 = 0x04aa
-	Key_kana_o                      uint32 = 0x04ab
-	Key_kana_ya                     uint32 = 0x04ac
-	Key_kana_yu                     uint32 = 0x04ad
-	Key_kana_yo                     uint32 = 0x04ae
-	Key_kana_tsu                    uint32 = 0x04af
-	Key_kana_tu                     uint32 = 0x04af
-	Key_prolongedsound              uint32 = 0x04b0
-	Key_kana_A                      uint32 = 0x04b1
-	Key_kana_I                      uint32 = 0x04b2
-	Key_kana_U                      uint32 = 0x04b3
-	Key_kana_E                      uint32 = 0x04b4
-	Key_kana_O                      uint32 = 0x04b5
-	Key_kana_KA                     uint32 = 0x04b6
-	Key_kana_KI                     uint32 = 0x04b7
-	Key_kana_KU                     uint32 = 0x04b8
-	Key_kana_KE                     uint32 = 0x04b9
-	Key_kana_KO                     uint32 = 0x04ba
-	Key_kana_SA                     uint32 = 0x04bb
-	Key_kana_SHI                    uint32 = 0x04bc
-	Key_kana_SU                     uint32 = 0x04bd
-	Key_kana_SE                     uint32 = 0x04be
-	Key_kana_SO                     uint32 = 0x04bf
-	Key_kana_TA                     uint32 = 0x04c0
-	Key_kana_CHI                    uint32 = 0x04c1
-	Key_kana_TI                     uint32 = 0x04c1
-	Key_kana_TSU                    uint32 = 0x04c2
-	Key_kana_TU                     uint32 = 0x04c2
-	Key_kana_TE                     uint32 = 0x04c3
-	Key_kana_TO                     uint32 = 0x04c4
-	Key_kana_NA                     uint32 = 0x04c5
-	Key_kana_NI                     uint32 = 0x04c6
-	Key_kana_NU                     uint32 = 0x04c7
-	Key_kana_NE                     uint32 = 0x04c8
-	Key_kana_NO                     uint32 = 0x04c9
-	Key_kana_HA                     uint32 = 0x04ca
-	Key_kana_HI                     uint32 = 0x04cb
-	Key_kana_FU                     uint32 = 0x04cc
-	Key_kana_HU                     uint32 = 0x04cc
-	Key_kana_HE                     uint32 = 0x04cd
-	Key_kana_HO                     uint32 = 0x04ce
-	Key_kana_MA                     uint32 = 0x04cf
-	Key_kana_MI                     uint32 = 0x04d0
-	Key_kana_MU                     uint32 = 0x04d1
-	Key_kana_ME                     uint32 = 0x04d2
-	Key_kana_MO                     uint32 = 0x04d3
-	Key_kana_YA                     uint32 = 0x04d4
-	Key_kana_YU                     uint32 = 0x04d5
-	Key_kana_YO                     uint32 = 0x04d6
-	Key_kana_RA                     uint32 = 0x04d7
-	Key_kana_RI                     uint32 = 0x04d8
-	Key_kana_RU                     uint32 = 0x04d9
-	Key_kana_RE                     uint32 = 0x04da
-	Key_kana_RO                     uint32 = 0x04db
-	Key_kana_WA                     uint32 = 0x04dc
-	Key_kana_N                      uint32 = 0x04dd
-	Key_voicedsound                 uint32 = 0x04de
-	Key_semivoicedsound             uint32 = 0x04df
-	Key_kana_switch                 uint32 = 0xff7e
-	Key_Farsi_0                     uint32 = 0x10006f0
-	Key_Farsi_1                     uint32 = 0x10006f1
-	Key_Farsi_2                     uint32 = 0x10006f2
-	Key_Farsi_3                     uint32 = 0x10006f3
-	Key_Farsi_4                     uint32 = 0x10006f4
-	Key_Farsi_5                     uint32 = 0x10006f5
-	Key_Farsi_6                     uint32 = 0x10006f6
-	Key_Farsi_7                     uint32 = 0x10006f7
-	Key_Farsi_8                     uint32 = 0x10006f8
-	Key_Farsi_9                     uint32 = 0x10006f9
-	Key_Arabic_percent              uint32 = 0x100066a
-	Key_Arabic_superscript_alef     uint32 = 0x1000670
-	Key_Arabic_tteh                 uint32 = 0x1000679
-	Key_Arabic_peh                  uint32 = 0x100067e
-	Key_Arabic_tcheh                uint32 = 0x1000686
-	Key_Arabic_ddal                 uint32 = 0x1000688
-	Key_Arabic_rreh                 uint32 = 0x1000691
-	Key_Arabic_comma                uint32 = 0x05ac
-	Key_Arabic_fullstop             uint32 = 0x10006d4
-	Key_Arabic_0                    uint32 = 0x1000660
-	Key_Arabic_1                    uint32 = 0x1000661
-	Key_Arabic_2                    uint32 = 0x1000662
-	Key_Arabic_3                    uint32 = 0x1000663
-	Key_Arabic_4                    uint32 = 0x1000664
-	Key_Arabic_5                    uint32 = 0x1000665
-	Key_Arabic_6                    uint32 = 0x1000666
-	Key_Arabic_7                    uint32 = 0x1000667
-	Key_Arabic_8                    uint32 = 0x1000668
-	Key_Arabic_9                    uint32 = 0x1000669
-	Key_Arabic_semicolon            uint32 = 0x05bb
-	Key_Arabic_question_mark        uint32 = 0x05bf
-	Key_Arabic_hamza                uint32 = 0x05c1
-	Key_Arabic_maddaonalef          uint32 = 0x05c2
-	Key_Arabic_hamzaonalef          uint32 = 0x05c3
-	Key_Arabic_hamzaonwaw           uint32 = 0x05c4
-	Key_Arabic_hamzaunderalef       uint32 = 0x05c5
-	Key_Arabic_hamzaonyeh           uint32 = 0x05c6
-	Key_Arabic_alef                 uint32 = 0x05c7
-	Key_Arabic_beh                  uint32 = 0x05c8
-	Key_Arabic_tehmarbuta           uint32 = 0x05c9
-	Key_Arabic_teh                  uint32 = 0x05ca
-	Key_Arabic_theh                 uint32 = 0x05cb
-	Key_Arabic_jeem                 uint32 = 0x05cc
-	Key_Arabic_hah                  uint32 = 0x05cd
-	Key_Arabic_khah                 uint32 = 0x05ce
-	Key_Arabic_dal                  uint32 = 0x05cf
-	Key_Arabic_thal                 uint32 = 0x05d0
-	Key_Arabic_ra                   uint32 = 0x05d1
-	Key_Arabic_zain                 uint32 = 0x05d2
-	Key_Arabic_seen                 uint32 = 0x05d3
-	Key_Arabic_sheen                uint32 = 0x05d4
-	Key_Arabic_sad                  uint32 = 0x05d5
-	Key_Arabic_dad                  uint32 = 0x05d6
-	Key_Arabic_tah                  uint32 = 0x05d7
-	Key_Arabic_zah                  uint32 = 0x05d8
-	Key_Arabic_ain                  uint32 = 0x05d9
-	Key_Arabic_ghain                uint32 = 0x05da
-	Key_Arabic_tatweel              uint32 = 0x05e0
-	Key_Arabic_feh                  uint32 = 0x05e1
-	Key_Arabic_qaf                  uint32 = 0x05e2
-	Key_Arabic_kaf                  uint32 = 0x05e3
-	Key_Arabic_lam                  uint32 = 0x05e4
-	Key_Arabic_meem                 uint32 = 0x05e5
-	Key_Arabic_noon                 uint32 = 0x05e6
-	Key_Arabic_ha                   uint32 = 0x05e7
-	Key_Arabic_heh                  uint32 = 0x05e7
-	Key_Arabic_waw                  uint32 = 0x05e8
-	Key_Arabic_alefmaksura          uint32 = 0x05e9
-	Key_Arabic_yeh                  uint32 = 0x05ea
-	Key_Arabic_fathatan             uint32 = 0x05eb
-	Key_Arabic_dammatan             uint32 = 0x05ec
-	Key_Arabic_kasratan             uint32 = 0x05ed
-	Key_Arabic_fatha                uint32 = 0x05ee
-	Key_Arabic_damma                uint32 = 0x05ef
-	Key_Arabic_kasra                uint32 = 0x05f0
-	Key_Arabic_shadda               uint32 = 0x05f1
-	Key_Arabic_sukun                uint32 = 0x05f2
-	Key_Arabic_madda_above          uint32 = 0x1000653
-	Key_Arabic_hamza_above          uint32 = 0x1000654
-	Key_Arabic_hamza_below          uint32 = 0x1000655
-	Key_Arabic_jeh                  uint32 = 0x1000698
-	Key_Arabic_veh                  uint32 = 0x10006a4
-	Key_Arabic_keheh                uint32 = 0x10006a9
-	Key_Arabic_gaf                  uint32 = 0x10006af
-	Key_Arabic_noon_ghunna          uint32 = 0x10006ba
-	Key_Arabic_heh_doachashmee      uint32 = 0x10006be
-	Key_Farsi_yeh                   uint32 = 0x10006cc
-	Key_Arabic_farsi_yeh            uint32 = 0x10006cc
-	Key_Arabic_yeh_baree            uint32 = 0x10006d2
-	Key_Arabic_heh_goal             uint32 = 0x10006c1
-	Key_Arabic_switch               uint32 = 0xff7e
-	Key_Cyrillic_GHE_bar            uint32 = 0x1000492
-	Key_Cyrillic_ghe_bar            uint32 = 0x1000493
-	Key_Cyrillic_ZHE_descender      uint32 = 0x1000496
-	Key_Cyrillic_zhe_descender      uint32 = 0x1000497
-	Key_Cyrillic_KA_descender       uint32 = 0x100049a
-	Key_Cyrillic_ka_descender       uint32 = 0x100049b
-	Key_Cyrillic_KA_vertstroke      uint32 = 0x100049c
-	Key_Cyrillic_ka_vertstroke      uint32 = 0x100049d
-	Key_Cyrillic_EN_descender       uint32 = 0x10004a2
-	Key_Cyrillic_en_descender       uint32 = 0x10004a3
-	Key_Cyrillic_U_straight         uint32 = 0x10004ae
-	Key_Cyrillic_u_straight         uint32 = 0x10004af
-	Key_Cyrillic_U_straight_bar     uint32 = 0x10004b0
-	Key_Cyrillic_u_straight_bar     uint32 = 0x10004b1
-	Key_Cyrillic_HA_descender       uint32 = 0x10004b2
-	Key_Cyrillic_ha_descender       uint32 = 0x10004b3
-	Key_Cyrillic_CHE_descender      uint32 = 0x10004b6
-	Key_Cyrillic_che_descender      uint32 = 0x10004b7
-	Key_Cyrillic_CHE_vertstroke     uint32 = 0x10004b8
-	Key_Cyrillic_che_vertstroke     uint32 = 0x10004b9
-	Key_Cyrillic_SHHA               uint32 = 0x10004ba
-	Key_Cyrillic_shha               uint32 = 0x10004bb
-	Key_Cyrillic_SCHWA              uint32 = 0x10004d8
-	Key_Cyrillic_schwa              uint32 = 0x10004d9
-	Key_Cyrillic_I_macron           uint32 = 0x10004e2
-	Key_Cyrillic_i_macron           uint32 = 0x10004e3
-	Key_Cyrillic_O_bar              uint32 = 0x10004e8
-	Key_Cyrillic_o_bar              uint32 = 0x10004e9
-	Key_Cyrillic_U_macron           uint32 = 0x10004ee
-	Key_Cyrillic_u_macron           uint32 = 0x10004ef
-	Key_Serbian_dje                 uint32 = 0x06a1
-	Key_Macedonia_gje               uint32 = 0x06a2
-	Key_Cyrillic_io                 uint32 = 0x06a3
-	Key_Ukrainian_ie                uint32 = 0x06a4
-	Key_Ukranian_je                 uint32 = 0x06a4
-	Key_Macedonia_dse               uint32 = 0x06a5
-	Key_Ukrainian_i                 uint32 = 0x06a6
-	Key_Ukranian_i                  uint32 = 0x06a6
-	Key_Ukrainian_yi                uint32 = 0x06a7
-	Key_Ukranian_yi                 uint32 = 0x06a7
-	Key_Cyrillic_je                 uint32 = 0x06a8
-	Key_Serbian_je                  uint32 = 0x06a8
-	Key_Cyrillic_lje                uint32 = 0x06a9
-	Key_Serbian_lje                 uint32 = 0x06a9
-	Key_Cyrillic_nje                uint32 = 0x06aa
-	Key_Serbian_nje                 uint32 = 0x06aa
-	Key_Serbian_tshe                uint32 = 0x06ab
-	Key_Macedonia_kje               uint32 = 0x06ac
-	Key_Ukrainian_ghe_with_upturn   uint32 = 0x06ad
-	Key_Byelorussian_shortu         uint32 = 0x06ae
-	Key_Cyrillic_dzhe               uint32 = 0x06af
-	Key_Serbian_dze                 uint32 = 0x06af
-	Key_numerosign                  uint32 = 0x06b0
-	Key_Serbian_DJE                 uint32 = 0x06b1
-	Key_Macedonia_GJE               uint32 = 0x06b2
-	Key_Cyrillic_IO                 uint32 = 0x06b3
-	Key_Ukrainian_IE                uint32 = 0x06b4
-	Key_Ukranian_JE                 uint32 = 0x06b4
-	Key_Macedonia_DSE               uint32 = 0x06b5
-	Key_Ukrainian_I                 uint32 = 0x06b6
-	Key_Ukranian_I                  uint32 = 0x06b6
-	Key_Ukrainian_YI                uint32 = 0x06b7
-	Key_Ukranian_YI                 uint32 = 0x06b7
-	Key_Cyrillic_JE                 uint32 = 0x06b8
-	Key_Serbian_JE                  uint32 = 0x06b8
-	Key_Cyrillic_LJE                uint32 = 0x06b9
-	Key_Serbian_LJE                 uint32 = 0x06b9
-	Key_Cyrillic_NJE                uint32 = 0x06ba
-	Key_Serbian_NJE                 uint32 = 0x06ba
-	Key_Serbian_TSHE                uint32 = 0x06bb
-	Key_Macedonia_KJE               uint32 = 0x06bc
-	Key_Ukrainian_GHE_WITH_UPTURN   uint32 = 0x06bd
-	Key_Byelorussian_SHORTU         uint32 = 0x06be
-	Key_Cyrillic_DZHE               uint32 = 0x06bf
-	Key_Serbian_DZE                 uint32 = 0x06bf
-	Key_Cyrillic_yu                 uint32 = 0x06c0
-	Key_Cyrillic_a                  uint32 = 0x06c1
-	Key_Cyrillic_be                 uint32 = 0x06c2
-	Key_Cyrillic_tse                uint32 = 0x06c3
-	Key_Cyrillic_de                 uint32 = 0x06c4
-	Key_Cyrillic_ie                 uint32 = 0x06c5
-	Key_Cyrillic_ef                 uint32 = 0x06c6
-	Key_Cyrillic_ghe                uint32 = 0x06c7
-	Key_Cyrillic_ha                 uint32 = 0x06c8
-	Key_Cyrillic_i                  uint32 = 0x06c9
-	Key_Cyrillic_shorti             uint32 = 0x06ca
-	Key_Cyrillic_ka                 uint32 = 0x06cb
-	Key_Cyrillic_el                 uint32 = 0x06cc
-	Key_Cyrillic_em                 uint32 = 0x06cd
-	Key_Cyrillic_en                 uint32 = 0x06ce
-	Key_Cyrillic_o                  uint32 = 0x06cf
-	Key_Cyrillic_pe                 uint32 = 0x06d0
-	Key_Cyrillic_ya                 uint32 = 0x06d1
-	Key_Cyrillic_er                 uint32 = 0x06d2
-	Key_Cyrillic_es                 uint32 = 0x06d3
-	Key_Cyrillic_te                 uint32 = 0x06d4
-	Key_Cyrillic_u                  uint32 = 0x06d5
-	Key_Cyrillic_zhe                uint32 = 0x06d6
-	Key_Cyrillic_ve                 uint32 = 0x06d7
-	Key_Cyrillic_softsign           uint32 = 0x06d8
-	Key_Cyrillic_yeru               uint32 = 0x06d9
-	Key_Cyrillic_ze                 uint32 = 0x06da
-	Key_Cyrillic_sha                uint32 = 0x06db
-	Key_Cyrillic_e                  uint32 = 0x06dc
-	Key_Cyrillic_shcha              uint32 = 0x06dd
-	Key_Cyrillic_che                uint32 = 0x06de
-	Key_Cyrillic_hardsign           uint32 = 0x06df
-	Key_Cyrillic_YU                 uint32 = 0x06e0
-	Key_Cyrillic_A                  uint32 = 0x06e1
-	Key_Cyrillic_BE                 uint32 = 0x06e2
-	Key_Cyrillic_TSE                uint32 = 0x06e3
-	Key_Cyrillic_DE                 uint32 = 0x06e4
-	Key_Cyrillic_IE                 uint32 = 0x06e5
-	Key_Cyrillic_EF                 uint32 = 0x06e6
-	Key_Cyrillic_GHE                uint32 = 0x06e7
-	Key_Cyrillic_HA                 uint32 = 0x06e8
-	Key_Cyrillic_I                  uint32 = 0x06e9
-	Key_Cyrillic_SHORTI             uint32 = 0x06ea
-	Key_Cyrillic_KA                 uint32 = 0x06eb
-	Key_Cyrillic_EL                 uint32 = 0x06ec
-	Key_Cyrillic_EM                 uint32 = 0x06ed
-	Key_Cyrillic_EN                 uint32 = 0x06ee
-	Key_Cyrillic_O                  uint32 = 0x06ef
-	Key_Cyrillic_PE                 uint32 = 0x06f0
-	Key_Cyrillic_YA                 uint32 = 0x06f1
-	Key_Cyrillic_ER                 uint32 = 0x06f2
-	Key_Cyrillic_ES                 uint32 = 0x06f3
-	Key_Cyrillic_TE                 uint32 = 0x06f4
-	Key_Cyrillic_U                  uint32 = 0x06f5
-	Key_Cyrillic_ZHE                uint32 = 0x06f6
-	Key_Cyrillic_VE                 uint32 = 0x06f7
-	Key_Cyrillic_SOFTSIGN           uint32 = 0x06f8
-	Key_Cyrillic_YERU               uint32 = 0x06f9
-	Key_Cyrillic_ZE                 uint32 = 0x06fa
-	Key_Cyrillic_SHA                uint32 = 0x06fb
-	Key_Cyrillic_E                  uint32 = 0x06fc
-	Key_Cyrillic_SHCHA              uint32 = 0x06fd
-	Key_Cyrillic_CHE                uint32 = 0x06fe
-	Key_Cyrillic_HARDSIGN           uint32 = 0x06ff
-	Key_Greek_ALPHAaccent           uint32 = 0x07a1
-	Key_Greek_EPSILONaccent         uint32 = 0x07a2
-	Key_Greek_ETAaccent             uint32 = 0x07a3
-	Key_Greek_IOTAaccent            uint32 = 0x07a4
-	Key_Greek_IOTAdieresis          uint32 = 0x07a5
-	Key_Greek_IOTAdiaeresis         uint32 = 0x07a5
-	Key_Greek_OMICRONaccent         uint32 = 0x07a7
-	Key_Greek_UPSILONaccent         uint32 = 0x07a8
-	Key_Greek_UPSILONdieresis       uint32 = 0x07a9
-	Key_Greek_OMEGAaccent           uint32 = 0x07ab
-	Key_Greek_accentdieresis        uint32 = 0x07ae
-	Key_Greek_horizbar              uint32 = 0x07af
-	Key_Greek_alphaaccent           uint32 = 0x07b1
-	Key_Greek_epsilonaccent         uint32 = 0x07b2
-	Key_Greek_etaaccent             uint32 = 0x07b3
-	Key_Greek_iotaaccent            uint32 = 0x07b4
-	Key_Greek_iotadieresis          uint32 = 0x07b5
-	Key_Greek_iotaaccentdieresis    uint32 = 0x07b6
-	Key_Greek_omicronaccent         uint32 = 0x07b7
-	Key_Greek_upsilonaccent         uint32 = 0x07b8
-	Key_Greek_upsilondieresis       uint32 = 0x07b9
-	Key_Greek_upsilonaccentdieresis uint32 = 0x07ba
-	Key_Greek_omegaaccent           uint32 = 0x07bb
-	Key_Greek_ALPHA                 uint32 = 0x07c1
-	Key_Greek_BETA                  uint32 = 0x07c2
-	Key_Greek_GAMMA                 uint32 = 0x07c3
-	Key_Greek_DELTA                 uint32 = 0x07c4
-	Key_Greek_EPSILON               uint32 = 0x07c5
-	Key_Greek_ZETA                  uint32 = 0x07c6
-	Key_Greek_ETA                   uint32 = 0x07c7
-	Key_Greek_THETA                 uint32 = 0x07c8
-	Key_Greek_IOTA                  uint32 = 0x07c9
-	Key_Greek_KAPPA                 uint32 = 0x07ca
-	Key_Greek_LAMDA                 uint32 = 0x07cb
-	Key_Greek_LAMBDA                uint32 = 0x07cb
-	Key_Greek_MU                    uint32 = 0x07cc
-	Key_Greek_NU                    uint32 = 0x07cd
-	Key_Greek_XI                    uint32 = 0x07ce
-	Key_Greek_OMICRON               uint32 = 0x07cf
-	Key_Greek_PI                    uint32 = 0x07d0
-	Key_Greek_RHO                   uint32 = 0x07d1
-	Key_Greek_SIGMA                 uint32 = 0x07d2
-	Key_Greek_TAU                   uint32 = 0x07d4
-	Key_Greek_UPSILON               uint32 = 0x07d5
-	Key_Greek_PHI                   uint32 = 0x07d6
-	Key_Greek_CHI                   uint32 = 0x07d7
-	Key_Greek_PSI                   uint32 = 0x07d8
-	Key_Greek_OMEGA                 uint32 = 0x07d9
-	Key_Greek_alpha                 uint32 = 0x07e1
-	Key_Greek_beta                  uint32 = 0x07e2
-	Key_Greek_gamma                 uint32 = 0x07e3
-	Key_Greek_delta                 uint32 = 0x07e4
-	Key_Greek_epsilon               uint32 = 0x07e5
-	Key_Greek_zeta                  uint32 = 0x07e6
-	Key_Greek_eta                   uint32 = 0x07e7
-	Key_Greek_theta                 uint32 = 0x07e8
-	Key_Greek_iota                  uint32 = 0x07e9
-	Key_Greek_kappa                 uint32 = 0x07ea
-	Key_Greek_lamda                 uint32 = 0x07eb
-	Key_Greek_lambda                uint32 = 0x07eb
-	Key_Greek_mu                    uint32 = 0x07ec
-	Key_Greek_nu                    uint32 = 0x07ed
-	Key_Greek_xi                    uint32 = 0x07ee
-	Key_Greek_omicron               uint32 = 0x07ef
-	Key_Greek_pi                    uint32 = 0x07f0
-	Key_Greek_rho                   uint32 = 0x07f1
-	Key_Greek_sigma                 uint32 = 0x07f2
-	Key_Greek_finalsmallsigma       uint32 = 0x07f3
-	Key_Greek_tau                   uint32 = 0x07f4
-	Key_Greek_upsilon               uint32 = 0x07f5
-	Key_Greek_phi                   uint32 = 0x07f6
-	Key_Greek_chi                   uint32 = 0x07f7
-	Key_Greek_psi                   uint32 = 0x07f8
-	Key_Greek_omega                 uint32 = 0x07f9
-	Key_Greek_switch                uint32 = 0xff7e
-	Key_leftradical                 uint32 = 0x08a1
-	Key_topleftradical              uint32 = 0x08a2
-	Key_horizconnector              uint32 = 0x08a3
-	Key_topintegral                 uint32 = 0x08a4
-	Key_botintegral                 uint32 = 0x08a5
-	Key_vertconnector               uint32 = 0x08a6
-	Key_topleftsqbracket            uint32 = 0x08a7
-	Key_botleftsqbracket            uint32 = 0x08a8
-	Key_toprightsqbracket           uint32 = 0x08a9
-	Key_botrightsqbracket           uint32 = 0x08aa
-	Key_topleftparens               uint32 = 0x08ab
-	Key_botleftparens               uint32 = 0x08ac
-	Key_toprightparens              uint32 = 0x08ad
-	Key_botrightparens              uint32 = 0x08ae
-	Key_leftmiddlecurlybrace        uint32 = 0x08af
-	Key_rightmiddlecurlybrace       uint32 = 0x08b0
-	Key_topleftsummation            uint32 = 0x08b1
-	Key_botleftsummation            uint32 = 0x08b2
-	Key_topvertsummationconnector   uint32 = 0x08b3
-	Key_botvertsummationconnector   uint32 = 0x08b4
-	Key_toprightsummation           uint32 = 0x08b5
-	Key_botrightsummation           uint32 = 0x08b6
-	Key_rightmiddlesummation        uint32 = 0x08b7
-	Key_lessthanequal               uint32 = 0x08bc
-	Key_notequal                    uint32 = 0x08bd
-	Key_greaterthanequal            uint32 = 0x08be
-	Key_integral                    uint32 = 0x08bf
-	Key_therefore                   uint32 = 0x08c0
-	Key_variation                   uint32 = 0x08c1
-	Key_infinity                    uint32 = 0x08c2
-	Key_nabla                       uint32 = 0x08c5
-	Key_approximate                 uint32 = 0x08c8
-	Key_similarequal                uint32 = 0x08c9
-	Key_ifonlyif                    uint32 = 0x08cd
-	Key_implies                     uint32 = 0x08ce
-	Key_identical                   uint32 = 0x08cf
-	Key_radical                     uint32 = 0x08d6
-	Key_includedin                  uint32 = 0x08da
-	Key_includes                    uint32 = 0x08db
-	Key_intersection                uint32 = 0x08dc
-	Key_union                       uint32 = 0x08dd
-	Key_logicaland                  uint32 = 0x08de
-	Key_logicalor                   uint32 = 0x08df
-	Key_partialderivative           uint32 = 0x08ef
-	Key_function                    uint32 = 0x08f6
-	Key_leftarrow                   uint32 = 0x08fb
-	Key_uparrow                     uint32 = 0x08fc
-	Key_rightarrow                  uint32 = 0x08fd
-	Key_downarrow                   uint32 = 0x08fe
-	Key_blank                       uint32 = 0x09df
-	Key_soliddiamond                uint32 = 0x09e0
-	Key_checkerboard                uint32 = 0x09e1
-	Key_ht                          uint32 = 0x09e2
-	Key_ff                          uint32 = 0x09e3
-	Key_cr                          uint32 = 0x09e4
-	Key_lf                          uint32 = 0x09e5
-	Key_nl                          uint32 = 0x09e8
-	Key_vt                          uint32 = 0x09e9
-	Key_lowrightcorner              uint32 = 0x09ea
-	Key_uprightcorner               uint32 = 0x09eb
-	Key_upleftcorner                uint32 = 0x09ec
-	Key_lowleftcorner               uint32 = 0x09ed
-	Key_crossinglines               uint32 = 0x09ee
-	Key_horizlinescan1              uint32 = 0x09ef
-	Key_horizlinescan3              uint32 = 0x09f0
-	Key_horizlinescan5              uint32 = 0x09f1
-	Key_horizlinescan7              uint32 = 0x09f2
-	Key_horizlinescan9              uint32 = 0x09f3
-	Key_leftt                       uint32 = 0x09f4
-	Key_rightt                      uint32 = 0x09f5
-	Key_bott                        uint32 = 0x09f6
-	Key_topt                        uint32 = 0x09f7
-	Key_vertbar                     uint32 = 0x09f8
-	Key_emspace                     uint32 = 0x0aa1
-	Key_enspace                     uint32 = 0x0aa2
-	Key_em3space                    uint32 = 0x0aa3
-	Key_em4space                    uint32 = 0x0aa4
-	Key_digitspace                  uint32 = 0x0aa5
-	Key_punctspace                  uint32 = 0x0aa6
-	Key_thinspace                   uint32 = 0x0aa7
-	Key_hairspace                   uint32 = 0x0aa8
-	Key_emdash                      uint32 = 0x0aa9
-	Key_endash                      uint32 = 0x0aaa
-	Key_signifblank                 uint32 = 0x0aac
-	Key_ellipsis                    uint32 = 0x0aae
-	Key_doubbaselinedot             uint32 = 0x0aaf
-	Key_onethird                    uint32 = 0x0ab0
-	Key_twothirds                   uint32 = 0x0ab1
-	Key_onefifth                    uint32 = 0x0ab2
-	Key_twofifths                   uint32 = 0x0ab3
-	Key_threefifths                 uint32 = 0x0ab4
-	Key_fourfifths                  uint32 = 0x0ab5
-	Key_onesixth                    uint32 = 0x0ab6
-	Key_fivesixths                  uint32 = 0x0ab7
-	Key_careof                      uint32 = 0x0ab8
-	Key_figdash                     uint32 = 0x0abb
-	Key_leftanglebracket            uint32 = 0x0abc
-	Key_decimalpoint                uint32 = 0x0abd
-	Key_rightanglebracket           uint32 = 0x0abe
-	Key_marker                      uint32 = 0x0abf
-	Key_oneeighth                   uint32 = 0x0ac3
-	Key_threeeighths                uint32 = 0x0ac4
-	Key_fiveeighths                 uint32 = 0x0ac5
-	Key_seveneighths                uint32 = 0x0ac6
-	Key_trademark                   uint32 = 0x0ac9
-	Key_signaturemark               uint32 = 0x0aca
-	Key_trademarkincircle           uint32 = 0x0acb
-	Key_leftopentriangle            uint32 = 0x0acc
-	Key_rightopentriangle           uint32 = 0x0acd
-	Key_emopencircle                uint32 = 0x0ace
-	Key_emopenrectangle             uint32 = 0x0acf
-	Key_leftsinglequotemark         uint32 = 0x0ad0
-	Key_rightsinglequotemark        uint32 = 0x0ad1
-	Key_leftdoublequotemark         uint32 = 0x0ad2
-	Key_rightdoublequotemark        uint32 = 0x0ad3
-	Key_prescription                uint32 = 0x0ad4
-	Key_permille                    uint32 = 0x0ad5
-	Key_minutes                     uint32 = 0x0ad6
-	Key_seconds                     uint32 = 0x0ad7
-	Key_latincross                  uint32 = 0x0ad9
-	Key_hexagram                    uint32 = 0x0ada
-	Key_filledrectbullet            uint32 = 0x0adb
-	Key_filledlefttribullet         uint32 = 0x0adc
-	Key_filledrighttribullet        uint32 = 0x0add
-	Key_emfilledcircle              uint32 = 0x0ade
-	Key_emfilledrect                uint32 = 0x0adf
-	Key_enopencircbullet            uint32 = 0x0ae0
-	Key_enopensquarebullet          uint32 = 0x0ae1
-	Key_openrectbullet              uint32 = 0x0ae2
-	Key_opentribulletup             uint32 = 0x0ae3
-	Key_opentribulletdown           uint32 = 0x0ae4
-	Key_openstar                    uint32 = 0x0ae5
-	Key_enfilledcircbullet          uint32 = 0x0ae6
-	Key_enfilledsqbullet            uint32 = 0x0ae7
-	Key_filledtribulletup           uint32 = 0x0ae8
-	Key_filledtribulletdown         uint32 = 0x0ae9
-	Key_leftpointer                 uint32 = 0x0aea
-	Key_rightpointer                uint32 = 0x0aeb
-	Key_club                        uint32 = 0x0aec
-	Key_diamond                     uint32 = 0x0aed
-	Key_heart                       uint32 = 0x0aee
-	Key_maltesecross                uint32 = 0x0af0
-	Key_dagger                      uint32 = 0x0af1
-	Key_doubledagger                uint32 = 0x0af2
-	Key_checkmark                   uint32 = 0x0af3
-	Key_ballotcross                 uint32 = 0x0af4
-	Key_musicalsharp                uint32 = 0x0af5
-	Key_musicalflat                 uint32 = 0x0af6
-	Key_malesymbol                  uint32 = 0x0af7
-	Key_femalesymbol                uint32 = 0x0af8
-	Key_telephone                   uint32 = 0x0af9
-	Key_telephonerecorder           uint32 = 0x0afa
-	Key_phonographcopyright         uint32 = 0x0afb
-	Key_caret                       uint32 = 0x0afc
-	Key_singlelowquotemark          uint32 = 0x0afd
-	Key_doublelowquotemark          uint32 = 0x0afe
-	Key_cursor                      uint32 = 0x0aff
-	Key_leftcaret                   uint32 = 0x0ba3
-	Key_rightcaret                  uint32 = 0x0ba6
-	Key_downcaret                   uint32 = 0x0ba8
-	Key_upcaret                     uint32 = 0x0ba9
-	Key_overbar                     uint32 = 0x0bc0
-	Key_downtack                    uint32 = 0x0bc2
-	Key_upshoe                      uint32 = 0x0bc3
-	Key_downstile                   uint32 = 0x0bc4
-	Key_underbar                    uint32 = 0x0bc6
-	Key_jot                         uint32 = 0x0bca
-	Key_quad                        uint32 = 0x0bcc
-	Key_uptack                      uint32 = 0x0bce
-	Key_circle                      uint32 = 0x0bcf
-	Key_upstile                     uint32 = 0x0bd3
-	Key_downshoe                    uint32 = 0x0bd6
-	Key_rightshoe                   uint32 = 0x0bd8
-	Key_leftshoe                    uint32 = 0x0bda
-	Key_lefttack                    uint32 = 0x0bdc
-	Key_righttack                   uint32 = 0x0bfc
-	Key_hebrew_doublelowline        uint32 = 0x0cdf
-	Key_hebrew_aleph                uint32 = 0x0ce0
-	Key_hebrew_bet                  uint32 = 0x0ce1
-	Key_hebrew_beth                 uint32 = 0x0ce1
-	Key_hebrew_gimel                uint32 = 0x0ce2
-	Key_hebrew_gimmel               uint32 = 0x0ce2
-	Key_hebrew_dalet                uint32 = 0x0ce3
-	Key_hebrew_daleth               uint32 = 0x0ce3
-	Key_hebrew_he                   uint32 = 0x0ce4
-	Key_hebrew_waw                  uint32 = 0x0ce5
-	Key_hebrew_zain                 uint32 = 0x0ce6
-	Key_hebrew_zayin                uint32 = 0x0ce6
-	Key_hebrew_chet                 uint32 = 0x0ce7
-	Key_hebrew_het                  uint32 = 0x0ce7
-	Key_hebrew_tet                  uint32 = 0x0ce8
-	Key_hebrew_teth                 uint32 = 0x0ce8
-	Key_hebrew_yod                  uint32 = 0x0ce9
-	Key_hebrew_finalkaph            uint32 = 0x0cea
-	Key_hebrew_kaph                 uint32 = 0x0ceb
-	Key_hebrew_lamed                uint32 = 0x0cec
-	Key_hebrew_finalmem             uint32 = 0x0ced
-	Key_hebrew_mem                  uint32 = 0x0cee
-	Key_hebrew_finalnun             uint32 = 0x0cef
-	Key_hebrew_nun                  uint32 = 0x0cf0
-	Key_hebrew_samech               uint32 = 0x0cf1
-	Key_hebrew_samekh               uint32 = 0x0cf1
-	Key_hebrew_ayin                 uint32 = 0x0cf2
-	Key_hebrew_finalpe              uint32 = 0x0cf3
-	Key_hebrew_pe                   uint32 = 0x0cf4
-	Key_hebrew_finalzade            uint32 = 0x0cf5
-	Key_hebrew_finalzadi            uint32 = 0x0cf5
-	Key_hebrew_zade                 uint32 = 0x0cf6
-	Key_hebrew_zadi                 uint32 = 0x0cf6
-	Key_hebrew_qoph                 uint32 = 0x0cf7
-	Key_hebrew_kuf                  uint32 = 0x0cf7
-	Key_hebrew_resh                 uint32 = 0x0cf8
-	Key_hebrew_shin                 uint32 = 0x0cf9
-	Key_hebrew_taw                  uint32 = 0x0cfa
-	Key_hebrew_taf                  uint32 = 0x0cfa
-	Key_Hebrew_switch               uint32 = 0xff7e
-	Key_Thai_kokai                  uint32 = 0x0da1
-	Key_Thai_khokhai                uint32 = 0x0da2
-	Key_Thai_khokhuat               uint32 = 0x0da3
-	Key_Thai_khokhwai               uint32 = 0x0da4
-	Key_Thai_khokhon                uint32 = 0x0da5
-	Key_Thai_khorakhang             uint32 = 0x0da6
-	Key_Thai_ngongu                 uint32 = 0x0da7
-	Key_Thai_chochan                uint32 = 0x0da8
-	Key_Thai_choching               uint32 = 0x0da9
-	Key_Thai_chochang               uint32 = 0x0daa
-	Key_Thai_soso                   uint32 = 0x0dab
-	Key_Thai_chochoe                uint32 = 0x0dac
-	Key_Thai_yoying                 uint32 = 0x0dad
-	Key_Thai_dochada                uint32 = 0x0dae
-	Key_Thai_topatak                uint32 = 0x0daf
-	Key_Thai_thothan                uint32 = 0x0db0
-	Key_Thai_thonangmontho          uint32 = 0x0db1
-	Key_Thai_thophuthao             uint32 = 0x0db2
-	Key_Thai_nonen                  uint32 = 0x0db3
-	Key_Thai_dodek                  uint32 = 0x0db4
-	Key_Thai_totao                  uint32 = 0x0db5
-	Key_Thai_thothung               uint32 = 0x0db6
-	Key_Thai_thothahan              uint32 = 0x0db7
-	Key_Thai_thothong               uint32 = 0x0db8
-	Key_Thai_nonu                   uint32 = 0x0db9
-	Key_Thai_bobaimai               uint32 = 0x0dba
-	Key_Thai_popla                  uint32 = 0x0dbb
-	Key_Thai_phophung               uint32 = 0x0dbc
-	Key_Thai_fofa                   uint32 = 0x0dbd
-	Key_Thai_phophan                uint32 = 0x0dbe
-	Key_Thai_fofan                  uint32 = 0x0dbf
-	Key_Thai_phosamphao             uint32 = 0x0dc0
-	Key_Thai_moma                   uint32 = 0x0dc1
-	Key_Thai_yoyak                  uint32 = 0x0dc2
-	Key_Thai_rorua                  uint32 = 0x0dc3
-	Key_Thai_ru                     uint32 = 0x0dc4
-	Key_Thai_loling                 uint32 = 0x0dc5
-	Key_Thai_lu                     uint32 = 0x0dc6
-	Key_Thai_wowaen                 uint32 = 0x0dc7
-	Key_Thai_sosala                 uint32 = 0x0dc8
-	Key_Thai_sorusi                 uint32 = 0x0dc9
-	Key_Thai_sosua                  uint32 = 0x0dca
-	Key_Thai_hohip                  uint32 = 0x0dcb
-	Key_Thai_lochula                uint32 = 0x0dcc
-	Key_Thai_oang                   uint32 = 0x0dcd
-	Key_Thai_honokhuk               uint32 = 0x0dce
-	Key_Thai_paiyannoi              uint32 = 0x0dcf
-	Key_Thai_saraa                  uint32 = 0x0dd0
-	Key_Thai_maihanakat             uint32 = 0x0dd1
-	Key_Thai_saraaa                 uint32 = 0x0dd2
-	Key_Thai_saraam                 uint32 = 0x0dd3
-	Key_Thai_sarai                  uint32 = 0x0dd4
-	Key_Thai_saraii                 uint32 = 0x0dd5
-	Key_Thai_saraue                 uint32 = 0x0dd6
-	Key_Thai_sarauee                uint32 = 0x0dd7
-	Key_Thai_sarau                  uint32 = 0x0dd8
-	Key_Thai_sarauu                 uint32 = 0x0dd9
-	Key_Thai_phinthu                uint32 = 0x0dda
-	Key_Thai_maihanakat_maitho      uint32 = 0x0dde
-	Key_Thai_baht                   uint32 = 0x0ddf
-	Key_Thai_sarae                  uint32 = 0x0de0
-	Key_Thai_saraae                 uint32 = 0x0de1
-	Key_Thai_sarao                  uint32 = 0x0de2
-	Key_Thai_saraaimaimuan          uint32 = 0x0de3
-	Key_Thai_saraaimaimalai         uint32 = 0x0de4
-	Key_Thai_lakkhangyao            uint32 = 0x0de5
-	Key_Thai_maiyamok               uint32 = 0x0de6
-	Key_Thai_maitaikhu              uint32 = 0x0de7
-	Key_Thai_maiek                  uint32 = 0x0de8
-	Key_Thai_maitho                 uint32 = 0x0de9
-	Key_Thai_maitri                 uint32 = 0x0dea
-	Key_Thai_maichattawa            uint32 = 0x0deb
-	Key_Thai_thanthakhat            uint32 = 0x0dec
-	Key_Thai_nikhahit               uint32 = 0x0ded
-	Key_Thai_leksun                 uint32 = 0x0df0
-	Key_Thai_leknung                uint32 = 0x0df1
-	Key_Thai_leksong                uint32 = 0x0df2
-	Key_Thai_leksam                 uint32 = 0x0df3
-	Key_Thai_leksi                  uint32 = 0x0df4
-	Key_Thai_lekha                  uint32 = 0x0df5
-	Key_Thai_lekhok                 uint32 = 0x0df6
-	Key_Thai_lekchet                uint32 = 0x0df7
-	Key_Thai_lekpaet                uint32 = 0x0df8
-	Key_Thai_lekkao                 uint32 = 0x0df9
-	Key_Hangul                      uint32 = 0xff31
-	Key_Hangul_Start                uint32 = 0xff32
-	Key_Hangul_End                  uint32 = 0xff33
-	Key_Hangul_Hanja                uint32 = 0xff34
-	Key_Hangul_Jamo                 uint32 = 0xff35
-	Key_Hangul_Romaja               uint32 = 0xff36
-	Key_Hangul_Codeinput            uint32 = 0xff37
-	Key_Hangul_Jeonja               uint32 = 0xff38
-	Key_Hangul_Banja                uint32 = 0xff39
-	Key_Hangul_PreHanja             uint32 = 0xff3a
-	Key_Hangul_PostHanja            uint32 = 0xff3b
-	Key_Hangul_SingleCandidate      uint32 = 0xff3c
-	Key_Hangul_MultipleCandidate    uint32 = 0xff3d
-	Key_Hangul_PreviousCandidate    uint32 = 0xff3e
-	Key_Hangul_Special              uint32 = 0xff3f
-	Key_Hangul_switch               uint32 = 0xff7e
-	Key_Hangul_Kiyeog               uint32 = 0x0ea1
-	Key_Hangul_SsangKiyeog          uint32 = 0x0ea2
-	Key_Hangul_KiyeogSios           uint32 = 0x0ea3
-	Key_Hangul_Nieun                uint32 = 0x0ea4
-	Key_Hangul_NieunJieuj           uint32 = 0x0ea5
-	Key_Hangul_NieunHieuh           uint32 = 0x0ea6
-	Key_Hangul_Dikeud               uint32 = 0x0ea7
-	Key_Hangul_SsangDikeud          uint32 = 0x0ea8
-	Key_Hangul_Rieul                uint32 = 0x0ea9
-	Key_Hangul_RieulKiyeog          uint32 = 0x0eaa
-	Key_Hangul_RieulMieum           uint32 = 0x0eab
-	Key_Hangul_RieulPieub           uint32 = 0x0eac
-	Key_Hangul_RieulSios            uint32 = 0x0ead
-	Key_Hangul_RieulTieut           uint32 = 0x0eae
-	Key_Hangul_RieulPhieuf          uint32 = 0x0eaf
-	Key_Hangul_RieulHieuh           uint32 = 0x0eb0
-	Key_Hangul_Mieum                uint32 = 0x0eb1
-	Key_Hangul_Pieub                uint32 = 0x0eb2
-	Key_Hangul_SsangPieub           uint32 = 0x0eb3
-	Key_Hangul_PieubSios            uint32 = 0x0eb4
-	Key_Hangul_Sios                 uint32 = 0x0eb5
-	Key_Hangul_SsangSios            uint32 = 0x0eb6
-	Key_Hangul_Ieung                uint32 = 0x0eb7
-	Key_Hangul_Jieuj                uint32 = 0x0eb8
-	Key_Hangul_SsangJieuj           uint32 = 0x0eb9
-	Key_Hangul_Cieuc                uint32 = 0x0eba
-	Key_Hangul_Khieuq               uint32 = 0x0ebb
-	Key_Hangul_Tieut                uint32 = 0x0ebc
-	Key_Hangul_Phieuf               uint32 = 0x0ebd
-	Key_Hangul_Hieuh                uint32 = 0x0ebe
-	Key_Hangul_A                    uint32 = 0x0ebf
-	Key_Hangul_AE                   uint32 = 0x0ec0
-	Key_Hangul_YA                   uint32 = 0x0ec1
-	Key_Hangul_YAE                  uint32 = 0x0ec2
-	Key_Hangul_EO                   uint32 = 0x0ec3
-	Key_Hangul_E                    uint32 = 0x0ec4
-	Key_Hangul_YEO                  uint32 = 0x0ec5
-	Key_Hangul_YE                   uint32 = 0x0ec6
-	Key_Hangul_O                    uint32 = 0x0ec7
-	Key_Hangul_WA                   uint32 = 0x0ec8
-	Key_Hangul_WAE                  uint32 = 0x0ec9
-	Key_Hangul_OE                   uint32 = 0x0eca
-	Key_Hangul_YO                   uint32 = 0x0ecb
-	Key_Hangul_U                    uint32 = 0x0ecc
-	Key_Hangul_WEO                  uint32 = 0x0ecd
-	Key_Hangul_WE                   uint32 = 0x0ece
-	Key_Hangul_WI                   uint32 = 0x0ecf
-	Key_Hangul_YU                   uint32 = 0x0ed0
-	Key_Hangul_EU                   uint32 = 0x0ed1
-	Key_Hangul_YI                   uint32 = 0x0ed2
-	Key_Hangul_I                    uint32 = 0x0ed3
-	Key_Hangul_J_Kiyeog             uint32 = 0x0ed4
-	Key_Hangul_J_SsangKiyeog        uint32 = 0x0ed5
-	Key_Hangul_J_KiyeogSios         uint32 = 0x0ed6
-	Key_Hangul_J_Nieun              uint32 = 0x0ed7
-	Key_Hangul_J_NieunJieuj         uint32 = 0x0ed8
-	Key_Hangul_J_NieunHieuh         uint32 = 0x0ed9
-	Key_Hangul_J_Dikeud             uint32 = 0x0eda
-	Key_Hangul_J_Rieul              uint32 = 0x0edb
-	Key_Hangul_J_RieulKiyeog        uint32 = 0x0edc
-	Key_Hangul_J_RieulMieum         uint32 = 0x0edd
-	Key_Hangul_J_RieulPieub         uint32 = 0x0ede
-	Key_Hangul_J_RieulSios          uint32 = 0x0edf
-	Key_Hangul_J_RieulTieut         uint32 = 0x0ee0
-	Key_Hangul_J_RieulPhieuf        uint32 = 0x0ee1
-	Key_Hangul_J_RieulHieuh         uint32 = 0x0ee2
-	Key_Hangul_J_Mieum              uint32 = 0x0ee3
-	Key_Hangul_J_Pieub              uint32 = 0x0ee4
-	Key_Hangul_J_PieubSios          uint32 = 0x0ee5
-	Key_Hangul_J_Sios               uint32 = 0x0ee6
-	Key_Hangul_J_SsangSios          uint32 = 0x0ee7
-	Key_Hangul_J_Ieung              uint32 = 0x0ee8
-	Key_Hangul_J_Jieuj              uint32 = 0x0ee9
-	Key_Hangul_J_Cieuc              uint32 = 0x0eea
-	Key_Hangul_J_Khieuq             uint32 = 0x0eeb
-	Key_Hangul_J_Tieut              uint32 = 0x0eec
-	Key_Hangul_J_Phieuf             uint32 = 0x0eed
-	Key_Hangul_J_Hieuh              uint32 = 0x0eee
-	Key_Hangul_RieulYeorinHieuh     uint32 = 0x0eef
-	Key_Hangul_SunkyeongeumMieum    uint32 = 0x0ef0
-	Key_Hangul_SunkyeongeumPieub    uint32 = 0x0ef1
-	Key_Hangul_PanSios              uint32 = 0x0ef2
-	Key_Hangul_KkogjiDalrinIeung    uint32 = 0x0ef3
-	Key_Hangul_SunkyeongeumPhieuf   uint32 = 0x0ef4
-	Key_Hangul_YeorinHieuh          uint32 = 0x0ef5
-	Key_Hangul_AraeA                uint32 = 0x0ef6
-	Key_Hangul_AraeAE               uint32 = 0x0ef7
-	Key_Hangul_J_PanSios            uint32 = 0x0ef8
-	Key_Hangul_J_KkogjiDalrinIeung  uint32 = 0x0ef9
-	Key_Hangul_J_YeorinHieuh        uint32 = 0x0efa
-	Key_Korean_Won                  uint32 = 0x0eff
-	Key_Armenian_ligature_ew        uint32 = 0x1000587
-	Key_Armenian_full_stop          uint32 = 0x1000589
-	Key_Armenian_verjaket           uint32 = 0x1000589
-	Key_Armenian_separation_mark    uint32 = 0x100055d
-	Key_Armenian_but                uint32 = 0x100055d
-	Key_Armenian_hyphen             uint32 = 0x100058a
-	Key_Armenian_yentamna           uint32 = 0x100058a
-	Key_Armenian_exclam             uint32 = 0x100055c
-	Key_Armenian_amanak             uint32 = 0x100055c
-	Key_Armenian_accent             uint32 = 0x100055b
-	Key_Armenian_shesht             uint32 = 0x100055b
-	Key_Armenian_question           uint32 = 0x100055e
-	Key_Armenian_paruyk             uint32 = 0x100055e
-	Key_Armenian_AYB                uint32 = 0x1000531
-	Key_Armenian_ayb                uint32 = 0x1000561
-	Key_Armenian_BEN                uint32 = 0x1000532
-	Key_Armenian_ben                uint32 = 0x1000562
-	Key_Armenian_GIM                uint32 = 0x1000533
-	Key_Armenian_gim                uint32 = 0x1000563
-	Key_Armenian_DA                 uint32 = 0x1000534
-	Key_Armenian_da                 uint32 = 0x1000564
-	Key_Armenian_YECH               uint32 = 0x1000535
-	Key_Armenian_yech               uint32 = 0x1000565
-	Key_Armenian_ZA                 uint32 = 0x1000536
-	Key_Armenian_za                 uint32 = 0x1000566
-	Key_Armenian_E                  uint32 = 0x1000537
-	Key_Armenian_e                  uint32 = 0x1000567
-	Key_Armenian_AT                 uint32 = 0x1000538
-	Key_Armenian_at                 uint32 = 0x1000568
-	Key_Armenian_TO                 uint32 = 0x1000539
-	Key_Armenian_to                 uint32 = 0x1000569
-	Key_Armenian_ZHE                uint32 = 0x100053a
-	Key_Armenian_zhe                uint32 = 0x100056a
-	Key_Armenian_INI                uint32 = 0x100053b
-	Key_Armenian_ini                uint32 = 0x100056b
-	Key_Armenian_LYUN               uint32 = 0x100053c
-	Key_Armenian_lyun               uint32 = 0x100056c
-	Key_Armenian_KHE                uint32 = 0x100053d
-	Key_Armenian_khe                uint32 = 0x100056d
-	Key_Armenian_TSA                uint32 = 0x100053e
-	Key_Armenian_tsa                uint32 = 0x100056e
-	Key_Armenian_KEN                uint32 = 0x100053f
-	Key_Armenian_ken                uint32 = 0x100056f
-	Key_Armenian_HO                 uint32 = 0x1000540
-	Key_Armenian_ho                 uint32 = 0x1000570
-	Key_Armenian_DZA                uint32 = 0x1000541
-	Key_Armenian_dza                uint32 = 0x1000571
-	Key_Armenian_GHAT               uint32 = 0x1000542
-	Key_Armenian_ghat               uint32 = 0x1000572
-	Key_Armenian_TCHE               uint32 = 0x1000543
-	Key_Armenian_tche               uint32 = 0x1000573
-	Key_Armenian_MEN                uint32 = 0x1000544
-	Key_Armenian_men                uint32 = 0x1000574
-	Key_Armenian_HI                 uint32 = 0x1000545
-	Key_Armenian_hi                 uint32 = 0x1000575
-	Key_Armenian_NU                 uint32 = 0x1000546
-	Key_Armenian_nu                 uint32 = 0x1000576
-	Key_Armenian_SHA                uint32 = 0x1000547
-	Key_Armenian_sha                uint32 = 0x1000577
-	Key_Armenian_VO                 uint32 = 0x1000548
-	Key_Armenian_vo                 uint32 = 0x1000578
-	Key_Armenian_CHA                uint32 = 0x1000549
-	Key_Armenian_cha                uint32 = 0x1000579
-	Key_Armenian_PE                 uint32 = 0x100054a
-	Key_Armenian_pe                 uint32 = 0x100057a
-	Key_Armenian_JE                 uint32 = 0x100054b
-	Key_Armenian_je                 uint32 = 0x100057b
-	Key_Armenian_RA                 uint32 = 0x100054c
-	Key_Armenian_ra                 uint32 = 0x100057c
-	Key_Armenian_SE                 uint32 = 0x100054d
-	Key_Armenian_se                 uint32 = 0x100057d
-	Key_Armenian_VEV                uint32 = 0x100054e
-	Key_Armenian_vev                uint32 = 0x100057e
-	Key_Armenian_TYUN               uint32 = 0x100054f
-	Key_Armenian_tyun               uint32 = 0x100057f
-	Key_Armenian_RE                 uint32 = 0x1000550
-	Key_Armenian_re                 uint32 = 0x1000580
-	Key_Armenian_TSO                uint32 = 0x1000551
-	Key_Armenian_tso                uint32 = 0x1000581
-	Key_Armenian_VYUN               uint32 = 0x1000552
-	Key_Armenian_vyun               uint32 = 0x1000582
-	Key_Armenian_PYUR               uint32 = 0x1000553
-	Key_Armenian_pyur               uint32 = 0x1000583
-	Key_Armenian_KE                 uint32 = 0x1000554
-	Key_Armenian_ke                 uint32 = 0x1000584
-	Key_Armenian_O                  uint32 = 0x1000555
-	Key_Armenian_o                  uint32 = 0x1000585
-	Key_Armenian_FE                 uint32 = 0x1000556
-	Key_Armenian_fe                 uint32 = 0x1000586
-	Key_Armenian_apostrophe         uint32 = 0x100055a
-	Key_Georgian_an                 uint32 = 0x10010d0
-	Key_Georgian_ban                uint32 = 0x10010d1
-	Key_Georgian_gan                uint32 = 0x10010d2
-	Key_Georgian_don                uint32 = 0x10010d3
-	Key_Georgian_en                 uint32 = 0x10010d4
-	Key_Georgian_vin                uint32 = 0x10010d5
-	Key_Georgian_zen                uint32 = 0x10010d6
-	Key_Georgian_tan                uint32 = 0x10010d7
-	Key_Georgian_in                 uint32 = 0x10010d8
-	Key_Georgian_kan                uint32 = 0x10010d9
-	Key_Georgian_las                uint32 = 0x10010da
-	Key_Georgian_man                uint32 = 0x10010db
-	Key_Georgian_nar                uint32 = 0x10010dc
-	Key_Georgian_on                 uint32 = 0x10010dd
-	Key_Georgian_par                uint32 = 0x10010de
-	Key_Georgian_zhar               uint32 = 0x10010df
-	Key_Georgian_rae                uint32 = 0x10010e0
-	Key_Georgian_san                uint32 = 0x10010e1
-	Key_Georgian_tar                uint32 = 0x10010e2
-	Key_Georgian_un                 uint32 = 0x10010e3
-	Key_Georgian_phar               uint32 = 0x10010e4
-	Key_Georgian_khar               uint32 = 0x10010e5
-	Key_Georgian_ghan               uint32 = 0x10010e6
-	Key_Georgian_qar                uint32 = 0x10010e7
-	Key_Georgian_shin               uint32 = 0x10010e8
-	Key_Georgian_chin               uint32 = 0x10010e9
-	Key_Georgian_can                uint32 = 0x10010ea
-	Key_Georgian_jil                uint32 = 0x10010eb
-	Key_Georgian_cil                uint32 = 0x10010ec
-	Key_Georgian_char               uint32 = 0x10010ed
-	Key_Georgian_xan                uint32 = 0x10010ee
-	Key_Georgian_jhan               uint32 = 0x10010ef
-	Key_Georgian_hae                uint32 = 0x10010f0
-	Key_Georgian_he                 uint32 = 0x10010f1
-	Key_Georgian_hie                uint32 = 0x10010f2
-	Key_Georgian_we                 uint32 = 0x10010f3
-	Key_Georgian_har                uint32 = 0x10010f4
-	Key_Georgian_hoe                uint32 = 0x10010f5
-	Key_Georgian_fi                 uint32 = 0x10010f6
-	Key_Xabovedot                   uint32 = 0x1001e8a
-	Key_Ibreve                      uint32 = 0x100012c
-	Key_Zstroke                     uint32 = 0x10001b5
-	Key_Gcaron                      uint32 = 0x10001e6
-	Key_Ocaron                      uint32 = 0x10001d1
-	Key_Obarred                     uint32 = 0x100019f
-	Key_xabovedot                   uint32 = 0x1001e8b
-	Key_ibreve                      uint32 = 0x100012d
-	Key_zstroke                     uint32 = 0x10001b6
-	Key_gcaron                      uint32 = 0x10001e7
-	Key_ocaron                      uint32 = 0x10001d2
-	Key_obarred                     uint32 = 0x1000275
-	Key_SCHWA                       uint32 = 0x100018f
-	Key_schwa                       uint32 = 0x1000259
-	Key_EZH                         uint32 = 0x10001b7
-	Key_ezh                         uint32 = 0x1000292
-	Key_Lbelowdot                   uint32 = 0x1001e36
-	Key_lbelowdot                   uint32 = 0x1001e37
-	Key_Abelowdot                   uint32 = 0x1001ea0
-	Key_abelowdot                   uint32 = 0x1001ea1
-	Key_Ahook                       uint32 = 0x1001ea2
-	Key_ahook                       uint32 = 0x1001ea3
-	Key_Acircumflexacute            uint32 = 0x1001ea4
-	Key_acircumflexacute            uint32 = 0x1001ea5
-	Key_Acircumflexgrave            uint32 = 0x1001ea6
-	Key_acircumflexgrave            uint32 = 0x1001ea7
-	Key_Acircumflexhook             uint32 = 0x1001ea8
-	Key_acircumflexhook             uint32 = 0x1001ea9
-	Key_Acircumflextilde            uint32 = 0x1001eaa
-	Key_acircumflextilde            uint32 = 0x1001eab
-	Key_Acircumflexbelowdot         uint32 = 0x1001eac
-	Key_acircumflexbelowdot         uint32 = 0x1001ead
-	Key_Abreveacute                 uint32 = 0x1001eae
-	Key_abreveacute                 uint32 = 0x1001eaf
-	Key_Abrevegrave                 uint32 = 0x1001eb0
-	Key_abrevegrave                 uint32 = 0x1001eb1
-	Key_Abrevehook                  uint32 = 0x1001eb2
-	Key_abrevehook                  uint32 = 0x1001eb3
-	Key_Abrevetilde                 uint32 = 0x1001eb4
-	Key_abrevetilde                 uint32 = 0x1001eb5
-	Key_Abrevebelowdot              uint32 = 0x1001eb6
-	Key_abrevebelowdot              uint32 = 0x1001eb7
-	Key_Ebelowdot                   uint32 = 0x1001eb8
-	Key_ebelowdot                   uint32 = 0x1001eb9
-	Key_Ehook                       uint32 = 0x1001eba
-	Key_ehook                       uint32 = 0x1001ebb
-	Key_Etilde                      uint32 = 0x1001ebc
-	Key_etilde                      uint32 = 0x1001ebd
-	Key_Ecircumflexacute            uint32 = 0x1001ebe
-	Key_ecircumflexacute            uint32 = 0x1001ebf
-	Key_Ecircumflexgrave            uint32 = 0x1001ec0
-	Key_ecircumflexgrave            uint32 = 0x1001ec1
-	Key_Ecircumflexhook             uint32 = 0x1001ec2
-	Key_ecircumflexhook             uint32 = 0x1001ec3
-	Key_Ecircumflextilde            uint32 = 0x1001ec4
-	Key_ecircumflextilde            uint32 = 0x1001ec5
-	Key_Ecircumflexbelowdot         uint32 = 0x1001ec6
-	Key_ecircumflexbelowdot         uint32 = 0x1001ec7
-	Key_Ihook                       uint32 = 0x1001ec8
-	Key_ihook                       uint32 = 0x1001ec9
-	Key_Ibelowdot                   uint32 = 0x1001eca
-	Key_ibelowdot                   uint32 = 0x1001ecb
-	Key_Obelowdot                   uint32 = 0x1001ecc
-	Key_obelowdot                   uint32 = 0x1001ecd
-	Key_Ohook                       uint32 = 0x1001ece
-	Key_ohook                       uint32 = 0x1001ecf
-	Key_Ocircumflexacute            uint32 = 0x1001ed0
-	Key_ocircumflexacute            uint32 = 0x1001ed1
-	Key_Ocircumflexgrave            uint32 = 0x1001ed2
-	Key_ocircumflexgrave            uint32 = 0x1001ed3
-	Key_Ocircumflexhook             uint32 = 0x1001ed4
-	Key_ocircumflexhook             uint32 = 0x1001ed5
-	Key_Ocircumflextilde            uint32 = 0x1001ed6
-	Key_ocircumflextilde            uint32 = 0x1001ed7
-	Key_Ocircumflexbelowdot         uint32 = 0x1001ed8
-	Key_ocircumflexbelowdot         uint32 = 0x1001ed9
-	Key_Ohornacute                  uint32 = 0x1001eda
-	Key_ohornacute                  uint32 = 0x1001edb
-	Key_Ohorngrave                  uint32 = 0x1001edc
-	Key_ohorngrave                  uint32 = 0x1001edd
-	Key_Ohornhook                   uint32 = 0x1001ede
-	Key_ohornhook                   uint32 = 0x1001edf
-	Key_Ohorntilde                  uint32 = 0x1001ee0
-	Key_ohorntilde                  uint32 = 0x1001ee1
-	Key_Ohornbelowdot               uint32 = 0x1001ee2
-	Key_ohornbelowdot               uint32 = 0x1001ee3
-	Key_Ubelowdot                   uint32 = 0x1001ee4
-	Key_ubelowdot                   uint32 = 0x1001ee5
-	Key_Uhook                       uint32 = 0x1001ee6
-	Key_uhook                       uint32 = 0x1001ee7
-	Key_Uhornacute                  uint32 = 0x1001ee8
-	Key_uhornacute                  uint32 = 0x1001ee9
-	Key_Uhorngrave                  uint32 = 0x1001eea
-	Key_uhorngrave                  uint32 = 0x1001eeb
-	Key_Uhornhook                   uint32 = 0x1001eec
-	Key_uhornhook                   uint32 = 0x1001eed
-	Key_Uhorntilde                  uint32 = 0x1001eee
-	Key_uhorntilde                  uint32 = 0x1001eef
-	Key_Uhornbelowdot               uint32 = 0x1001ef0
-	Key_uhornbelowdot               uint32 = 0x1001ef1
-	Key_Ybelowdot                   uint32 = 0x1001ef4
-	Key_ybelowdot                   uint32 = 0x1001ef5
-	Key_Yhook                       uint32 = 0x1001ef6
-	Key_yhook                       uint32 = 0x1001ef7
-	Key_Ytilde                      uint32 = 0x1001ef8
-	Key_ytilde                      uint32 = 0x1001ef9
-	Key_Ohorn                       uint32 = 0x10001a0
-	Key_ohorn                       uint32 = 0x10001a1
-	Key_Uhorn                       uint32 = 0x10001af
-	Key_uhorn                       uint32 = 0x10001b0
-	Key_EcuSign                     uint32 = 0x10020a0
-	Key_ColonSign                   uint32 = 0x10020a1
-	Key_CruzeiroSign                uint32 = 0x10020a2
-	Key_FFrancSign                  uint32 = 0x10020a3
-	Key_LiraSign                    uint32 = 0x10020a4
-	Key_MillSign                    uint32 = 0x10020a5
-	Key_NairaSign                   uint32 = 0x10020a6
-	Key_PesetaSign                  uint32 = 0x10020a7
-	Key_RupeeSign                   uint32 = 0x10020a8
-	Key_WonSign                     uint32 = 0x10020a9
-	Key_NewSheqelSign               uint32 = 0x10020aa
-	Key_DongSign                    uint32 = 0x10020ab
-	Key_EuroSign                    uint32 = 0x20ac
-	Key_zerosuperior                uint32 = 0x1002070
-	Key_foursuperior                uint32 = 0x1002074
-	Key_fivesuperior                uint32 = 0x1002075
-	Key_sixsuperior                 uint32 = 0x1002076
-	Key_sevensuperior               uint32 = 0x1002077
-	Key_eightsuperior               uint32 = 0x1002078
-	Key_ninesuperior                uint32 = 0x1002079
-	Key_zerosubscript               uint32 = 0x1002080
-	Key_onesubscript                uint32 = 0x1002081
-	Key_twosubscript                uint32 = 0x1002082
-	Key_threesubscript              uint32 = 0x1002083
-	Key_foursubscript               uint32 = 0x1002084
-	Key_fivesubscript               uint32 = 0x1002085
-	Key_sixsubscript                uint32 = 0x1002086
-	Key_sevensubscript              uint32 = 0x1002087
-	Key_eightsubscript              uint32 = 0x1002088
-	Key_ninesubscript               uint32 = 0x1002089
-	Key_partdifferential            uint32 = 0x1002202
-	Key_emptyset                    uint32 = 0x1002205
-	Key_elementof                   uint32 = 0x1002208
-	Key_notelementof                uint32 = 0x1002209
-	Key_containsas                  uint32 = 0x100220B
-	Key_squareroot                  uint32 = 0x100221A
-	Key_cuberoot                    uint32 = 0x100221B
-	Key_fourthroot                  uint32 = 0x100221C
-	Key_dintegral                   uint32 = 0x100222C
-	Key_tintegral                   uint32 = 0x100222D
-	Key_because                     uint32 = 0x1002235
-	Key_approxeq                    uint32 = 0x1002248
-	Key_notapproxeq                 uint32 = 0x1002247
-	Key_notidentical                uint32 = 0x1002262
-	Key_stricteq                    uint32 = 0x1002263
-	Key_braille_dot_1               uint32 = 0xfff1
-	Key_braille_dot_2               uint32 = 0xfff2
-	Key_braille_dot_3               uint32 = 0xfff3
-	Key_braille_dot_4               uint32 = 0xfff4
-	Key_braille_dot_5               uint32 = 0xfff5
-	Key_braille_dot_6               uint32 = 0xfff6
-	Key_braille_dot_7               uint32 = 0xfff7
-	Key_braille_dot_8               uint32 = 0xfff8
-	Key_braille_dot_9               uint32 = 0xfff9
-	Key_braille_dot_10              uint32 = 0xfffa
-	Key_braille_blank               uint32 = 0x1002800
-	Key_braille_dots_1              uint32 = 0x1002801
-	Key_braille_dots_2              uint32 = 0x1002802
-	Key_braille_dots_12             uint32 = 0x1002803
-	Key_braille_dots_3              uint32 = 0x1002804
-	Key_braille_dots_13             uint32 = 0x1002805
-	Key_braille_dots_23             uint32 = 0x1002806
-	Key_braille_dots_123            uint32 = 0x1002807
-	Key_braille_dots_4              uint32 = 0x1002808
-	Key_braille_dots_14             uint32 = 0x1002809
-	Key_braille_dots_24             uint32 = 0x100280a
-	Key_braille_dots_124            uint32 = 0x100280b
-	Key_braille_dots_34             uint32 = 0x100280c
-	Key_braille_dots_134            uint32 = 0x100280d
-	Key_braille_dots_234            uint32 = 0x100280e
-	Key_braille_dots_1234           uint32 = 0x100280f
-	Key_braille_dots_5              uint32 = 0x1002810
-	Key_braille_dots_15             uint32 = 0x1002811
-	Key_braille_dots_25             uint32 = 0x1002812
-	Key_braille_dots_125            uint32 = 0x1002813
-	Key_braille_dots_35             uint32 = 0x1002814
-	Key_braille_dots_135            uint32 = 0x1002815
-	Key_braille_dots_235            uint32 = 0x1002816
-	Key_braille_dots_1235           uint32 = 0x1002817
-	Key_braille_dots_45             uint32 = 0x1002818
-	Key_braille_dots_145            uint32 = 0x1002819
-	Key_braille_dots_245            uint32 = 0x100281a
-	Key_braille_dots_1245           uint32 = 0x100281b
-	Key_braille_dots_345            uint32 = 0x100281c
-	Key_braille_dots_1345           uint32 = 0x100281d
-	Key_braille_dots_2345           uint32 = 0x100281e
-	Key_braille_dots_12345          uint32 = 0x100281f
-	Key_braille_dots_6              uint32 = 0x1002820
-	Key_braille_dots_16             uint32 = 0x1002821
-	Key_braille_dots_26             uint32 = 0x1002822
-	Key_braille_dots_126            uint32 = 0x1002823
-	Key_braille_dots_36             uint32 = 0x1002824
-	Key_braille_dots_136            uint32 = 0x1002825
-	Key_braille_dots_236            uint32 = 0x1002826
-	Key_braille_dots_1236           uint32 = 0x1002827
-	Key_braille_dots_46             uint32 = 0x1002828
-	Key_braille_dots_146            uint32 = 0x1002829
-	Key_braille_dots_246            uint32 = 0x100282a
-	Key_braille_dots_1246           uint32 = 0x100282b
-	Key_braille_dots_346            uint32 = 0x100282c
-	Key_braille_dots_1346           uint32 = 0x100282d
-	Key_braille_dots_2346           uint32 = 0x100282e
-	Key_braille_dots_12346          uint32 = 0x100282f
-	Key_braille_dots_56             uint32 = 0x1002830
-	Key_braille_dots_156            uint32 = 0x1002831
-	Key_braille_dots_256            uint32 = 0x1002832
-	Key_braille_dots_1256           uint32 = 0x1002833
-	Key_braille_dots_356            uint32 = 0x1002834
-	Key_braille_dots_1356           uint32 = 0x1002835
-	Key_braille_dots_2356           uint32 = 0x1002836
-	Key_braille_dots_12356          uint32 = 0x1002837
-	Key_braille_dots_456            uint32 = 0x1002838
-	Key_braille_dots_1456           uint32 = 0x1002839
-	Key_braille_dots_2456           uint32 = 0x100283a
-	Key_braille_dots_12456          uint32 = 0x100283b
-	Key_braille_dots_3456           uint32 = 0x100283c
-	Key_braille_dots_13456          uint32 = 0x100283d
-	Key_braille_dots_23456          uint32 = 0x100283e
-	Key_braille_dots_123456         uint32 = 0x100283f
-	Key_braille_dots_7              uint32 = 0x1002840
-	Key_braille_dots_17             uint32 = 0x1002841
-	Key_braille_dots_27             uint32 = 0x1002842
-	Key_braille_dots_127            uint32 = 0x1002843
-	Key_braille_dots_37             uint32 = 0x1002844
-	Key_braille_dots_137            uint32 = 0x1002845
-	Key_braille_dots_237            uint32 = 0x1002846
-	Key_braille_dots_1237           uint32 = 0x1002847
-	Key_braille_dots_47             uint32 = 0x1002848
-	Key_braille_dots_147            uint32 = 0x1002849
-	Key_braille_dots_247            uint32 = 0x100284a
-	Key_braille_dots_1247           uint32 = 0x100284b
-	Key_braille_dots_347            uint32 = 0x100284c
-	Key_braille_dots_1347           uint32 = 0x100284d
-	Key_braille_dots_2347           uint32 = 0x100284e
-	Key_braille_dots_12347          uint32 = 0x100284f
-	Key_braille_dots_57             uint32 = 0x1002850
-	Key_braille_dots_157            uint32 = 0x1002851
-	Key_braille_dots_257            uint32 = 0x1002852
-	Key_braille_dots_1257           uint32 = 0x1002853
-	Key_braille_dots_357            uint32 = 0x1002854
-	Key_braille_dots_1357           uint32 = 0x1002855
-	Key_braille_dots_2357           uint32 = 0x1002856
-	Key_braille_dots_12357          uint32 = 0x1002857
-	Key_braille_dots_457            uint32 = 0x1002858
-	Key_braille_dots_1457           uint32 = 0x1002859
-	Key_braille_dots_2457           uint32 = 0x100285a
-	Key_braille_dots_12457          uint32 = 0x100285b
-	Key_braille_dots_3457           uint32 = 0x100285c
-	Key_braille_dots_13457          uint32 = 0x100285d
-	Key_braille_dots_23457          uint32 = 0x100285e
-	Key_braille_dots_123457         uint32 = 0x100285f
-	Key_braille_dots_67             uint32 = 0x1002860
-	Key_braille_dots_167            uint32 = 0x1002861
-	Key_braille_dots_267            uint32 = 0x1002862
-	Key_braille_dots_1267           uint32 = 0x1002863
-	Key_braille_dots_367            uint32 = 0x1002864
-	Key_braille_dots_1367           uint32 = 0x1002865
-	Key_braille_dots_2367           uint32 = 0x1002866
-	Key_braille_dots_12367          uint32 = 0x1002867
-	Key_braille_dots_467            uint32 = 0x1002868
-	Key_braille_dots_1467           uint32 = 0x1002869
-	Key_braille_dots_2467           uint32 = 0x100286a
-	Key_braille_dots_12467          uint32 = 0x100286b
-	Key_braille_dots_3467           uint32 = 0x100286c
-	Key_braille_dots_13467          uint32 = 0x100286d
-	Key_braille_dots_23467          uint32 = 0x100286e
-	Key_braille_dots_123467         uint32 = 0x100286f
-	Key_braille_dots_567            uint32 = 0x1002870
-	Key_braille_dots_1567           uint32 = 0x1002871
-	Key_braille_dots_2567           uint32 = 0x1002872
-	Key_braille_dots_12567          uint32 = 0x1002873
-	Key_braille_dots_3567           uint32 = 0x1002874
-	Key_braille_dots_13567          uint32 = 0x1002875
-	Key_braille_dots_23567          uint32 = 0x1002876
-	Key_braille_dots_123567         uint32 = 0x1002877
-	Key_braille_dots_4567           uint32 = 0x1002878
-	Key_braille_dots_14567          uint32 = 0x1002879
-	Key_braille_dots_24567          uint32 = 0x100287a
-	Key_braille_dots_124567         uint32 = 0x100287b
-	Key_braille_dots_34567          uint32 = 0x100287c
-	Key_braille_dots_134567         uint32 = 0x100287d
-	Key_braille_dots_234567         uint32 = 0x100287e
-	Key_braille_dots_1234567        uint32 = 0x100287f
-	Key_braille_dots_8              uint32 = 0x1002880
-	Key_braille_dots_18             uint32 = 0x1002881
-	Key_braille_dots_28             uint32 = 0x1002882
-	Key_braille_dots_128            uint32 = 0x1002883
-	Key_braille_dots_38             uint32 = 0x1002884
-	Key_braille_dots_138            uint32 = 0x1002885
-	Key_braille_dots_238            uint32 = 0x1002886
-	Key_braille_dots_1238           uint32 = 0x1002887
-	Key_braille_dots_48             uint32 = 0x1002888
-	Key_braille_dots_148            uint32 = 0x1002889
-	Key_braille_dots_248            uint32 = 0x100288a
-	Key_braille_dots_1248           uint32 = 0x100288b
-	Key_braille_dots_348            uint32 = 0x100288c
-	Key_braille_dots_1348           uint32 = 0x100288d
-	Key_braille_dots_2348           uint32 = 0x100288e
-	Key_braille_dots_12348          uint32 = 0x100288f
-	Key_braille_dots_58             uint32 = 0x1002890
-	Key_braille_dots_158            uint32 = 0x1002891
-	Key_braille_dots_258            uint32 = 0x1002892
-	Key_braille_dots_1258           uint32 = 0x1002893
-	Key_braille_dots_358            uint32 = 0x1002894
-	Key_braille_dots_1358           uint32 = 0x1002895
-	Key_braille_dots_2358           uint32 = 0x1002896
-	Key_braille_dots_12358          uint32 = 0x1002897
-	Key_braille_dots_458            uint32 = 0x1002898
-	Key_braille_dots_1458           uint32 = 0x1002899
-	Key_braille_dots_2458           uint32 = 0x100289a
-	Key_braille_dots_12458          uint32 = 0x100289b
-	Key_braille_dots_3458           uint32 = 0x100289c
-	Key_braille_dots_13458          uint32 = 0x100289d
-	Key_braille_dots_23458          uint32 = 0x100289e
-	Key_braille_dots_123458         uint32 = 0x100289f
-	Key_braille_dots_68             uint32 = 0x10028a0
-	Key_braille_dots_168            uint32 = 0x10028a1
-	Key_braille_dots_268            uint32 = 0x10028a2
-	Key_braille_dots_1268           uint32 = 0x10028a3
-	Key_braille_dots_368            uint32 = 0x10028a4
-	Key_braille_dots_1368           uint32 = 0x10028a5
-	Key_braille_dots_2368           uint32 = 0x10028a6
-	Key_braille_dots_12368          uint32 = 0x10028a7
-	Key_braille_dots_468            uint32 = 0x10028a8
-	Key_braille_dots_1468           uint32 = 0x10028a9
-	Key_braille_dots_2468           uint32 = 0x10028aa
-	Key_braille_dots_12468          uint32 = 0x10028ab
-	Key_braille_dots_3468           uint32 = 0x10028ac
-	Key_braille_dots_13468          uint32 = 0x10028ad
-	Key_braille_dots_23468          uint32 = 0x10028ae
-	Key_braille_dots_123468         uint32 = 0x10028af
-	Key_braille_dots_568            uint32 = 0x10028b0
-	Key_braille_dots_1568           uint32 = 0x10028b1
-	Key_braille_dots_2568           uint32 = 0x10028b2
-	Key_braille_dots_12568          uint32 = 0x10028b3
-	Key_braille_dots_3568           uint32 = 0x10028b4
-	Key_braille_dots_13568          uint32 = 0x10028b5
-	Key_braille_dots_23568          uint32 = 0x10028b6
-	Key_braille_dots_123568         uint32 = 0x10028b7
-	Key_braille_dots_4568           uint32 = 0x10028b8
-	Key_braille_dots_14568          uint32 = 0x10028b9
-	Key_braille_dots_24568          uint32 = 0x10028ba
-	Key_braille_dots_124568         uint32 = 0x10028bb
-	Key_braille_dots_34568          uint32 = 0x10028bc
-	Key_braille_dots_134568         uint32 = 0x10028bd
-	Key_braille_dots_234568         uint32 = 0x10028be
-	Key_braille_dots_1234568        uint32 = 0x10028bf
-	Key_braille_dots_78             uint32 = 0x10028c0
-	Key_braille_dots_178            uint32 = 0x10028c1
-	Key_braille_dots_278            uint32 = 0x10028c2
-	Key_braille_dots_1278           uint32 = 0x10028c3
-	Key_braille_dots_378            uint32 = 0x10028c4
-	Key_braille_dots_1378           uint32 = 0x10028c5
-	Key_braille_dots_2378           uint32 = 0x10028c6
-	Key_braille_dots_12378          uint32 = 0x10028c7
-	Key_braille_dots_478            uint32 = 0x10028c8
-	Key_braille_dots_1478           uint32 = 0x10028c9
-	Key_braille_dots_2478           uint32 = 0x10028ca
-	Key_braille_dots_12478          uint32 = 0x10028cb
-	Key_braille_dots_3478           uint32 = 0x10028cc
-	Key_braille_dots_13478          uint32 = 0x10028cd
-	Key_braille_dots_23478          uint32 = 0x10028ce
-	Key_braille_dots_123478         uint32 = 0x10028cf
-	Key_braille_dots_578            uint32 = 0x10028d0
-	Key_braille_dots_1578           uint32 = 0x10028d1
-	Key_braille_dots_2578           uint32 = 0x10028d2
-	Key_braille_dots_12578          uint32 = 0x10028d3
-	Key_braille_dots_3578           uint32 = 0x10028d4
-	Key_braille_dots_13578          uint32 = 0x10028d5
-	Key_braille_dots_23578          uint32 = 0x10028d6
-	Key_braille_dots_123578         uint32 = 0x10028d7
-	Key_braille_dots_4578           uint32 = 0x10028d8
-	Key_braille_dots_14578          uint32 = 0x10028d9
-	Key_braille_dots_24578          uint32 = 0x10028da
-	Key_braille_dots_124578         uint32 = 0x10028db
-	Key_braille_dots_34578          uint32 = 0x10028dc
-	Key_braille_dots_134578         uint32 = 0x10028dd
-	Key_braille_dots_234578         uint32 = 0x10028de
-	Key_braille_dots_1234578        uint32 = 0x10028df
-	Key_braille_dots_678            uint32 = 0x10028e0
-	Key_braille_dots_1678           uint32 = 0x10028e1
-	Key_braille_dots_2678           uint32 = 0x10028e2
-	Key_braille_dots_12678          uint32 = 0x10028e3
-	Key_braille_dots_3678           uint32 = 0x10028e4
-	Key_braille_dots_13678          uint32 = 0x10028e5
-	Key_braille_dots_23678          uint32 = 0x10028e6
-	Key_braille_dots_123678         uint32 = 0x10028e7
-	Key_braille_dots_4678           uint32 = 0x10028e8
-	Key_braille_dots_14678          uint32 = 0x10028e9
-	Key_braille_dots_24678          uint32 = 0x10028ea
-	Key_braille_dots_124678         uint32 = 0x10028eb
-	Key_braille_dots_34678          uint32 = 0x10028ec
-	Key_braille_dots_134678         uint32 = 0x10028ed
-	Key_braille_dots_234678         uint32 = 0x10028ee
-	Key_braille_dots_1234678        uint32 = 0x10028ef
-	Key_braille_dots_5678           uint32 = 0x10028f0
-	Key_braille_dots_15678          uint32 = 0x10028f1
-	Key_braille_dots_25678          uint32 = 0x10028f2
-	Key_braille_dots_125678         uint32 = 0x10028f3
-	Key_braille_dots_35678          uint32 = 0x10028f4
-	Key_braille_dots_135678         uint32 = 0x10028f5
-	Key_braille_dots_235678         uint32 = 0x10028f6
-	Key_braille_dots_1235678        uint32 = 0x10028f7
-	Key_braille_dots_45678          uint32 = 0x10028f8
-	Key_braille_dots_145678         uint32 = 0x10028f9
-	Key_braille_dots_245678         uint32 = 0x10028fa
-	Key_braille_dots_1245678        uint32 = 0x10028fb
-	Key_braille_dots_345678         uint32 = 0x10028fc
-	Key_braille_dots_1345678        uint32 = 0x10028fd
-	Key_braille_dots_2345678        uint32 = 0x10028fe
-	Key_braille_dots_12345678       uint32 = 0x10028ff
-	Key_Sinh_ng                     uint32 = 0x1000d82
-	Key_Sinh_h2                     uint32 = 0x1000d83
-	Key_Sinh_a                      uint32 = 0x1000d85
-	Key_Sinh_aa                     uint32 = 0x1000d86
-	Key_Sinh_ae                     uint32 = 0x1000d87
-	Key_Sinh_aee                    uint32 = 0x1000d88
-	Key_Sinh_i                      uint32 = 0x1000d89
-	Key_Sinh_ii                     uint32 = 0x1000d8a
-	Key_Sinh_u                      uint32 = 0x1000d8b
-	Key_Sinh_uu                     uint32 = 0x1000d8c
-	Key_Sinh_ri                     uint32 = 0x1000d8d
-	Key_Sinh_rii                    uint32 = 0x1000d8e
-	Key_Sinh_lu                     uint32 = 0x1000d8f
-	Key_Sinh_luu                    uint32 = 0x1000d90
-	Key_Sinh_e                      uint32 = 0x1000d91
-	Key_Sinh_ee                     uint32 = 0x1000d92
-	Key_Sinh_ai                     uint32 = 0x1000d93
-	Key_Sinh_o                      uint32 = 0x1000d94
-	Key_Sinh_oo                     uint32 = 0x1000d95
-	Key_Sinh_au                     uint32 = 0x1000d96
-	Key_Sinh_ka                     uint32 = 0x1000d9a
-	Key_Sinh_kha                    uint32 = 0x1000d9b
-	Key_Sinh_ga                     uint32 = 0x1000d9c
-	Key_Sinh_gha                    uint32 = 0x1000d9d
-	Key_Sinh_ng2                    uint32 = 0x1000d9e
-	Key_Sinh_nga                    uint32 = 0x1000d9f
-	Key_Sinh_ca                     uint32 = 0x1000da0
-	Key_Sinh_cha                    uint32 = 0x1000da1
-	Key_Sinh_ja                     uint32 = 0x1000da2
-	Key_Sinh_jha                    uint32 = 0x1000da3
-	Key_Sinh_nya                    uint32 = 0x1000da4
-	Key_Sinh_jnya                   uint32 = 0x1000da5
-	Key_Sinh_nja                    uint32 = 0x1000da6
-	Key_Sinh_tta                    uint32 = 0x1000da7
-	Key_Sinh_ttha                   uint32 = 0x1000da8
-	Key_Sinh_dda                    uint32 = 0x1000da9
-	Key_Sinh_ddha                   uint32 = 0x1000daa
-	Key_Sinh_nna                    uint32 = 0x1000dab
-	Key_Sinh_ndda                   uint32 = 0x1000dac
-	Key_Sinh_tha                    uint32 = 0x1000dad
-	Key_Sinh_thha                   uint32 = 0x1000dae
-	Key_Sinh_dha                    uint32 = 0x1000daf
-	Key_Sinh_dhha                   uint32 = 0x1000db0
-	Key_Sinh_na                     uint32 = 0x1000db1
-	Key_Sinh_ndha                   uint32 = 0x1000db3
-	Key_Sinh_pa                     uint32 = 0x1000db4
-	Key_Sinh_pha                    uint32 = 0x1000db5
-	Key_Sinh_ba                     uint32 = 0x1000db6
-	Key_Sinh_bha                    uint32 = 0x1000db7
-	Key_Sinh_ma                     uint32 = 0x1000db8
-	Key_Sinh_mba                    uint32 = 0x1000db9
-	Key_Sinh_ya                     uint32 = 0x1000dba
-	Key_Sinh_ra                     uint32 = 0x1000dbb
-	Key_Sinh_la                     uint32 = 0x1000dbd
-	Key_Sinh_va                     uint32 = 0x1000dc0
-	Key_Sinh_sha                    uint32 = 0x1000dc1
-	Key_Sinh_ssha                   uint32 = 0x1000dc2
-	Key_Sinh_sa                     uint32 = 0x1000dc3
-	Key_Sinh_ha                     uint32 = 0x1000dc4
-	Key_Sinh_lla                    uint32 = 0x1000dc5
-	Key_Sinh_fa                     uint32 = 0x1000dc6
-	Key_Sinh_al                     uint32 = 0x1000dca
-	Key_Sinh_aa2                    uint32 = 0x1000dcf
-	Key_Sinh_ae2                    uint32 = 0x1000dd0
-	Key_Sinh_aee2                   uint32 = 0x1000dd1
-	Key_Sinh_i2                     uint32 = 0x1000dd2
-	Key_Sinh_ii2                    uint32 = 0x1000dd3
-	Key_Sinh_u2                     uint32 = 0x1000dd4
-	Key_Sinh_uu2                    uint32 = 0x1000dd6
-	Key_Sinh_ru2                    uint32 = 0x1000dd8
-	Key_Sinh_e2                     uint32 = 0x1000dd9
-	Key_Sinh_ee2                    uint32 = 0x1000dda
-	Key_Sinh_ai2                    uint32 = 0x1000ddb
-	Key_Sinh_o2                     uint32 = 0x1000ddc
-	Key_Sinh_oo2                    uint32 = 0x1000ddd
-	Key_Sinh_au2                    uint32 = 0x1000dde
-	Key_Sinh_lu2                    uint32 = 0x1000ddf
-	Key_Sinh_ruu2                   uint32 = 0x1000df2
-	Key_Sinh_luu2                   uint32 = 0x1000df3
-	Key_Sinh_kunddaliya             uint32 = 0x1000df4
+const (
+	Key_VoidSymbol                  Keysym = 0xffffff
+	Key_BackSpace                   Keysym = 0xff08
+	Key_Tab                         Keysym = 0xff09
+	Key_Linefeed                    Keysym = 0xff0a
+	Key_Clear                       Keysym = 0xff0b
+	Key_Return                      Keysym = 0xff0d
+	Key_Pause                       Keysym = 0xff13
+	Key_Scroll_Lock                 Keysym = 0xff14
+	Key_Sys_Req                     Keysym = 0xff15
+	Key_Escape                      Keysym = 0xff1b
+	Key_Delete                      Keysym = 0xffff
+	Key_Multi_key                   Keysym = 0xff20
+	Key_Codeinput                   Keysym = 0xff37
+	Key_SingleCandidate             Keysym = 0xff3c
+	Key_MultipleCandidate           Keysym = 0xff3d
+	Key_PreviousCandidate           Keysym = 0xff3e
+	Key_Kanji                       Keysym = 0xff21
+	Key_Muhenkan                    Keysym = 0xff22
+	Key_Henkan_Mode                 Keysym = 0xff23
+	Key_Henkan                      Keysym = 0xff23
+	Key_Romaji                      Keysym = 0xff24
+	Key_Hiragana                    Keysym = 0xff25
+	Key_Katakana                    Keysym = 0xff26
+	Key_Hiragana_Katakana           Keysym = 0xff27
+	Key_Zenkaku                     Keysym = 0xff28
+	Key_Hankaku                     Keysym = 0xff29
+	Key_Zenkaku_Hankaku             Keysym = 0xff2a
+	Key_Touroku                     Keysym = 0xff2b
+	Key_Massyo                      Keysym = 0xff2c
+	Key_Kana_Lock                   Keysym = 0xff2d
+	Key_Kana_Shift                  Keysym = 0xff2e
+	Key_Eisu_Shift                  Keysym = 0xff2f
+	Key_Eisu_toggle                 Keysym = 0xff30
+	Key_Kanji_Bangou                Keysym = 0xff37
+	Key_Zen_Koho                    Keysym = 0xff3d
+	Key_Mae_Koho                    Keysym = 0xff3e
+	Key_Home                        Keysym = 0xff50
+	Key_Left                        Keysym = 0xff51
+	Key_Up                          Keysym = 0xff52
+	Key_Right                       Keysym = 0xff53
+	Key_Down                        Keysym = 0xff54
+	Key_Prior                       Keysym = 0xff55
+	Key_Page_Up                     Keysym = 0xff55
+	Key_Next                        Keysym = 0xff56
+	Key_Page_Down                   Keysym = 0xff56
+	Key_End                         Keysym = 0xff57
+	Key_Begin                       Keysym = 0xff58
+	Key_Select                      Keysym = 0xff60
+	Key_Print                       Keysym = 0xff61
+	Key_Execute                     Keysym = 0xff62
+	Key_Insert                      Keysym = 0xff63
+	Key_Undo                        Keysym = 0xff65
+	Key_Redo                        Keysym = 0xff66
+	Key_Menu                        Keysym = 0xff67
+	Key_Find                        Keysym = 0xff68
+	Key_Cancel                      Keysym = 0xff69
+	Key_Help                        Keysym = 0xff6a
+	Key_Break                       Keysym = 0xff6b
+	Key_Mode_switch                 Keysym = 0xff7e
+	Key_script_switch               Keysym = 0xff7e
+	Key_Num_Lock                    Keysym = 0xff7f
+	Key_KP_Space                    Keysym = 0xff80
+	Key_KP_Tab                      Keysym = 0xff89
+	Key_KP_Enter                    Keysym = 0xff8d
+	Key_KP_F1                       Keysym = 0xff91
+	Key_KP_F2                       Keysym = 0xff92
+	Key_KP_F3                       Keysym = 0xff93
+	Key_KP_F4                       Keysym = 0xff94
+	Key_KP_Home                     Keysym = 0xff95
+	Key_KP_Left                     Keysym = 0xff96
+	Key_KP_Up                       Keysym = 0xff97
+	Key_KP_Right                    Keysym = 0xff98
+	Key_KP_Down                     Keysym = 0xff99
+	Key_KP_Prior                    Keysym = 0xff9a
+	Key_KP_Page_Up                  Keysym = 0xff9a
+	Key_KP_Next                     Keysym = 0xff9b
+	Key_KP_Page_Down                Keysym = 0xff9b
+	Key_KP_End                      Keysym = 0xff9c
+	Key_KP_Begin                    Keysym = 0xff9d
+	Key_KP_Insert                   Keysym = 0xff9e
+	Key_KP_Delete                   Keysym = 0xff9f
+	Key_KP_Equal                    Keysym = 0xffbd
+	Key_KP_Multiply                 Keysym = 0xffaa
+	Key_KP_Add                      Keysym = 0xffab
+	Key_KP_Separator                Keysym = 0xffac
+	Key_KP_Subtract                 Keysym = 0xffad
+	Key_KP_Decimal                  Keysym = 0xffae
+	Key_KP_Divide                   Keysym = 0xffaf
+	Key_KP_0                        Keysym = 0xffb0
+	Key_KP_1                        Keysym = 0xffb1
+	Key_KP_2                        Keysym = 0xffb2
+	Key_KP_3                        Keysym = 0xffb3
+	Key_KP_4                        Keysym = 0xffb4
+	Key_KP_5                        Keysym = 0xffb5
+	Key_KP_6                        Keysym = 0xffb6
+	Key_KP_7                        Keysym = 0xffb7
+	Key_KP_8                        Keysym = 0xffb8
+	Key_KP_9                        Keysym = 0xffb9
+	Key_F1                          Keysym = 0xffbe
+	Key_F2                          Keysym = 0xffbf
+	Key_F3                          Keysym = 0xffc0
+	Key_F4                          Keysym = 0xffc1
+	Key_F5                          Keysym = 0xffc2
+	Key_F6                          Keysym = 0xffc3
+	Key_F7                          Keysym = 0xffc4
+	Key_F8                          Keysym = 0xffc5
+	Key_F9                          Keysym = 0xffc6
+	Key_F10                         Keysym = 0xffc7
+	Key_F11                         Keysym = 0xffc8
+	Key_L1                          Keysym = 0xffc8
+	Key_F12                         Keysym = 0xffc9
+	Key_L2                          Keysym = 0xffc9
+	Key_F13                         Keysym = 0xffca
+	Key_L3                          Keysym = 0xffca
+	Key_F14                         Keysym = 0xffcb
+	Key_L4                          Keysym = 0xffcb
+	Key_F15                         Keysym = 0xffcc
+	Key_L5                          Keysym = 0xffcc
+	Key_F16                         Keysym = 0xffcd
+	Key_L6                          Keysym = 0xffcd
+	Key_F17                         Keysym = 0xffce
+	Key_L7                          Keysym = 0xffce
+	Key_F18                         Keysym = 0xffcf
+	Key_L8                          Keysym = 0xffcf
+	Key_F19                         Keysym = 0xffd0
+	Key_L9                          Keysym = 0xffd0
+	Key_F20                         Keysym = 0xffd1
+	Key_L10                         Keysym = 0xffd1
+	Key_F21                         Keysym = 0xffd2
+	Key_R1                          Keysym = 0xffd2
+	Key_F22                         Keysym = 0xffd3
+	Key_R2                          Keysym = 0xffd3
+	Key_F23                         Keysym = 0xffd4
+	Key_R3                          Keysym = 0xffd4
+	Key_F24                         Keysym = 0xffd5
+	Key_R4                          Keysym = 0xffd5
+	Key_F25                         Keysym = 0xffd6
+	Key_R5                          Keysym = 0xffd6
+	Key_F26                         Keysym = 0xffd7
+	Key_R6                          Keysym = 0xffd7
+	Key_F27                         Keysym = 0xffd8
+	Key_R7                          Keysym = 0xffd8
+	Key_F28                         Keysym = 0xffd9
+	Key_R8                          Keysym = 0xffd9
+	Key_F29                         Keysym = 0xffda
+	Key_R9                          Keysym = 0xffda
+	Key_F30                         Keysym = 0xffdb
+	Key_R10                         Keysym = 0xffdb
+	Key_F31                         Keysym = 0xffdc
+	Key_R11                         Keysym = 0xffdc
+	Key_F32                         Keysym = 0xffdd
+	Key_R12                         Keysym = 0xffdd
+	Key_F33                         Keysym = 0xffde
+	Key_R13                         Keysym = 0xffde
+	Key_F34                         Keysym = 0xffdf
+	Key_R14                         Keysym = 0xffdf
+	Key_F35                         Keysym = 0xffe0
+	Key_R15                         Keysym = 0xffe0
+	Key_Shift_L                     Keysym = 0xffe1
+	Key_Shift_R                     Keysym = 0xffe2
+	Key_Control_L                   Keysym = 0xffe3
+	Key_Control_R                   Keysym = 0xffe4
+	Key_Caps_Lock                   Keysym = 0xffe5
+	Key_Shift_Lock                  Keysym = 0xffe6
+	Key_Meta_L                      Keysym = 0xffe7
+	Key_Meta_R                      Keysym = 0xffe8
+	Key_Alt_L                       Keysym = 0xffe9
+	Key_Alt_R                       Keysym = 0xffea
+	Key_Super_L                     Keysym = 0xffeb
+	Key_Super_R                     Keysym = 0xffec
+	Key_Hyper_L                     Keysym = 0xffed
+	Key_Hyper_R                     Keysym = 0xffee
+	Key_ISO_Lock                    Keysym = 0xfe01
+	Key_ISO_Level2_Latch            Keysym = 0xfe02
+	Key_ISO_Level3_Shift            Keysym = 0xfe03
+	Key_ISO_Level3_Latch            Keysym = 0xfe04
+	Key_ISO_Level3_Lock             Keysym = 0xfe05
+	Key_ISO_Level5_Shift            Keysym = 0xfe11
+	Key_ISO_Level5_Latch            Keysym = 0xfe12
+	Key_ISO_Level5_Lock             Keysym = 0xfe13
+	Key_ISO_Group_Shift             Keysym = 0xff7e
+	Key_ISO_Group_Latch             Keysym = 0xfe06
+	Key_ISO_Group_Lock              Keysym = 0xfe07
+	Key_ISO_Next_Group              Keysym = 0xfe08
+	Key_ISO_Next_Group_Lock         Keysym = 0xfe09
+	Key_ISO_Prev_Group              Keysym = 0xfe0a
+	Key_ISO_Prev_Group_Lock         Keysym = 0xfe0b
+	Key_ISO_First_Group             Keysym = 0xfe0c
+	Key_ISO_First_Group_Lock        Keysym = 0xfe0d
+	Key_ISO_Last_Group              Keysym = 0xfe0e
+	Key_ISO_Last_Group_Lock         Keysym = 0xfe0f
+	Key_ISO_Left_Tab                Keysym = 0xfe20
+	Key_ISO_Move_Line_Up            Keysym = 0xfe21
+	Key_ISO_Move_Line_Down          Keysym = 0xfe22
+	Key_ISO_Partial_Line_Up         Keysym = 0xfe23
+	Key_ISO_Partial_Line_Down       Keysym = 0xfe24
+	Key_ISO_Partial_Space_Left      Keysym = 0xfe25
+	Key_ISO_Partial_Space_Right     Keysym = 0xfe26
+	Key_ISO_Set_Margin_Left         Keysym = 0xfe27
+	Key_ISO_Set_Margin_Right        Keysym = 0xfe28
+	Key_ISO_Release_Margin_Left     Keysym = 0xfe29
+	Key_ISO_Release_Margin_Right    Keysym = 0xfe2a
+	Key_ISO_Release_Both_Margins    Keysym = 0xfe2b
+	Key_ISO_Fast_Cursor_Left        Keysym = 0xfe2c
+	Key_ISO_Fast_Cursor_Right       Keysym = 0xfe2d
+	Key_ISO_Fast_Cursor_Up          Keysym = 0xfe2e
+	Key_ISO_Fast_Cursor_Down        Keysym = 0xfe2f
+	Key_ISO_Continuous_Underline    Keysym = 0xfe30
+	Key_ISO_Discontinuous_Underline Keysym = 0xfe31
+	Key_ISO_Emphasize               Keysym = 0xfe32
+	Key_ISO_Center_Object           Keysym = 0xfe33
+	Key_ISO_Enter                   Keysym = 0xfe34
+	Key_dead_grave                  Keysym = 0xfe50
+	Key_dead_acute                  Keysym = 0xfe51
+	Key_dead_circumflex             Keysym = 0xfe52
+	Key_dead_tilde                  Keysym = 0xfe53
+	Key_dead_perispomeni            Keysym = 0xfe53
+	Key_dead_macron                 Keysym = 0xfe54
+	Key_dead_breve                  Keysym = 0xfe55
+	Key_dead_abovedot               Keysym = 0xfe56
+	Key_dead_diaeresis              Keysym = 0xfe57
+	Key_dead_abovering              Keysym = 0xfe58
+	Key_dead_doubleacute            Keysym = 0xfe59
+	Key_dead_caron                  Keysym = 0xfe5a
+	Key_dead_cedilla                Keysym = 0xfe5b
+	Key_dead_ogonek                 Keysym = 0xfe5c
+	Key_dead_iota                   Keysym = 0xfe5d
+	Key_dead_voiced_sound           Keysym = 0xfe5e
+	Key_dead_semivoiced_sound       Keysym = 0xfe5f
+	Key_dead_belowdot               Keysym = 0xfe60
+	Key_dead_hook                   Keysym = 0xfe61
+	Key_dead_horn                   Keysym = 0xfe62
+	Key_dead_stroke                 Keysym = 0xfe63
+	Key_dead_abovecomma             Keysym = 0xfe64
+	Key_dead_psili                  Keysym = 0xfe64
+	Key_dead_abovereversedcomma     Keysym = 0xfe65
+	Key_dead_dasia                  Keysym = 0xfe65
+	Key_dead_doublegrave            Keysym = 0xfe66
+	Key_dead_belowring              Keysym = 0xfe67
+	Key_dead_belowmacron            Keysym = 0xfe68
+	Key_dead_belowcircumflex        Keysym = 0xfe69
+	Key_dead_belowtilde             Keysym = 0xfe6a
+	Key_dead_belowbreve             Keysym = 0xfe6b
+	Key_dead_belowdiaeresis         Keysym = 0xfe6c
+	Key_dead_invertedbreve          Keysym = 0xfe6d
+	Key_dead_belowcomma             Keysym = 0xfe6e
+	Key_dead_currency               Keysym = 0xfe6f
+	Key_dead_lowline                Keysym = 0xfe90
+	Key_dead_aboveverticalline      Keysym = 0xfe91
+	Key_dead_belowverticalline      Keysym = 0xfe92
+	Key_dead_longsolidusoverlay     Keysym = 0xfe93
+	Key_dead_a                      Keysym = 0xfe80
+	Key_dead_A                      Keysym = 0xfe81
+	Key_dead_e                      Keysym = 0xfe82
+	Key_dead_E                      Keysym = 0xfe83
+	Key_dead_i                      Keysym = 0xfe84
+	Key_dead_I                      Keysym = 0xfe85
+	Key_dead_o                      Keysym = 0xfe86
+	Key_dead_O                      Keysym = 0xfe87
+	Key_dead_u                      Keysym = 0xfe88
+	Key_dead_U                      Keysym = 0xfe89
+	Key_dead_small_schwa            Keysym = 0xfe8a
+	Key_dead_capital_schwa          Keysym = 0xfe8b
+	Key_dead_greek                  Keysym = 0xfe8c
+	Key_First_Virtual_Screen        Keysym = 0xfed0
+	Key_Prev_Virtual_Screen         Keysym = 0xfed1
+	Key_Next_Virtual_Screen         Keysym = 0xfed2
+	Key_Last_Virtual_Screen         Keysym = 0xfed4
+	Key_Terminate_Server            Keysym = 0xfed5
+	Key_AccessX_Enable              Keysym = 0xfe70
+	Key_AccessX_Feedback_Enable     Keysym = 0xfe71
+	Key_RepeatKeys_Enable           Keysym = 0xfe72
+	Key_SlowKeys_Enable             Keysym = 0xfe73
+	Key_BounceKeys_Enable           Keysym = 0xfe74
+	Key_StickyKeys_Enable           Keysym = 0xfe75
+	Key_MouseKeys_Enable            Keysym = 0xfe76
+	Key_MouseKeys_Accel_Enable      Keysym = 0xfe77
+	Key_Overlay1_Enable             Keysym = 0xfe78
+	Key_Overlay2_Enable             Keysym = 0xfe79
+	Key_AudibleBell_Enable          Keysym = 0xfe7a
+	Key_Pointer_Left                Keysym = 0xfee0
+	Key_Pointer_Right               Keysym = 0xfee1
+	Key_Pointer_Up                  Keysym = 0xfee2
+	Key_Pointer_Down                Keysym = 0xfee3
+	Key_Pointer_UpLeft              Keysym = 0xfee4
+	Key_Pointer_UpRight             Keysym = 0xfee5
+	Key_Pointer_DownLeft            Keysym = 0xfee6
+	Key_Pointer_DownRight           Keysym = 0xfee7
+	Key_Pointer_Button_Dflt         Keysym = 0xfee8
+	Key_Pointer_Button1             Keysym = 0xfee9
+	Key_Pointer_Button2             Keysym = 0xfeea
+	Key_Pointer_Button3             Keysym = 0xfeeb
+	Key_Pointer_Button4             Keysym = 0xfeec
+	Key_Pointer_Button5             Keysym = 0xfeed
+	Key_Pointer_DblClick_Dflt       Keysym = 0xfeee
+	Key_Pointer_DblClick1           Keysym = 0xfeef
+	Key_Pointer_DblClick2           Keysym = 0xfef0
+	Key_Pointer_DblClick3           Keysym = 0xfef1
+	Key_Pointer_DblClick4           Keysym = 0xfef2
+	Key_Pointer_DblClick5           Keysym = 0xfef3
+	Key_Pointer_Drag_Dflt           Keysym = 0xfef4
+	Key_Pointer_Drag1               Keysym = 0xfef5
+	Key_Pointer_Drag2               Keysym = 0xfef6
+	Key_Pointer_Drag3               Keysym = 0xfef7
+	Key_Pointer_Drag4               Keysym = 0xfef8
+	Key_Pointer_Drag5               Keysym = 0xfefd
+	Key_Pointer_EnableKeys          Keysym = 0xfef9
+	Key_Pointer_Accelerate          Keysym = 0xfefa
+	Key_Pointer_DfltBtnNext         Keysym = 0xfefb
+	Key_Pointer_DfltBtnPrev         Keysym = 0xfefc
+	Key_ch                          Keysym = 0xfea0
+	Key_Ch                          Keysym = 0xfea1
+	Key_CH                          Keysym = 0xfea2
+	Key_c_h                         Keysym = 0xfea3
+	Key_C_h                         Keysym = 0xfea4
+	Key_C_H                         Keysym = 0xfea5
+	Key_3270_Duplicate              Keysym = 0xfd01
+	Key_3270_FieldMark              Keysym = 0xfd02
+	Key_3270_Right2                 Keysym = 0xfd03
+	Key_3270_Left2                  Keysym = 0xfd04
+	Key_3270_BackTab                Keysym = 0xfd05
+	Key_3270_EraseEOF               Keysym = 0xfd06
+	Key_3270_EraseInput             Keysym = 0xfd07
+	Key_3270_Reset                  Keysym = 0xfd08
+	Key_3270_Quit                   Keysym = 0xfd09
+	Key_3270_PA1                    Keysym = 0xfd0a
+	Key_3270_PA2                    Keysym = 0xfd0b
+	Key_3270_PA3                    Keysym = 0xfd0c
+	Key_3270_Test                   Keysym = 0xfd0d
+	Key_3270_Attn                   Keysym = 0xfd0e
+	Key_3270_CursorBlink            Keysym = 0xfd0f
+	Key_3270_AltCursor              Keysym = 0xfd10
+	Key_3270_KeyClick               Keysym = 0xfd11
+	Key_3270_Jump                   Keysym = 0xfd12
+	Key_3270_Ident                  Keysym = 0xfd13
+	Key_3270_Rule                   Keysym = 0xfd14
+	Key_3270_Copy                   Keysym = 0xfd15
+	Key_3270_Play                   Keysym = 0xfd16
+	Key_3270_Setup                  Keysym = 0xfd17
+	Key_3270_Record                 Keysym = 0xfd18
+	Key_3270_ChangeScreen           Keysym = 0xfd19
+	Key_3270_DeleteWord             Keysym = 0xfd1a
+	Key_3270_ExSelect               Keysym = 0xfd1b
+	Key_3270_CursorSelect           Keysym = 0xfd1c
+	Key_3270_PrintScreen            Keysym = 0xfd1d
+	Key_3270_Enter                  Keysym = 0xfd1e
+	Key_space                       Keysym = 0x0020
+	Key_exclam                      Keysym = 0x0021
+	Key_quotedbl                    Keysym = 0x0022
+	Key_numbersign                  Keysym = 0x0023
+	Key_dollar                      Keysym = 0x0024
+	Key_percent                     Keysym = 0x0025
+	Key_ampersand                   Keysym = 0x0026
+	Key_apostrophe                  Keysym = 0x0027
+	Key_quoteright                  Keysym = 0x0027
+	Key_parenleft                   Keysym = 0x0028
+	Key_parenright                  Keysym = 0x0029
+	Key_asterisk                    Keysym = 0x002a
+	Key_plus                        Keysym = 0x002b
+	Key_comma                       Keysym = 0x002c
+	Key_minus                       Keysym = 0x002d
+	Key_period                      Keysym = 0x002e
+	Key_slash                       Keysym = 0x002f
+	Key_0                           Keysym = 0x0030
+	Key_1                           Keysym = 0x0031
+	Key_2                           Keysym = 0x0032
+	Key_3                           Keysym = 0x0033
+	Key_4                           Keysym = 0x0034
+	Key_5                           Keysym = 0x0035
+	Key_6                           Keysym = 0x0036
+	Key_7                           Keysym = 0x0037
+	Key_8                           Keysym = 0x0038
+	Key_9                           Keysym = 0x0039
+	Key_colon                       Keysym = 0x003a
+	Key_semicolon                   Keysym = 0x003b
+	Key_less                        Keysym = 0x003c
+	Key_equal                       Keysym = 0x003d
+	Key_greater                     Keysym = 0x003e
+	Key_question                    Keysym = 0x003f
+	Key_at                          Keysym = 0x0040
+	Key_A                           Keysym = 0x0041
+	Key_B                           Keysym = 0x0042
+	Key_C                           Keysym = 0x0043
+	Key_D                           Keysym = 0x0044
+	Key_E                           Keysym = 0x0045
+	Key_F                           Keysym = 0x0046
+	Key_G                           Keysym = 0x0047
+	Key_H                           Keysym = 0x0048
+	Key_I                           Keysym = 0x0049
+	Key_J                           Keysym = 0x004a
+	Key_K                           Keysym = 0x004b
+	Key_L                           Keysym = 0x004c
+	Key_M                           Keysym = 0x004d
+	Key_N                           Keysym = 0x004e
+	Key_O                           Keysym = 0x004f
+	Key_P                           Keysym = 0x0050
+	Key_Q                           Keysym = 0x0051
+	Key_R                           Keysym = 0x0052
+	Key_S                           Keysym = 0x0053
+	Key_T                           Keysym = 0x0054
+	Key_U                           Keysym = 0x0055
+	Key_V                           Keysym = 0x0056
+	Key_W                           Keysym = 0x0057
+	Key_X                           Keysym = 0x0058
+	Key_Y                           Keysym = 0x0059
+	Key_Z                           Keysym = 0x005a
+	Key_bracketleft                 Keysym = 0x005b
+	Key_backslash                   Keysym = 0x005c
+	Key_bracketright                Keysym = 0x005d
+	Key_asciicircum                 Keysym = 0x005e
+	Key_underscore                  Keysym = 0x005f
+	Key_grave                       Keysym = 0x0060
+	Key_quoteleft                   Keysym = 0x0060
+	Key_a                           Keysym = 0x0061
+	Key_b                           Keysym = 0x0062
+	Key_c                           Keysym = 0x0063
+	Key_d                           Keysym = 0x0064
+	Key_e                           Keysym = 0x0065
+	Key_f                           Keysym = 0x0066
+	Key_g                           Keysym = 0x0067
+	Key_h                           Keysym = 0x0068
+	Key_i                           Keysym = 0x0069
+	Key_j                           Keysym = 0x006a
+	Key_k                           Keysym = 0x006b
+	Key_l                           Keysym = 0x006c
+	Key_m                           Keysym = 0x006d
+	Key_n                           Keysym = 0x006e
+	Key_o                           Keysym = 0x006f
+	Key_p                           Keysym = 0x0070
+	Key_q                           Keysym = 0x0071
+	Key_r                           Keysym = 0x0072
+	Key_s                           Keysym = 0x0073
+	Key_t                           Keysym = 0x0074
+	Key_u                           Keysym = 0x0075
+	Key_v                           Keysym = 0x0076
+	Key_w                           Keysym = 0x0077
+	Key_x                           Keysym = 0x0078
+	Key_y                           Keysym = 0x0079
+	Key_z                           Keysym = 0x007a
+	Key_braceleft                   Keysym = 0x007b
+	Key_bar                         Keysym = 0x007c
+	Key_braceright                  Keysym = 0x007d
+	Key_asciitilde                  Keysym = 0x007e
+	Key_nobreakspace                Keysym = 0x00a0
+	Key_exclamdown                  Keysym = 0x00a1
+	Key_cent                        Keysym = 0x00a2
+	Key_sterling                    Keysym = 0x00a3
+	Key_currency                    Keysym = 0x00a4
+	Key_yen                         Keysym = 0x00a5
+	Key_brokenbar                   Keysym = 0x00a6
+	Key_section                     Keysym = 0x00a7
+	Key_diaeresis                   Keysym = 0x00a8
+	Key_copyright                   Keysym = 0x00a9
+	Key_ordfeminine                 Keysym = 0x00aa
+	Key_guillemotleft               Keysym = 0x00ab
+	Key_notsign                     Keysym = 0x00ac
+	Key_hyphen                      Keysym = 0x00ad
+	Key_registered                  Keysym = 0x00ae
+	Key_macron                      Keysym = 0x00af
+	Key_degree                      Keysym = 0x00b0
+	Key_plusminus                   Keysym = 0x00b1
+	Key_twosuperior                 Keysym = 0x00b2
+	Key_threesuperior               Keysym = 0x00b3
+	Key_acute                       Keysym = 0x00b4
+	Key_mu                          Keysym = 0x00b5
+	Key_paragraph                   Keysym = 0x00b6
+	Key_periodcentered              Keysym = 0x00b7
+	Key_cedilla                     Keysym = 0x00b8
+	Key_onesuperior                 Keysym = 0x00b9
+	Key_masculine                   Keysym = 0x00ba
+	Key_guillemotright              Keysym = 0x00bb
+	Key_onequarter                  Keysym = 0x00bc
+	Key_onehalf                     Keysym = 0x00bd
+	Key_threequarters               Keysym = 0x00be
+	Key_questiondown                Keysym = 0x00bf
+	Key_Agrave                      Keysym = 0x00c0
+	Key_Aacute                      Keysym = 0x00c1
+	Key_Acircumflex                 Keysym = 0x00c2
+	Key_Atilde                      Keysym = 0x00c3
+	Key_Adiaeresis                  Keysym = 0x00c4
+	Key_Aring                       Keysym = 0x00c5
+	Key_AE                          Keysym = 0x00c6
+	Key_Ccedilla                    Keysym = 0x00c7
+	Key_Egrave                      Keysym = 0x00c8
+	Key_Eacute                      Keysym = 0x00c9
+	Key_Ecircumflex                 Keysym = 0x00ca
+	Key_Ediaeresis                  Keysym = 0x00cb
+	Key_Igrave                      Keysym = 0x00cc
+	Key_Iacute                      Keysym = 0x00cd
+	Key_Icircumflex                 Keysym = 0x00ce
+	Key_Idiaeresis                  Keysym = 0x00cf
+	Key_ETH                         Keysym = 0x00d0
+	Key_Eth                         Keysym = 0x00d0
+	Key_Ntilde                      Keysym = 0x00d1
+	Key_Ograve                      Keysym = 0x00d2
+	Key_Oacute                      Keysym = 0x00d3
+	Key_Ocircumflex                 Keysym = 0x00d4
+	Key_Otilde                      Keysym = 0x00d5
+	Key_Odiaeresis                  Keysym = 0x00d6
+	Key_multiply                    Keysym = 0x00d7
+	Key_Oslash                      Keysym = 0x00d8
+	Key_Ooblique                    Keysym = 0x00d8
+	Key_Ugrave                      Keysym = 0x00d9
+	Key_Uacute                      Keysym = 0x00da
+	Key_Ucircumflex                 Keysym = 0x00db
+	Key_Udiaeresis                  Keysym = 0x00dc
+	Key_Yacute                      Keysym = 0x00dd
+	Key_THORN                       Keysym = 0x00de
+	Key_Thorn                       Keysym = 0x00de
+	Key_ssharp                      Keysym = 0x00df
+	Key_agrave                      Keysym = 0x00e0
+	Key_aacute                      Keysym = 0x00e1
+	Key_acircumflex                 Keysym = 0x00e2
+	Key_atilde                      Keysym = 0x00e3
+	Key_adiaeresis                  Keysym = 0x00e4
+	Key_aring                       Keysym = 0x00e5
+	Key_ae                          Keysym = 0x00e6
+	Key_ccedilla                    Keysym = 0x00e7
+	Key_egrave                      Keysym = 0x00e8
+	Key_eacute                      Keysym = 0x00e9
+	Key_ecircumflex                 Keysym = 0x00ea
+	Key_ediaeresis                  Keysym = 0x00eb
+	Key_igrave                      Keysym = 0x00ec
+	Key_iacute                      Keysym = 0x00ed
+	Key_icircumflex                 Keysym = 0x00ee
+	Key_idiaeresis                  Keysym = 0x00ef
+	Key_eth                         Keysym = 0x00f0
+	Key_ntilde                      Keysym = 0x00f1
+	Key_ograve                      Keysym = 0x00f2
+	Key_oacute                      Keysym = 0x00f3
+	Key_ocircumflex                 Keysym = 0x00f4
+	Key_otilde                      Keysym = 0x00f5
+	Key_odiaeresis                  Keysym = 0x00f6
+	Key_division                    Keysym = 0x00f7
+	Key_oslash                      Keysym = 0x00f8
+	Key_ooblique                    Keysym = 0x00f8
+	Key_ugrave                      Keysym = 0x00f9
+	Key_uacute                      Keysym = 0x00fa
+	Key_ucircumflex                 Keysym = 0x00fb
+	Key_udiaeresis                  Keysym = 0x00fc
+	Key_yacute                      Keysym = 0x00fd
+	Key_thorn                       Keysym = 0x00fe
+	Key_ydiaeresis                  Keysym = 0x00ff
+	Key_Aogonek                     Keysym = 0x01a1
+	Key_breve                       Keysym = 0x01a2
+	Key_Lstroke                     Keysym = 0x01a3
+	Key_Lcaron                      Keysym = 0x01a5
+	Key_Sacute                      Keysym = 0x01a6
+	Key_Scaron                      Keysym = 0x01a9
+	Key_Scedilla                    Keysym = 0x01aa
+	Key_Tcaron                      Keysym = 0x01ab
+	Key_Zacute                      Keysym = 0x01ac
+	Key_Zcaron                      Keysym = 0x01ae
+	Key_Zabovedot                   Keysym = 0x01af
+	Key_aogonek                     Keysym = 0x01b1
+	Key_ogonek                      Keysym = 0x01b2
+	Key_lstroke                     Keysym = 0x01b3
+	Key_lcaron                      Keysym = 0x01b5
+	Key_sacute                      Keysym = 0x01b6
+	Key_caron                       Keysym = 0x01b7
+	Key_scaron                      Keysym = 0x01b9
+	Key_scedilla                    Keysym = 0x01ba
+	Key_tcaron                      Keysym = 0x01bb
+	Key_zacute                      Keysym = 0x01bc
+	Key_doubleacute                 Keysym = 0x01bd
+	Key_zcaron                      Keysym = 0x01be
+	Key_zabovedot                   Keysym = 0x01bf
+	Key_Racute                      Keysym = 0x01c0
+	Key_Abreve                      Keysym = 0x01c3
+	Key_Lacute                      Keysym = 0x01c5
+	Key_Cacute                      Keysym = 0x01c6
+	Key_Ccaron                      Keysym = 0x01c8
+	Key_Eogonek                     Keysym = 0x01ca
+	Key_Ecaron                      Keysym = 0x01cc
+	Key_Dcaron                      Keysym = 0x01cf
+	Key_Dstroke                     Keysym = 0x01d0
+	Key_Nacute                      Keysym = 0x01d1
+	Key_Ncaron                      Keysym = 0x01d2
+	Key_Odoubleacute                Keysym = 0x01d5
+	Key_Rcaron                      Keysym = 0x01d8
+	Key_Uring                       Keysym = 0x01d9
+	Key_Udoubleacute                Keysym = 0x01db
+	Key_Tcedilla                    Keysym = 0x01de
+	Key_racute                      Keysym = 0x01e0
+	Key_abreve                      Keysym = 0x01e3
+	Key_lacute                      Keysym = 0x01e5
+	Key_cacute                      Keysym = 0x01e6
+	Key_ccaron                      Keysym = 0x01e8
+	Key_eogonek                     Keysym = 0x01ea
+	Key_ecaron                      Keysym = 0x01ec
+	Key_dcaron                      Keysym = 0x01ef
+	Key_dstroke                     Keysym = 0x01f0
+	Key_nacute                      Keysym = 0x01f1
+	Key_ncaron                      Keysym = 0x01f2
+	Key_odoubleacute                Keysym = 0x01f5
+	Key_rcaron                      Keysym = 0x01f8
+	Key_uring                       Keysym = 0x01f9
+	Key_udoubleacute                Keysym = 0x01fb
+	Key_tcedilla                    Keysym = 0x01fe
+	Key_abovedot                    Keysym = 0x01ff
+	Key_Hstroke                     Keysym = 0x02a1
+	Key_Hcircumflex                 Keysym = 0x02a6
+	Key_Iabovedot                   Keysym = 0x02a9
+	Key_Gbreve                      Keysym = 0x02ab
+	Key_Jcircumflex                 Keysym = 0x02ac
+	Key_hstroke                     Keysym = 0x02b1
+	Key_hcircumflex                 Keysym = 0x02b6
+	Key_idotless                    Keysym = 0x02b9
+	Key_gbreve                      Keysym = 0x02bb
+	Key_jcircumflex                 Keysym = 0x02bc
+	Key_Cabovedot                   Keysym = 0x02c5
+	Key_Ccircumflex                 Keysym = 0x02c6
+	Key_Gabovedot                   Keysym = 0x02d5
+	Key_Gcircumflex                 Keysym = 0x02d8
+	Key_Ubreve                      Keysym = 0x02dd
+	Key_Scircumflex                 Keysym = 0x02de
+	Key_cabovedot                   Keysym = 0x02e5
+	Key_ccircumflex                 Keysym = 0x02e6
+	Key_gabovedot                   Keysym = 0x02f5
+	Key_gcircumflex                 Keysym = 0x02f8
+	Key_ubreve                      Keysym = 0x02fd
+	Key_scircumflex                 Keysym = 0x02fe
+	Key_kra                         Keysym = 0x03a2
+	Key_kappa                       Keysym = 0x03a2
+	Key_Rcedilla                    Keysym = 0x03a3
+	Key_Itilde                      Keysym = 0x03a5
+	Key_Lcedilla                    Keysym = 0x03a6
+	Key_Emacron                     Keysym = 0x03aa
+	Key_Gcedilla                    Keysym = 0x03ab
+	Key_Tslash                      Keysym = 0x03ac
+	Key_rcedilla                    Keysym = 0x03b3
+	Key_itilde                      Keysym = 0x03b5
+	Key_lcedilla                    Keysym = 0x03b6
+	Key_emacron                     Keysym = 0x03ba
+	Key_gcedilla                    Keysym = 0x03bb
+	Key_tslash                      Keysym = 0x03bc
+	Key_ENG                         Keysym = 0x03bd
+	Key_eng                         Keysym = 0x03bf
+	Key_Amacron                     Keysym = 0x03c0
+	Key_Iogonek                     Keysym = 0x03c7
+	Key_Eabovedot                   Keysym = 0x03cc
+	Key_Imacron                     Keysym = 0x03cf
+	Key_Ncedilla                    Keysym = 0x03d1
+	Key_Omacron                     Keysym = 0x03d2
+	Key_Kcedilla                    Keysym = 0x03d3
+	Key_Uogonek                     Keysym = 0x03d9
+	Key_Utilde                      Keysym = 0x03dd
+	Key_Umacron                     Keysym = 0x03de
+	Key_amacron                     Keysym = 0x03e0
+	Key_iogonek                     Keysym = 0x03e7
+	Key_eabovedot                   Keysym = 0x03ec
+	Key_imacron                     Keysym = 0x03ef
+	Key_ncedilla                    Keysym = 0x03f1
+	Key_omacron                     Keysym = 0x03f2
+	Key_kcedilla                    Keysym = 0x03f3
+	Key_uogonek                     Keysym = 0x03f9
+	Key_utilde                      Keysym = 0x03fd
+	Key_umacron                     Keysym = 0x03fe
+	Key_Wcircumflex                 Keysym = 0x1000174
+	Key_wcircumflex                 Keysym = 0x1000175
+	Key_Ycircumflex                 Keysym = 0x1000176
+	Key_ycircumflex                 Keysym = 0x1000177
+	Key_Babovedot                   Keysym = 0x1001e02
+	Key_babovedot                   Keysym = 0x1001e03
+	Key_Dabovedot                   Keysym = 0x1001e0a
+	Key_dabovedot                   Keysym = 0x1001e0b
+	Key_Fabovedot                   Keysym = 0x1001e1e
+	Key_fabovedot                   Keysym = 0x1001e1f
+	Key_Mabovedot                   Keysym = 0x1001e40
+	Key_mabovedot                   Keysym = 0x1001e41
+	Key_Pabovedot                   Keysym = 0x1001e56
+	Key_pabovedot                   Keysym = 0x1001e57
+	Key_Sabovedot                   Keysym = 0x1001e60
+	Key_sabovedot                   Keysym = 0x1001e61
+	Key_Tabovedot                   Keysym = 0x1001e6a
+	Key_tabovedot                   Keysym = 0x1001e6b
+	Key_Wgrave                      Keysym = 0x1001e80
+	Key_wgrave                      Keysym = 0x1001e81
+	Key_Wacute                      Keysym = 0x1001e82
+	Key_wacute                      Keysym = 0x1001e83
+	Key_Wdiaeresis                  Keysym = 0x1001e84
+	Key_wdiaeresis                  Keysym = 0x1001e85
+	Key_Ygrave                      Keysym = 0x1001ef2
+	Key_ygrave                      Keysym = 0x1001ef3
+	Key_OE                          Keysym = 0x13bc
+	Key_oe                          Keysym = 0x13bd
+	Key_Ydiaeresis                  Keysym = 0x13be
+	Key_overline                    Keysym = 0x047e
+	Key_kana_fullstop               Keysym = 0x04a1
+	Key_kana_openingbracket         Keysym = 0x04a2
+	Key_kana_closingbracket         Keysym = 0x04a3
+	Key_kana_comma                  Keysym = 0x04a4
+	Key_kana_conjunctive            Keysym = 0x04a5
+	Key_kana_middledot              Keysym = 0x04a5
+	Key_kana_WO                     Keysym = 0x04a6
+	Key_kana_a                      Keysym = 0x04a7
+	Key_kana_i                      Keysym = 0x04a8
+	Key_kana_u                      Keysym = 0x04a9
+	Key_kana_e                      Keysym = 0x04aa
+	Key_kana_o                      Keysym = 0x04ab
+	Key_kana_ya                     Keysym = 0x04ac
+	Key_kana_yu                     Keysym = 0x04ad
+	Key_kana_yo                     Keysym = 0x04ae
+	Key_kana_tsu                    Keysym = 0x04af
+	Key_kana_tu                     Keysym = 0x04af
+	Key_prolongedsound              Keysym = 0x04b0
+	Key_kana_A                      Keysym = 0x04b1
+	Key_kana_I                      Keysym = 0x04b2
+	Key_kana_U                      Keysym = 0x04b3
+	Key_kana_E                      Keysym = 0x04b4
+	Key_kana_O                      Keysym = 0x04b5
+	Key_kana_KA                     Keysym = 0x04b6
+	Key_kana_KI                     Keysym = 0x04b7
+	Key_kana_KU                     Keysym = 0x04b8
+	Key_kana_KE                     Keysym = 0x04b9
+	Key_kana_KO                     Keysym = 0x04ba
+	Key_kana_SA                     Keysym = 0x04bb
+	Key_kana_SHI                    Keysym = 0x04bc
+	Key_kana_SU                     Keysym = 0x04bd
+	Key_kana_SE                     Keysym = 0x04be
+	Key_kana_SO                     Keysym = 0x04bf
+	Key_kana_TA                     Keysym = 0x04c0
+	Key_kana_CHI                    Keysym = 0x04c1
+	Key_kana_TI                     Keysym = 0x04c1
+	Key_kana_TSU                    Keysym = 0x04c2
+	Key_kana_TU                     Keysym = 0x04c2
+	Key_kana_TE                     Keysym = 0x04c3
+	Key_kana_TO                     Keysym = 0x04c4
+	Key_kana_NA                     Keysym = 0x04c5
+	Key_kana_NI                     Keysym = 0x04c6
+	Key_kana_NU                     Keysym = 0x04c7
+	Key_kana_NE                     Keysym = 0x04c8
+	Key_kana_NO                     Keysym = 0x04c9
+	Key_kana_HA                     Keysym = 0x04ca
+	Key_kana_HI                     Keysym = 0x04cb
+	Key_kana_FU                     Keysym = 0x04cc
+	Key_kana_HU                     Keysym = 0x04cc
+	Key_kana_HE                     Keysym = 0x04cd
+	Key_kana_HO                     Keysym = 0x04ce
+	Key_kana_MA                     Keysym = 0x04cf
+	Key_kana_MI                     Keysym = 0x04d0
+	Key_kana_MU                     Keysym = 0x04d1
+	Key_kana_ME                     Keysym = 0x04d2
+	Key_kana_MO                     Keysym = 0x04d3
+	Key_kana_YA                     Keysym = 0x04d4
+	Key_kana_YU                     Keysym = 0x04d5
+	Key_kana_YO                     Keysym = 0x04d6
+	Key_kana_RA                     Keysym = 0x04d7
+	Key_kana_RI                     Keysym = 0x04d8
+	Key_kana_RU                     Keysym = 0x04d9
+	Key_kana_RE                     Keysym = 0x04da
+	Key_kana_RO                     Keysym = 0x04db
+	Key_kana_WA                     Keysym = 0x04dc
+	Key_kana_N                      Keysym = 0x04dd
+	Key_voicedsound                 Keysym = 0x04de
+	Key_semivoicedsound             Keysym = 0x04df
+	Key_kana_switch                 Keysym = 0xff7e
+	Key_Farsi_0                     Keysym = 0x10006f0
+	Key_Farsi_1                     Keysym = 0x10006f1
+	Key_Farsi_2                     Keysym = 0x10006f2
+	Key_Farsi_3                     Keysym = 0x10006f3
+	Key_Farsi_4                     Keysym = 0x10006f4
+	Key_Farsi_5                     Keysym = 0x10006f5
+	Key_Farsi_6                     Keysym = 0x10006f6
+	Key_Farsi_7                     Keysym = 0x10006f7
+	Key_Farsi_8                     Keysym = 0x10006f8
+	Key_Farsi_9                     Keysym = 0x10006f9
+	Key_Arabic_percent              Keysym = 0x100066a
+	Key_Arabic_superscript_alef     Keysym = 0x1000670
+	Key_Arabic_tteh                 Keysym = 0x1000679
+	Key_Arabic_peh                  Keysym = 0x100067e
+	Key_Arabic_tcheh                Keysym = 0x1000686
+	Key_Arabic_ddal                 Keysym = 0x1000688
+	Key_Arabic_rreh                 Keysym = 0x1000691
+	Key_Arabic_comma                Keysym = 0x05ac
+	Key_Arabic_fullstop             Keysym = 0x10006d4
+	Key_Arabic_0                    Keysym = 0x1000660
+	Key_Arabic_1                    Keysym = 0x1000661
+	Key_Arabic_2                    Keysym = 0x1000662
+	Key_Arabic_3                    Keysym = 0x1000663
+	Key_Arabic_4                    Keysym = 0x1000664
+	Key_Arabic_5                    Keysym = 0x1000665
+	Key_Arabic_6                    Keysym = 0x1000666
+	Key_Arabic_7                    Keysym = 0x1000667
+	Key_Arabic_8                    Keysym = 0x1000668
+	Key_Arabic_9                    Keysym = 0x1000669
+	Key_Arabic_semicolon            Keysym = 0x05bb
+	Key_Arabic_question_mark        Keysym = 0x05bf
+	Key_Arabic_hamza                Keysym = 0x05c1
+	Key_Arabic_maddaonalef          Keysym = 0x05c2
+	Key_Arabic_hamzaonalef          Keysym = 0x05c3
+	Key_Arabic_hamzaonwaw           Keysym = 0x05c4
+	Key_Arabic_hamzaunderalef       Keysym = 0x05c5
+	Key_Arabic_hamzaonyeh           Keysym = 0x05c6
+	Key_Arabic_alef                 Keysym = 0x05c7
+	Key_Arabic_beh                  Keysym = 0x05c8
+	Key_Arabic_tehmarbuta           Keysym = 0x05c9
+	Key_Arabic_teh                  Keysym = 0x05ca
+	Key_Arabic_theh                 Keysym = 0x05cb
+	Key_Arabic_jeem                 Keysym = 0x05cc
+	Key_Arabic_hah                  Keysym = 0x05cd
+	Key_Arabic_khah                 Keysym = 0x05ce
+	Key_Arabic_dal                  Keysym = 0x05cf
+	Key_Arabic_thal                 Keysym = 0x05d0
+	Key_Arabic_ra                   Keysym = 0x05d1
+	Key_Arabic_zain                 Keysym = 0x05d2
+	Key_Arabic_seen                 Keysym = 0x05d3
+	Key_Arabic_sheen                Keysym = 0x05d4
+	Key_Arabic_sad                  Keysym = 0x05d5
+	Key_Arabic_dad                  Keysym = 0x05d6
+	Key_Arabic_tah                  Keysym = 0x05d7
+	Key_Arabic_zah                  Keysym = 0x05d8
+	Key_Arabic_ain                  Keysym = 0x05d9
+	Key_Arabic_ghain                Keysym = 0x05da
+	Key_Arabic_tatweel              Keysym = 0x05e0
+	Key_Arabic_feh                  Keysym = 0x05e1
+	Key_Arabic_qaf                  Keysym = 0x05e2
+	Key_Arabic_kaf                  Keysym = 0x05e3
+	Key_Arabic_lam                  Keysym = 0x05e4
+	Key_Arabic_meem                 Keysym = 0x05e5
+	Key_Arabic_noon                 Keysym = 0x05e6
+	Key_Arabic_ha                   Keysym = 0x05e7
+	Key_Arabic_heh                  Keysym = 0x05e7
+	Key_Arabic_waw                  Keysym = 0x05e8
+	Key_Arabic_alefmaksura          Keysym = 0x05e9
+	Key_Arabic_yeh                  Keysym = 0x05ea
+	Key_Arabic_fathatan             Keysym = 0x05eb
+	Key_Arabic_dammatan             Keysym = 0x05ec
+	Key_Arabic_kasratan             Keysym = 0x05ed
+	Key_Arabic_fatha                Keysym = 0x05ee
+	Key_Arabic_damma                Keysym = 0x05ef
+	Key_Arabic_kasra                Keysym = 0x05f0
+	Key_Arabic_shadda               Keysym = 0x05f1
+	Key_Arabic_sukun                Keysym = 0x05f2
+	Key_Arabic_madda_above          Keysym = 0x1000653
+	Key_Arabic_hamza_above          Keysym = 0x1000654
+	Key_Arabic_hamza_below          Keysym = 0x1000655
+	Key_Arabic_jeh                  Keysym = 0x1000698
+	Key_Arabic_veh                  Keysym = 0x10006a4
+	Key_Arabic_keheh                Keysym = 0x10006a9
+	Key_Arabic_gaf                  Keysym = 0x10006af
+	Key_Arabic_noon_ghunna          Keysym = 0x10006ba
+	Key_Arabic_heh_doachashmee      Keysym = 0x10006be
+	Key_Farsi_yeh                   Keysym = 0x10006cc
+	Key_Arabic_farsi_yeh            Keysym = 0x10006cc
+	Key_Arabic_yeh_baree            Keysym = 0x10006d2
+	Key_Arabic_heh_goal             Keysym = 0x10006c1
+	Key_Arabic_switch               Keysym = 0xff7e
+	Key_Cyrillic_GHE_bar            Keysym = 0x1000492
+	Key_Cyrillic_ghe_bar            Keysym = 0x1000493
+	Key_Cyrillic_ZHE_descender      Keysym = 0x1000496
+	Key_Cyrillic_zhe_descender      Keysym = 0x1000497
+	Key_Cyrillic_KA_descender       Keysym = 0x100049a
+	Key_Cyrillic_ka_descender       Keysym = 0x100049b
+	Key_Cyrillic_KA_vertstroke      Keysym = 0x100049c
+	Key_Cyrillic_ka_vertstroke      Keysym = 0x100049d
+	Key_Cyrillic_EN_descender       Keysym = 0x10004a2
+	Key_Cyrillic_en_descender       Keysym = 0x10004a3
+	Key_Cyrillic_U_straight         Keysym = 0x10004ae
+	Key_Cyrillic_u_straight         Keysym = 0x10004af
+	Key_Cyrillic_U_straight_bar     Keysym = 0x10004b0
+	Key_Cyrillic_u_straight_bar     Keysym = 0x10004b1
+	Key_Cyrillic_HA_descender       Keysym = 0x10004b2
+	Key_Cyrillic_ha_descender       Keysym = 0x10004b3
+	Key_Cyrillic_CHE_descender      Keysym = 0x10004b6
+	Key_Cyrillic_che_descender      Keysym = 0x10004b7
+	Key_Cyrillic_CHE_vertstroke     Keysym = 0x10004b8
+	Key_Cyrillic_che_vertstroke     Keysym = 0x10004b9
+	Key_Cyrillic_SHHA               Keysym = 0x10004ba
+	Key_Cyrillic_shha               Keysym = 0x10004bb
+	Key_Cyrillic_SCHWA              Keysym = 0x10004d8
+	Key_Cyrillic_schwa              Keysym = 0x10004d9
+	Key_Cyrillic_I_macron           Keysym = 0x10004e2
+	Key_Cyrillic_i_macron           Keysym = 0x10004e3
+	Key_Cyrillic_O_bar              Keysym = 0x10004e8
+	Key_Cyrillic_o_bar              Keysym = 0x10004e9
+	Key_Cyrillic_U_macron           Keysym = 0x10004ee
+	Key_Cyrillic_u_macron           Keysym = 0x10004ef
+	Key_Serbian_dje                 Keysym = 0x06a1
+	Key_Macedonia_gje               Keysym = 0x06a2
+	Key_Cyrillic_io                 Keysym = 0x06a3
+	Key_Ukrainian_ie                Keysym = 0x06a4
+	Key_Ukranian_je                 Keysym = 0x06a4
+	Key_Macedonia_dse               Keysym = 0x06a5
+	Key_Ukrainian_i                 Keysym = 0x06a6
+	Key_Ukranian_i                  Keysym = 0x06a6
+	Key_Ukrainian_yi                Keysym = 0x06a7
+	Key_Ukranian_yi                 Keysym = 0x06a7
+	Key_Cyrillic_je                 Keysym = 0x06a8
+	Key_Serbian_je                  Keysym = 0x06a8
+	Key_Cyrillic_lje                Keysym = 0x06a9
+	Key_Serbian_lje                 Keysym = 0x06a9
+	Key_Cyrillic_nje                Keysym = 0x06aa
+	Key_Serbian_nje                 Keysym = 0x06aa
+	Key_Serbian_tshe                Keysym = 0x06ab
+	Key_Macedonia_kje               Keysym = 0x06ac
+	Key_Ukrainian_ghe_with_upturn   Keysym = 0x06ad
+	Key_Byelorussian_shortu         Keysym = 0x06ae
+	Key_Cyrillic_dzhe               Keysym = 0x06af
+	Key_Serbian_dze                 Keysym = 0x06af
+	Key_numerosign                  Keysym = 0x06b0
+	Key_Serbian_DJE                 Keysym = 0x06b1
+	Key_Macedonia_GJE               Keysym = 0x06b2
+	Key_Cyrillic_IO                 Keysym = 0x06b3
+	Key_Ukrainian_IE                Keysym = 0x06b4
+	Key_Ukranian_JE                 Keysym = 0x06b4
+	Key_Macedonia_DSE               Keysym = 0x06b5
+	Key_Ukrainian_I                 Keysym = 0x06b6
+	Key_Ukranian_I                  Keysym = 0x06b6
+	Key_Ukrainian_YI                Keysym = 0x06b7
+	Key_Ukranian_YI                 Keysym = 0x06b7
+	Key_Cyrillic_JE                 Keysym = 0x06b8
+	Key_Serbian_JE                  Keysym = 0x06b8
+	Key_Cyrillic_LJE                Keysym = 0x06b9
+	Key_Serbian_LJE                 Keysym = 0x06b9
+	Key_Cyrillic_NJE                Keysym = 0x06ba
+	Key_Serbian_NJE                 Keysym = 0x06ba
+	Key_Serbian_TSHE                Keysym = 0x06bb
+	Key_Macedonia_KJE               Keysym = 0x06bc
+	Key_Ukrainian_GHE_WITH_UPTURN   Keysym = 0x06bd
+	Key_Byelorussian_SHORTU         Keysym = 0x06be
+	Key_Cyrillic_DZHE               Keysym = 0x06bf
+	Key_Serbian_DZE                 Keysym = 0x06bf
+	Key_Cyrillic_yu                 Keysym = 0x06c0
+	Key_Cyrillic_a                  Keysym = 0x06c1
+	Key_Cyrillic_be                 Keysym = 0x06c2
+	Key_Cyrillic_tse                Keysym = 0x06c3
+	Key_Cyrillic_de                 Keysym = 0x06c4
+	Key_Cyrillic_ie                 Keysym = 0x06c5
+	Key_Cyrillic_ef                 Keysym = 0x06c6
+	Key_Cyrillic_ghe                Keysym = 0x06c7
+	Key_Cyrillic_ha                 Keysym = 0x06c8
+	Key_Cyrillic_i                  Keysym = 0x06c9
+	Key_Cyrillic_shorti             Keysym = 0x06ca
+	Key_Cyrillic_ka                 Keysym = 0x06cb
+	Key_Cyrillic_el                 Keysym = 0x06cc
+	Key_Cyrillic_em                 Keysym = 0x06cd
+	Key_Cyrillic_en                 Keysym = 0x06ce
+	Key_Cyrillic_o                  Keysym = 0x06cf
+	Key_Cyrillic_pe                 Keysym = 0x06d0
+	Key_Cyrillic_ya                 Keysym = 0x06d1
+	Key_Cyrillic_er                 Keysym = 0x06d2
+	Key_Cyrillic_es                 Keysym = 0x06d3
+	Key_Cyrillic_te                 Keysym = 0x06d4
+	Key_Cyrillic_u                  Keysym = 0x06d5
+	Key_Cyrillic_zhe                Keysym = 0x06d6
+	Key_Cyrillic_ve                 Keysym = 0x06d7
+	Key_Cyrillic_softsign           Keysym = 0x06d8
+	Key_Cyrillic_yeru               Keysym = 0x06d9
+	Key_Cyrillic_ze                 Keysym = 0x06da
+	Key_Cyrillic_sha                Keysym = 0x06db
+	Key_Cyrillic_e                  Keysym = 0x06dc
+	Key_Cyrillic_shcha              Keysym = 0x06dd
+	Key_Cyrillic_che                Keysym = 0x06de
+	Key_Cyrillic_hardsign           Keysym = 0x06df
+	Key_Cyrillic_YU                 Keysym = 0x06e0
+	Key_Cyrillic_A                  Keysym = 0x06e1
+	Key_Cyrillic_BE                 Keysym = 0x06e2
+	Key_Cyrillic_TSE                Keysym = 0x06e3
+	Key_Cyrillic_DE                 Keysym = 0x06e4
+	Key_Cyrillic_IE                 Keysym = 0x06e5
+	Key_Cyrillic_EF                 Keysym = 0x06e6
+	Key_Cyrillic_GHE                Keysym = 0x06e7
+	Key_Cyrillic_HA                 Keysym = 0x06e8
+	Key_Cyrillic_I                  Keysym = 0x06e9
+	Key_Cyrillic_SHORTI             Keysym = 0x06ea
+	Key_Cyrillic_KA                 Keysym = 0x06eb
+	Key_Cyrillic_EL                 Keysym = 0x06ec
+	Key_Cyrillic_EM                 Keysym = 0x06ed
+	Key_Cyrillic_EN                 Keysym = 0x06ee
+	Key_Cyrillic_O                  Keysym = 0x06ef
+	Key_Cyrillic_PE                 Keysym = 0x06f0
+	Key_Cyrillic_YA                 Keysym = 0x06f1
+	Key_Cyrillic_ER                 Keysym = 0x06f2
+	Key_Cyrillic_ES                 Keysym = 0x06f3
+	Key_Cyrillic_TE                 Keysym = 0x06f4
+	Key_Cyrillic_U                  Keysym = 0x06f5
+	Key_Cyrillic_ZHE                Keysym = 0x06f6
+	Key_Cyrillic_VE                 Keysym = 0x06f7
+	Key_Cyrillic_SOFTSIGN           Keysym = 0x06f8
+	Key_Cyrillic_YERU               Keysym = 0x06f9
+	Key_Cyrillic_ZE                 Keysym = 0x06fa
+	Key_Cyrillic_SHA                Keysym = 0x06fb
+	Key_Cyrillic_E                  Keysym = 0x06fc
+	Key_Cyrillic_SHCHA              Keysym = 0x06fd
+	Key_Cyrillic_CHE                Keysym = 0x06fe
+	Key_Cyrillic_HARDSIGN           Keysym = 0x06ff
+	Key_Greek_ALPHAaccent           Keysym = 0x07a1
+	Key_Greek_EPSILONaccent         Keysym = 0x07a2
+	Key_Greek_ETAaccent             Keysym = 0x07a3
+	Key_Greek_IOTAaccent            Keysym = 0x07a4
+	Key_Greek_IOTAdieresis          Keysym = 0x07a5
+	Key_Greek_IOTAdiaeresis         Keysym = 0x07a5
+	Key_Greek_OMICRONaccent         Keysym = 0x07a7
+	Key_Greek_UPSILONaccent         Keysym = 0x07a8
+	Key_Greek_UPSILONdieresis       Keysym = 0x07a9
+	Key_Greek_OMEGAaccent           Keysym = 0x07ab
+	Key_Greek_accentdieresis        Keysym = 0x07ae
+	Key_Greek_horizbar              Keysym = 0x07af
+	Key_Greek_alphaaccent           Keysym = 0x07b1
+	Key_Greek_epsilonaccent         Keysym = 0x07b2
+	Key_Greek_etaaccent             Keysym = 0x07b3
+	Key_Greek_iotaaccent            Keysym = 0x07b4
+	Key_Greek_iotadieresis          Keysym = 0x07b5
+	Key_Greek_iotaaccentdieresis    Keysym = 0x07b6
+	Key_Greek_omicronaccent         Keysym = 0x07b7
+	Key_Greek_upsilonaccent         Keysym = 0x07b8
+	Key_Greek_upsilondieresis       Keysym = 0x07b9
+	Key_Greek_upsilonaccentdieresis Keysym = 0x07ba
+	Key_Greek_omegaaccent           Keysym = 0x07bb
+	Key_Greek_ALPHA                 Keysym = 0x07c1
+	Key_Greek_BETA                  Keysym = 0x07c2
+	Key_Greek_GAMMA                 Keysym = 0x07c3
+	Key_Greek_DELTA                 Keysym = 0x07c4
+	Key_Greek_EPSILON               Keysym = 0x07c5
+	Key_Greek_ZETA                  Keysym = 0x07c6
+	Key_Greek_ETA                   Keysym = 0x07c7
+	Key_Greek_THETA                 Keysym = 0x07c8
+	Key_Greek_IOTA                  Keysym = 0x07c9
+	Key_Greek_KAPPA                 Keysym = 0x07ca
+	Key_Greek_LAMDA                 Keysym = 0x07cb
+	Key_Greek_LAMBDA                Keysym = 0x07cb
+	Key_Greek_MU                    Keysym = 0x07cc
+	Key_Greek_NU                    Keysym = 0x07cd
+	Key_Greek_XI                    Keysym = 0x07ce
+	Key_Greek_OMICRON               Keysym = 0x07cf
+	Key_Greek_PI                    Keysym = 0x07d0
+	Key_Greek_RHO                   Keysym = 0x07d1
+	Key_Greek_SIGMA                 Keysym = 0x07d2
+	Key_Greek_TAU                   Keysym = 0x07d4
+	Key_Greek_UPSILON               Keysym = 0x07d5
+	Key_Greek_PHI                   Keysym = 0x07d6
+	Key_Greek_CHI                   Keysym = 0x07d7
+	Key_Greek_PSI                   Keysym = 0x07d8
+	Key_Greek_OMEGA                 Keysym = 0x07d9
+	Key_Greek_alpha                 Keysym = 0x07e1
+	Key_Greek_beta                  Keysym = 0x07e2
+	Key_Greek_gamma                 Keysym = 0x07e3
+	Key_Greek_delta                 Keysym = 0x07e4
+	Key_Greek_epsilon               Keysym = 0x07e5
+	Key_Greek_zeta                  Keysym = 0x07e6
+	Key_Greek_eta                   Keysym = 0x07e7
+	Key_Greek_theta                 Keysym = 0x07e8
+	Key_Greek_iota                  Keysym = 0x07e9
+	Key_Greek_kappa                 Keysym = 0x07ea
+	Key_Greek_lamda                 Keysym = 0x07eb
+	Key_Greek_lambda                Keysym = 0x07eb
+	Key_Greek_mu                    Keysym = 0x07ec
+	Key_Greek_nu                    Keysym = 0x07ed
+	Key_Greek_xi                    Keysym = 0x07ee
+	Key_Greek_omicron               Keysym = 0x07ef
+	Key_Greek_pi                    Keysym = 0x07f0
+	Key_Greek_rho                   Keysym = 0x07f1
+	Key_Greek_sigma                 Keysym = 0x07f2
+	Key_Greek_finalsmallsigma       Keysym = 0x07f3
+	Key_Greek_tau                   Keysym = 0x07f4
+	Key_Greek_upsilon               Keysym = 0x07f5
+	Key_Greek_phi                   Keysym = 0x07f6
+	Key_Greek_chi                   Keysym = 0x07f7
+	Key_Greek_psi                   Keysym = 0x07f8
+	Key_Greek_omega                 Keysym = 0x07f9
+	Key_Greek_switch                Keysym = 0xff7e
+	Key_leftradical                 Keysym = 0x08a1
+	Key_topleftradical              Keysym = 0x08a2
+	Key_horizconnector              Keysym = 0x08a3
+	Key_topintegral                 Keysym = 0x08a4
+	Key_botintegral                 Keysym = 0x08a5
+	Key_vertconnector               Keysym = 0x08a6
+	Key_topleftsqbracket            Keysym = 0x08a7
+	Key_botleftsqbracket            Keysym = 0x08a8
+	Key_toprightsqbracket           Keysym = 0x08a9
+	Key_botrightsqbracket           Keysym = 0x08aa
+	Key_topleftparens               Keysym = 0x08ab
+	Key_botleftparens               Keysym = 0x08ac
+	Key_toprightparens              Keysym = 0x08ad
+	Key_botrightparens              Keysym = 0x08ae
+	Key_leftmiddlecurlybrace        Keysym = 0x08af
+	Key_rightmiddlecurlybrace       Keysym = 0x08b0
+	Key_topleftsummation            Keysym = 0x08b1
+	Key_botleftsummation            Keysym = 0x08b2
+	Key_topvertsummationconnector   Keysym = 0x08b3
+	Key_botvertsummationconnector   Keysym = 0x08b4
+	Key_toprightsummation           Keysym = 0x08b5
+	Key_botrightsummation           Keysym = 0x08b6
+	Key_rightmiddlesummation        Keysym = 0x08b7
+	Key_lessthanequal               Keysym = 0x08bc
+	Key_notequal                    Keysym = 0x08bd
+	Key_greaterthanequal            Keysym = 0x08be
+	Key_integral                    Keysym = 0x08bf
+	Key_therefore                   Keysym = 0x08c0
+	Key_variation                   Keysym = 0x08c1
+	Key_infinity                    Keysym = 0x08c2
+	Key_nabla                       Keysym = 0x08c5
+	Key_approximate                 Keysym = 0x08c8
+	Key_similarequal                Keysym = 0x08c9
+	Key_ifonlyif                    Keysym = 0x08cd
+	Key_implies                     Keysym = 0x08ce
+	Key_identical                   Keysym = 0x08cf
+	Key_radical                     Keysym = 0x08d6
+	Key_includedin                  Keysym = 0x08da
+	Key_includes                    Keysym = 0x08db
+	Key_intersection                Keysym = 0x08dc
+	Key_union                       Keysym = 0x08dd
+	Key_logicaland                  Keysym = 0x08de
+	Key_logicalor                   Keysym = 0x08df
+	Key_partialderivative           Keysym = 0x08ef
+	Key_function                    Keysym = 0x08f6
+	Key_leftarrow                   Keysym = 0x08fb
+	Key_uparrow                     Keysym = 0x08fc
+	Key_rightarrow                  Keysym = 0x08fd
+	Key_downarrow                   Keysym = 0x08fe
+	Key_blank                       Keysym = 0x09df
+	Key_soliddiamond                Keysym = 0x09e0
+	Key_checkerboard                Keysym = 0x09e1
+	Key_ht                          Keysym = 0x09e2
+	Key_ff                          Keysym = 0x09e3
+	Key_cr                          Keysym = 0x09e4
+	Key_lf                          Keysym = 0x09e5
+	Key_nl                          Keysym = 0x09e8
+	Key_vt                          Keysym = 0x09e9
+	Key_lowrightcorner              Keysym = 0x09ea
+	Key_uprightcorner               Keysym = 0x09eb
+	Key_upleftcorner                Keysym = 0x09ec
+	Key_lowleftcorner               Keysym = 0x09ed
+	Key_crossinglines               Keysym = 0x09ee
+	Key_horizlinescan1              Keysym = 0x09ef
+	Key_horizlinescan3              Keysym = 0x09f0
+	Key_horizlinescan5              Keysym = 0x09f1
+	Key_horizlinescan7              Keysym = 0x09f2
+	Key_horizlinescan9              Keysym = 0x09f3
+	Key_leftt                       Keysym = 0x09f4
+	Key_rightt                      Keysym = 0x09f5
+	Key_bott                        Keysym = 0x09f6
+	Key_topt                        Keysym = 0x09f7
+	Key_vertbar                     Keysym = 0x09f8
+	Key_emspace                     Keysym = 0x0aa1
+	Key_enspace                     Keysym = 0x0aa2
+	Key_em3space                    Keysym = 0x0aa3
+	Key_em4space                    Keysym = 0x0aa4
+	Key_digitspace                  Keysym = 0x0aa5
+	Key_punctspace                  Keysym = 0x0aa6
+	Key_thinspace                   Keysym = 0x0aa7
+	Key_hairspace                   Keysym = 0x0aa8
+	Key_emdash                      Keysym = 0x0aa9
+	Key_endash                      Keysym = 0x0aaa
+	Key_signifblank                 Keysym = 0x0aac
+	Key_ellipsis                    Keysym = 0x0aae
+	Key_doubbaselinedot             Keysym = 0x0aaf
+	Key_onethird                    Keysym = 0x0ab0
+	Key_twothirds                   Keysym = 0x0ab1
+	Key_onefifth                    Keysym = 0x0ab2
+	Key_twofifths                   Keysym = 0x0ab3
+	Key_threefifths                 Keysym = 0x0ab4
+	Key_fourfifths                  Keysym = 0x0ab5
+	Key_onesixth                    Keysym = 0x0ab6
+	Key_fivesixths                  Keysym = 0x0ab7
+	Key_careof                      Keysym = 0x0ab8
+	Key_figdash                     Keysym = 0x0abb
+	Key_leftanglebracket            Keysym = 0x0abc
+	Key_decimalpoint                Keysym = 0x0abd
+	Key_rightanglebracket           Keysym = 0x0abe
+	Key_marker                      Keysym = 0x0abf
+	Key_oneeighth                   Keysym = 0x0ac3
+	Key_threeeighths                Keysym = 0x0ac4
+	Key_fiveeighths                 Keysym = 0x0ac5
+	Key_seveneighths                Keysym = 0x0ac6
+	Key_trademark                   Keysym = 0x0ac9
+	Key_signaturemark               Keysym = 0x0aca
+	Key_trademarkincircle           Keysym = 0x0acb
+	Key_leftopentriangle            Keysym = 0x0acc
+	Key_rightopentriangle           Keysym = 0x0acd
+	Key_emopencircle                Keysym = 0x0ace
+	Key_emopenrectangle             Keysym = 0x0acf
+	Key_leftsinglequotemark         Keysym = 0x0ad0
+	Key_rightsinglequotemark        Keysym = 0x0ad1
+	Key_leftdoublequotemark         Keysym = 0x0ad2
+	Key_rightdoublequotemark        Keysym = 0x0ad3
+	Key_prescription                Keysym = 0x0ad4
+	Key_permille                    Keysym = 0x0ad5
+	Key_minutes                     Keysym = 0x0ad6
+	Key_seconds                     Keysym = 0x0ad7
+	Key_latincross                  Keysym = 0x0ad9
+	Key_hexagram                    Keysym = 0x0ada
+	Key_filledrectbullet            Keysym = 0x0adb
+	Key_filledlefttribullet         Keysym = 0x0adc
+	Key_filledrighttribullet        Keysym = 0x0add
+	Key_emfilledcircle              Keysym = 0x0ade
+	Key_emfilledrect                Keysym = 0x0adf
+	Key_enopencircbullet            Keysym = 0x0ae0
+	Key_enopensquarebullet          Keysym = 0x0ae1
+	Key_openrectbullet              Keysym = 0x0ae2
+	Key_opentribulletup             Keysym = 0x0ae3
+	Key_opentribulletdown           Keysym = 0x0ae4
+	Key_openstar                    Keysym = 0x0ae5
+	Key_enfilledcircbullet          Keysym = 0x0ae6
+	Key_enfilledsqbullet            Keysym = 0x0ae7
+	Key_filledtribulletup           Keysym = 0x0ae8
+	Key_filledtribulletdown         Keysym = 0x0ae9
+	Key_leftpointer                 Keysym = 0x0aea
+	Key_rightpointer                Keysym = 0x0aeb
+	Key_club                        Keysym = 0x0aec
+	Key_diamond                     Keysym = 0x0aed
+	Key_heart                       Keysym = 0x0aee
+	Key_maltesecross                Keysym = 0x0af0
+	Key_dagger                      Keysym = 0x0af1
+	Key_doubledagger                Keysym = 0x0af2
+	Key_checkmark                   Keysym = 0x0af3
+	Key_ballotcross                 Keysym = 0x0af4
+	Key_musicalsharp                Keysym = 0x0af5
+	Key_musicalflat                 Keysym = 0x0af6
+	Key_malesymbol                  Keysym = 0x0af7
+	Key_femalesymbol                Keysym = 0x0af8
+	Key_telephone                   Keysym = 0x0af9
+	Key_telephonerecorder           Keysym = 0x0afa
+	Key_phonographcopyright         Keysym = 0x0afb
+	Key_caret                       Keysym = 0x0afc
+	Key_singlelowquotemark          Keysym = 0x0afd
+	Key_doublelowquotemark          Keysym = 0x0afe
+	Key_cursor                      Keysym = 0x0aff
+	Key_leftcaret                   Keysym = 0x0ba3
+	Key_rightcaret                  Keysym = 0x0ba6
+	Key_downcaret                   Keysym = 0x0ba8
+	Key_upcaret                     Keysym = 0x0ba9
+	Key_overbar                     Keysym = 0x0bc0
+	Key_downtack                    Keysym = 0x0bc2
+	Key_upshoe                      Keysym = 0x0bc3
+	Key_downstile                   Keysym = 0x0bc4
+	Key_underbar                    Keysym = 0x0bc6
+	Key_jot                         Keysym = 0x0bca
+	Key_quad                        Keysym = 0x0bcc
+	Key_uptack                      Keysym = 0x0bce
+	Key_circle                      Keysym = 0x0bcf
+	Key_upstile                     Keysym = 0x0bd3
+	Key_downshoe                    Keysym = 0x0bd6
+	Key_rightshoe                   Keysym = 0x0bd8
+	Key_leftshoe                    Keysym = 0x0bda
+	Key_lefttack                    Keysym = 0x0bdc
+	Key_righttack                   Keysym = 0x0bfc
+	Key_hebrew_doublelowline        Keysym = 0x0cdf
+	Key_hebrew_aleph                Keysym = 0x0ce0
+	Key_hebrew_bet                  Keysym = 0x0ce1
+	Key_hebrew_beth                 Keysym = 0x0ce1
+	Key_hebrew_gimel                Keysym = 0x0ce2
+	Key_hebrew_gimmel               Keysym = 0x0ce2
+	Key_hebrew_dalet                Keysym = 0x0ce3
+	Key_hebrew_daleth               Keysym = 0x0ce3
+	Key_hebrew_he                   Keysym = 0x0ce4
+	Key_hebrew_waw                  Keysym = 0x0ce5
+	Key_hebrew_zain                 Keysym = 0x0ce6
+	Key_hebrew_zayin                Keysym = 0x0ce6
+	Key_hebrew_chet                 Keysym = 0x0ce7
+	Key_hebrew_het                  Keysym = 0x0ce7
+	Key_hebrew_tet                  Keysym = 0x0ce8
+	Key_hebrew_teth                 Keysym = 0x0ce8
+	Key_hebrew_yod                  Keysym = 0x0ce9
+	Key_hebrew_finalkaph            Keysym = 0x0cea
+	Key_hebrew_kaph                 Keysym = 0x0ceb
+	Key_hebrew_lamed                Keysym = 0x0cec
+	Key_hebrew_finalmem             Keysym = 0x0ced
+	Key_hebrew_mem                  Keysym = 0x0cee
+	Key_hebrew_finalnun             Keysym = 0x0cef
+	Key_hebrew_nun                  Keysym = 0x0cf0
+	Key_hebrew_samech               Keysym = 0x0cf1
+	Key_hebrew_samekh               Keysym = 0x0cf1
+	Key_hebrew_ayin                 Keysym = 0x0cf2
+	Key_hebrew_finalpe              Keysym = 0x0cf3
+	Key_hebrew_pe                   Keysym = 0x0cf4
+	Key_hebrew_finalzade            Keysym = 0x0cf5
+	Key_hebrew_finalzadi            Keysym = 0x0cf5
+	Key_hebrew_zade                 Keysym = 0x0cf6
+	Key_hebrew_zadi                 Keysym = 0x0cf6
+	Key_hebrew_qoph                 Keysym = 0x0cf7
+	Key_hebrew_kuf                  Keysym = 0x0cf7
+	Key_hebrew_resh                 Keysym = 0x0cf8
+	Key_hebrew_shin                 Keysym = 0x0cf9
+	Key_hebrew_taw                  Keysym = 0x0cfa
+	Key_hebrew_taf                  Keysym = 0x0cfa
+	Key_Hebrew_switch               Keysym = 0xff7e
+	Key_Thai_kokai                  Keysym = 0x0da1
+	Key_Thai_khokhai                Keysym = 0x0da2
+	Key_Thai_khokhuat               Keysym = 0x0da3
+	Key_Thai_khokhwai               Keysym = 0x0da4
+	Key_Thai_khokhon                Keysym = 0x0da5
+	Key_Thai_khorakhang             Keysym = 0x0da6
+	Key_Thai_ngongu                 Keysym = 0x0da7
+	Key_Thai_chochan                Keysym = 0x0da8
+	Key_Thai_choching               Keysym = 0x0da9
+	Key_Thai_chochang               Keysym = 0x0daa
+	Key_Thai_soso                   Keysym = 0x0dab
+	Key_Thai_chochoe                Keysym = 0x0dac
+	Key_Thai_yoying                 Keysym = 0x0dad
+	Key_Thai_dochada                Keysym = 0x0dae
+	Key_Thai_topatak                Keysym = 0x0daf
+	Key_Thai_thothan                Keysym = 0x0db0
+	Key_Thai_thonangmontho          Keysym = 0x0db1
+	Key_Thai_thophuthao             Keysym = 0x0db2
+	Key_Thai_nonen                  Keysym = 0x0db3
+	Key_Thai_dodek                  Keysym = 0x0db4
+	Key_Thai_totao                  Keysym = 0x0db5
+	Key_Thai_thothung               Keysym = 0x0db6
+	Key_Thai_thothahan              Keysym = 0x0db7
+	Key_Thai_thothong               Keysym = 0x0db8
+	Key_Thai_nonu                   Keysym = 0x0db9
+	Key_Thai_bobaimai               Keysym = 0x0dba
+	Key_Thai_popla                  Keysym = 0x0dbb
+	Key_Thai_phophung               Keysym = 0x0dbc
+	Key_Thai_fofa                   Keysym = 0x0dbd
+	Key_Thai_phophan                Keysym = 0x0dbe
+	Key_Thai_fofan                  Keysym = 0x0dbf
+	Key_Thai_phosamphao             Keysym = 0x0dc0
+	Key_Thai_moma                   Keysym = 0x0dc1
+	Key_Thai_yoyak                  Keysym = 0x0dc2
+	Key_Thai_rorua                  Keysym = 0x0dc3
+	Key_Thai_ru                     Keysym = 0x0dc4
+	Key_Thai_loling                 Keysym = 0x0dc5
+	Key_Thai_lu                     Keysym = 0x0dc6
+	Key_Thai_wowaen                 Keysym = 0x0dc7
+	Key_Thai_sosala                 Keysym = 0x0dc8
+	Key_Thai_sorusi                 Keysym = 0x0dc9
+	Key_Thai_sosua                  Keysym = 0x0dca
+	Key_Thai_hohip                  Keysym = 0x0dcb
+	Key_Thai_lochula                Keysym = 0x0dcc
+	Key_Thai_oang                   Keysym = 0x0dcd
+	Key_Thai_honokhuk               Keysym = 0x0dce
+	Key_Thai_paiyannoi              Keysym = 0x0dcf
+	Key_Thai_saraa                  Keysym = 0x0dd0
+	Key_Thai_maihanakat             Keysym = 0x0dd1
+	Key_Thai_saraaa                 Keysym = 0x0dd2
+	Key_Thai_saraam                 Keysym = 0x0dd3
+	Key_Thai_sarai                  Keysym = 0x0dd4
+	Key_Thai_saraii                 Keysym = 0x0dd5
+	Key_Thai_saraue                 Keysym = 0x0dd6
+	Key_Thai_sarauee                Keysym = 0x0dd7
+	Key_Thai_sarau                  Keysym = 0x0dd8
+	Key_Thai_sarauu                 Keysym = 0x0dd9
+	Key_Thai_phinthu                Keysym = 0x0dda
+	Key_Thai_maihanakat_maitho      Keysym = 0x0dde
+	Key_Thai_baht                   Keysym = 0x0ddf
+	Key_Thai_sarae                  Keysym = 0x0de0
+	Key_Thai_saraae                 Keysym = 0x0de1
+	Key_Thai_sarao                  Keysym = 0x0de2
+	Key_Thai_saraaimaimuan          Keysym = 0x0de3
+	Key_Thai_saraaimaimalai         Keysym = 0x0de4
+	Key_Thai_lakkhangyao            Keysym = 0x0de5
+	Key_Thai_maiyamok               Keysym = 0x0de6
+	Key_Thai_maitaikhu              Keysym = 0x0de7
+	Key_Thai_maiek                  Keysym = 0x0de8
+	Key_Thai_maitho                 Keysym = 0x0de9
+	Key_Thai_maitri                 Keysym = 0x0dea
+	Key_Thai_maichattawa            Keysym = 0x0deb
+	Key_Thai_thanthakhat            Keysym = 0x0dec
+	Key_Thai_nikhahit               Keysym = 0x0ded
+	Key_Thai_leksun                 Keysym = 0x0df0
+	Key_Thai_leknung                Keysym = 0x0df1
+	Key_Thai_leksong                Keysym = 0x0df2
+	Key_Thai_leksam                 Keysym = 0x0df3
+	Key_Thai_leksi                  Keysym = 0x0df4
+	Key_Thai_lekha                  Keysym = 0x0df5
+	Key_Thai_lekhok                 Keysym = 0x0df6
+	Key_Thai_lekchet                Keysym = 0x0df7
+	Key_Thai_lekpaet                Keysym = 0x0df8
+	Key_Thai_lekkao                 Keysym = 0x0df9
+	Key_Hangul                      Keysym = 0xff31
+	Key_Hangul_Start                Keysym = 0xff32
+	Key_Hangul_End                  Keysym = 0xff33
+	Key_Hangul_Hanja                Keysym = 0xff34
+	Key_Hangul_Jamo                 Keysym = 0xff35
+	Key_Hangul_Romaja               Keysym = 0xff36
+	Key_Hangul_Codeinput            Keysym = 0xff37
+	Key_Hangul_Jeonja               Keysym = 0xff38
+	Key_Hangul_Banja                Keysym = 0xff39
+	Key_Hangul_PreHanja             Keysym = 0xff3a
+	Key_Hangul_PostHanja            Keysym = 0xff3b
+	Key_Hangul_SingleCandidate      Keysym = 0xff3c
+	Key_Hangul_MultipleCandidate    Keysym = 0xff3d
+	Key_Hangul_PreviousCandidate    Keysym = 0xff3e
+	Key_Hangul_Special              Keysym = 0xff3f
+	Key_Hangul_switch               Keysym = 0xff7e
+	Key_Hangul_Kiyeog               Keysym = 0x0ea1
+	Key_Hangul_SsangKiyeog          Keysym = 0x0ea2
+	Key_Hangul_KiyeogSios           Keysym = 0x0ea3
+	Key_Hangul_Nieun                Keysym = 0x0ea4
+	Key_Hangul_NieunJieuj           Keysym = 0x0ea5
+	Key_Hangul_NieunHieuh           Keysym = 0x0ea6
+	Key_Hangul_Dikeud               Keysym = 0x0ea7
+	Key_Hangul_SsangDikeud          Keysym = 0x0ea8
+	Key_Hangul_Rieul                Keysym = 0x0ea9
+	Key_Hangul_RieulKiyeog          Keysym = 0x0eaa
+	Key_Hangul_RieulMieum           Keysym = 0x0eab
+	Key_Hangul_RieulPieub           Keysym = 0x0eac
+	Key_Hangul_RieulSios            Keysym = 0x0ead
+	Key_Hangul_RieulTieut           Keysym = 0x0eae
+	Key_Hangul_RieulPhieuf          Keysym = 0x0eaf
+	Key_Hangul_RieulHieuh           Keysym = 0x0eb0
+	Key_Hangul_Mieum                Keysym = 0x0eb1
+	Key_Hangul_Pieub                Keysym = 0x0eb2
+	Key_Hangul_SsangPieub           Keysym = 0x0eb3
+	Key_Hangul_PieubSios            Keysym = 0x0eb4
+	Key_Hangul_Sios                 Keysym = 0x0eb5
+	Key_Hangul_SsangSios            Keysym = 0x0eb6
+	Key_Hangul_Ieung                Keysym = 0x0eb7
+	Key_Hangul_Jieuj                Keysym = 0x0eb8
+	Key_Hangul_SsangJieuj           Keysym = 0x0eb9
+	Key_Hangul_Cieuc                Keysym = 0x0eba
+	Key_Hangul_Khieuq               Keysym = 0x0ebb
+	Key_Hangul_Tieut                Keysym = 0x0ebc
+	Key_Hangul_Phieuf               Keysym = 0x0ebd
+	Key_Hangul_Hieuh                Keysym = 0x0ebe
+	Key_Hangul_A                    Keysym = 0x0ebf
+	Key_Hangul_AE                   Keysym = 0x0ec0
+	Key_Hangul_YA                   Keysym = 0x0ec1
+	Key_Hangul_YAE                  Keysym = 0x0ec2
+	Key_Hangul_EO                   Keysym = 0x0ec3
+	Key_Hangul_E                    Keysym = 0x0ec4
+	Key_Hangul_YEO                  Keysym = 0x0ec5
+	Key_Hangul_YE                   Keysym = 0x0ec6
+	Key_Hangul_O                    Keysym = 0x0ec7
+	Key_Hangul_WA                   Keysym = 0x0ec8
+	Key_Hangul_WAE                  Keysym = 0x0ec9
+	Key_Hangul_OE                   Keysym = 0x0eca
+	Key_Hangul_YO                   Keysym = 0x0ecb
+	Key_Hangul_U                    Keysym = 0x0ecc
+	Key_Hangul_WEO                  Keysym = 0x0ecd
+	Key_Hangul_WE                   Keysym = 0x0ece
+	Key_Hangul_WI                   Keysym = 0x0ecf
+	Key_Hangul_YU                   Keysym = 0x0ed0
+	Key_Hangul_EU                   Keysym = 0x0ed1
+	Key_Hangul_YI                   Keysym = 0x0ed2
+	Key_Hangul_I                    Keysym = 0x0ed3
+	Key_Hangul_J_Kiyeog             Keysym = 0x0ed4
+	Key_Hangul_J_SsangKiyeog        Keysym = 0x0ed5
+	Key_Hangul_J_KiyeogSios         Keysym = 0x0ed6
+	Key_Hangul_J_Nieun              Keysym = 0x0ed7
+	Key_Hangul_J_NieunJieuj         Keysym = 0x0ed8
+	Key_Hangul_J_NieunHieuh         Keysym = 0x0ed9
+	Key_Hangul_J_Dikeud             Keysym = 0x0eda
+	Key_Hangul_J_Rieul              Keysym = 0x0edb
+	Key_Hangul_J_RieulKiyeog        Keysym = 0x0edc
+	Key_Hangul_J_RieulMieum         Keysym = 0x0edd
+	Key_Hangul_J_RieulPieub         Keysym = 0x0ede
+	Key_Hangul_J_RieulSios          Keysym = 0x0edf
+	Key_Hangul_J_RieulTieut         Keysym = 0x0ee0
+	Key_Hangul_J_RieulPhieuf        Keysym = 0x0ee1
+	Key_Hangul_J_RieulHieuh         Keysym = 0x0ee2
+	Key_Hangul_J_Mieum              Keysym = 0x0ee3
+	Key_Hangul_J_Pieub              Keysym = 0x0ee4
+	Key_Hangul_J_PieubSios          Keysym = 0x0ee5
+	Key_Hangul_J_Sios               Keysym = 0x0ee6
+	Key_Hangul_J_SsangSios          Keysym = 0x0ee7
+	Key_Hangul_J_Ieung              Keysym = 0x0ee8
+	Key_Hangul_J_Jieuj              Keysym = 0x0ee9
+	Key_Hangul_J_Cieuc              Keysym = 0x0eea
+	Key_Hangul_J_Khieuq             Keysym = 0x0eeb
+	Key_Hangul_J_Tieut              Keysym = 0x0eec
+	Key_Hangul_J_Phieuf             Keysym = 0x0eed
+	Key_Hangul_J_Hieuh              Keysym = 0x0eee
+	Key_Hangul_RieulYeorinHieuh     Keysym = 0x0eef
+	Key_Hangul_SunkyeongeumMieum    Keysym = 0x0ef0
+	Key_Hangul_SunkyeongeumPieub    Keysym = 0x0ef1
+	Key_Hangul_PanSios              Keysym = 0x0ef2
+	Key_Hangul_KkogjiDalrinIeung    Keysym = 0x0ef3
+	Key_Hangul_SunkyeongeumPhieuf   Keysym = 0x0ef4
+	Key_Hangul_YeorinHieuh          Keysym = 0x0ef5
+	Key_Hangul_AraeA                Keysym = 0x0ef6
+	Key_Hangul_AraeAE               Keysym = 0x0ef7
+	Key_Hangul_J_PanSios            Keysym = 0x0ef8
+	Key_Hangul_J_KkogjiDalrinIeung  Keysym = 0x0ef9
+	Key_Hangul_J_YeorinHieuh        Keysym = 0x0efa
+	Key_Korean_Won                  Keysym = 0x0eff
+	Key_Armenian_ligature_ew        Keysym = 0x1000587
+	Key_Armenian_full_stop          Keysym = 0x1000589
+	Key_Armenian_verjaket           Keysym = 0x1000589
+	Key_Armenian_separation_mark    Keysym = 0x100055d
+	Key_Armenian_but                Keysym = 0x100055d
+	Key_Armenian_hyphen             Keysym = 0x100058a
+	Key_Armenian_yentamna           Keysym = 0x100058a
+	Key_Armenian_exclam             Keysym = 0x100055c
+	Key_Armenian_amanak             Keysym = 0x100055c
+	Key_Armenian_accent             Keysym = 0x100055b
+	Key_Armenian_shesht             Keysym = 0x100055b
+	Key_Armenian_question           Keysym = 0x100055e
+	Key_Armenian_paruyk             Keysym = 0x100055e
+	Key_Armenian_AYB                Keysym = 0x1000531
+	Key_Armenian_ayb                Keysym = 0x1000561
+	Key_Armenian_BEN                Keysym = 0x1000532
+	Key_Armenian_ben                Keysym = 0x1000562
+	Key_Armenian_GIM                Keysym = 0x1000533
+	Key_Armenian_gim                Keysym = 0x1000563
+	Key_Armenian_DA                 Keysym = 0x1000534
+	Key_Armenian_da                 Keysym = 0x1000564
+	Key_Armenian_YECH               Keysym = 0x1000535
+	Key_Armenian_yech               Keysym = 0x1000565
+	Key_Armenian_ZA                 Keysym = 0x1000536
+	Key_Armenian_za                 Keysym = 0x1000566
+	Key_Armenian_E                  Keysym = 0x1000537
+	Key_Armenian_e                  Keysym = 0x1000567
+	Key_Armenian_AT                 Keysym = 0x1000538
+	Key_Armenian_at                 Keysym = 0x1000568
+	Key_Armenian_TO                 Keysym = 0x1000539
+	Key_Armenian_to                 Keysym = 0x1000569
+	Key_Armenian_ZHE                Keysym = 0x100053a
+	Key_Armenian_zhe                Keysym = 0x100056a
+	Key_Armenian_INI                Keysym = 0x100053b
+	Key_Armenian_ini                Keysym = 0x100056b
+	Key_Armenian_LYUN               Keysym = 0x100053c
+	Key_Armenian_lyun               Keysym = 0x100056c
+	Key_Armenian_KHE                Keysym = 0x100053d
+	Key_Armenian_khe                Keysym = 0x100056d
+	Key_Armenian_TSA                Keysym = 0x100053e
+	Key_Armenian_tsa                Keysym = 0x100056e
+	Key_Armenian_KEN                Keysym = 0x100053f
+	Key_Armenian_ken                Keysym = 0x100056f
+	Key_Armenian_HO                 Keysym = 0x1000540
+	Key_Armenian_ho                 Keysym = 0x1000570
+	Key_Armenian_DZA                Keysym = 0x1000541
+	Key_Armenian_dza                Keysym = 0x1000571
+	Key_Armenian_GHAT               Keysym = 0x1000542
+	Key_Armenian_ghat               Keysym = 0x1000572
+	Key_Armenian_TCHE               Keysym = 0x1000543
+	Key_Armenian_tche               Keysym = 0x1000573
+	Key_Armenian_MEN                Keysym = 0x1000544
+	Key_Armenian_men                Keysym = 0x1000574
+	Key_Armenian_HI                 Keysym = 0x1000545
+	Key_Armenian_hi                 Keysym = 0x1000575
+	Key_Armenian_NU                 Keysym = 0x1000546
+	Key_Armenian_nu                 Keysym = 0x1000576
+	Key_Armenian_SHA                Keysym = 0x1000547
+	Key_Armenian_sha                Keysym = 0x1000577
+	Key_Armenian_VO                 Keysym = 0x1000548
+	Key_Armenian_vo                 Keysym = 0x1000578
+	Key_Armenian_CHA                Keysym = 0x1000549
+	Key_Armenian_cha                Keysym = 0x1000579
+	Key_Armenian_PE                 Keysym = 0x100054a
+	Key_Armenian_pe                 Keysym = 0x100057a
+	Key_Armenian_JE                 Keysym = 0x100054b
+	Key_Armenian_je                 Keysym = 0x100057b
+	Key_Armenian_RA                 Keysym = 0x100054c
+	Key_Armenian_ra                 Keysym = 0x100057c
+	Key_Armenian_SE                 Keysym = 0x100054d
+	Key_Armenian_se                 Keysym = 0x100057d
+	Key_Armenian_VEV                Keysym = 0x100054e
+	Key_Armenian_vev                Keysym = 0x100057e
+	Key_Armenian_TYUN               Keysym = 0x100054f
+	Key_Armenian_tyun               Keysym = 0x100057f
+	Key_Armenian_RE                 Keysym = 0x1000550
+	Key_Armenian_re                 Keysym = 0x1000580
+	Key_Armenian_TSO                Keysym = 0x1000551
+	Key_Armenian_tso                Keysym = 0x1000581
+	Key_Armenian_VYUN               Keysym = 0x1000552
+	Key_Armenian_vyun               Keysym = 0x1000582
+	Key_Armenian_PYUR               Keysym = 0x1000553
+	Key_Armenian_pyur               Keysym = 0x1000583
+	Key_Armenian_KE                 Keysym = 0x1000554
+	Key_Armenian_ke                 Keysym = 0x1000584
+	Key_Armenian_O                  Keysym = 0x1000555
+	Key_Armenian_o                  Keysym = 0x1000585
+	Key_Armenian_FE                 Keysym = 0x1000556
+	Key_Armenian_fe                 Keysym = 0x1000586
+	Key_Armenian_apostrophe         Keysym = 0x100055a
+	Key_Georgian_an                 Keysym = 0x10010d0
+	Key_Georgian_ban                Keysym = 0x10010d1
+	Key_Georgian_gan                Keysym = 0x10010d2
+	Key_Georgian_don                Keysym = 0x10010d3
+	Key_Georgian_en                 Keysym = 0x10010d4
+	Key_Georgian_vin                Keysym = 0x10010d5
+	Key_Georgian_zen                Keysym = 0x10010d6
+	Key_Georgian_tan                Keysym = 0x10010d7
+	Key_Georgian_in                 Keysym = 0x10010d8
+	Key_Georgian_kan                Keysym = 0x10010d9
+	Key_Georgian_las                Keysym = 0x10010da
+	Key_Georgian_man                Keysym = 0x10010db
+	Key_Georgian_nar                Keysym = 0x10010dc
+	Key_Georgian_on                 Keysym = 0x10010dd
+	Key_Georgian_par                Keysym = 0x10010de
+	Key_Georgian_zhar               Keysym = 0x10010df
+	Key_Georgian_rae                Keysym = 0x10010e0
+	Key_Georgian_san                Keysym = 0x10010e1
+	Key_Georgian_tar                Keysym = 0x10010e2
+	Key_Georgian_un                 Keysym = 0x10010e3
+	Key_Georgian_phar               Keysym = 0x10010e4
+	Key_Georgian_khar               Keysym = 0x10010e5
+	Key_Georgian_ghan               Keysym = 0x10010e6
+	Key_Georgian_qar                Keysym = 0x10010e7
+	Key_Georgian_shin               Keysym = 0x10010e8
+	Key_Georgian_chin               Keysym = 0x10010e9
+	Key_Georgian_can                Keysym = 0x10010ea
+	Key_Georgian_jil                Keysym = 0x10010eb
+	Key_Georgian_cil                Keysym = 0x10010ec
+	Key_Georgian_char               Keysym = 0x10010ed
+	Key_Georgian_xan                Keysym = 0x10010ee
+	Key_Georgian_jhan               Keysym = 0x10010ef
+	Key_Georgian_hae                Keysym = 0x10010f0
+	Key_Georgian_he                 Keysym = 0x10010f1
+	Key_Georgian_hie                Keysym = 0x10010f2
+	Key_Georgian_we                 Keysym = 0x10010f3
+	Key_Georgian_har                Keysym = 0x10010f4
+	Key_Georgian_hoe                Keysym = 0x10010f5
+	Key_Georgian_fi                 Keysym = 0x10010f6
+	Key_Xabovedot                   Keysym = 0x1001e8a
+	Key_Ibreve                      Keysym = 0x100012c
+	Key_Zstroke                     Keysym = 0x10001b5
+	Key_Gcaron                      Keysym = 0x10001e6
+	Key_Ocaron                      Keysym = 0x10001d1
+	Key_Obarred                     Keysym = 0x100019f
+	Key_xabovedot                   Keysym = 0x1001e8b
+	Key_ibreve                      Keysym = 0x100012d
+	Key_zstroke                     Keysym = 0x10001b6
+	Key_gcaron                      Keysym = 0x10001e7
+	Key_ocaron                      Keysym = 0x10001d2
+	Key_obarred                     Keysym = 0x1000275
+	Key_SCHWA                       Keysym = 0x100018f
+	Key_schwa                       Keysym = 0x1000259
+	Key_EZH                         Keysym = 0x10001b7
+	Key_ezh                         Keysym = 0x1000292
+	Key_Lbelowdot                   Keysym = 0x1001e36
+	Key_lbelowdot                   Keysym = 0x1001e37
+	Key_Abelowdot                   Keysym = 0x1001ea0
+	Key_abelowdot                   Keysym = 0x1001ea1
+	Key_Ahook                       Keysym = 0x1001ea2
+	Key_ahook                       Keysym = 0x1001ea3
+	Key_Acircumflexacute            Keysym = 0x1001ea4
+	Key_acircumflexacute            Keysym = 0x1001ea5
+	Key_Acircumflexgrave            Keysym = 0x1001ea6
+	Key_acircumflexgrave            Keysym = 0x1001ea7
+	Key_Acircumflexhook             Keysym = 0x1001ea8
+	Key_acircumflexhook             Keysym = 0x1001ea9
+	Key_Acircumflextilde            Keysym = 0x1001eaa
+	Key_acircumflextilde            Keysym = 0x1001eab
+	Key_Acircumflexbelowdot         Keysym = 0x1001eac
+	Key_acircumflexbelowdot         Keysym = 0x1001ead
+	Key_Abreveacute                 Keysym = 0x1001eae
+	Key_abreveacute                 Keysym = 0x1001eaf
+	Key_Abrevegrave                 Keysym = 0x1001eb0
+	Key_abrevegrave                 Keysym = 0x1001eb1
+	Key_Abrevehook                  Keysym = 0x1001eb2
+	Key_abrevehook                  Keysym = 0x1001eb3
+	Key_Abrevetilde                 Keysym = 0x1001eb4
+	Key_abrevetilde                 Keysym = 0x1001eb5
+	Key_Abrevebelowdot              Keysym = 0x1001eb6
+	Key_abrevebelowdot              Keysym = 0x1001eb7
+	Key_Ebelowdot                   Keysym = 0x1001eb8
+	Key_ebelowdot                   Keysym = 0x1001eb9
+	Key_Ehook                       Keysym = 0x1001eba
+	Key_ehook                       Keysym = 0x1001ebb
+	Key_Etilde                      Keysym = 0x1001ebc
+	Key_etilde                      Keysym = 0x1001ebd
+	Key_Ecircumflexacute            Keysym = 0x1001ebe
+	Key_ecircumflexacute            Keysym = 0x1001ebf
+	Key_Ecircumflexgrave            Keysym = 0x1001ec0
+	Key_ecircumflexgrave            Keysym = 0x1001ec1
+	Key_Ecircumflexhook             Keysym = 0x1001ec2
+	Key_ecircumflexhook             Keysym = 0x1001ec3
+	Key_Ecircumflextilde            Keysym = 0x1001ec4
+	Key_ecircumflextilde            Keysym = 0x1001ec5
+	Key_Ecircumflexbelowdot         Keysym = 0x1001ec6
+	Key_ecircumflexbelowdot         Keysym = 0x1001ec7
+	Key_Ihook                       Keysym = 0x1001ec8
+	Key_ihook                       Keysym = 0x1001ec9
+	Key_Ibelowdot                   Keysym = 0x1001eca
+	Key_ibelowdot                   Keysym = 0x1001ecb
+	Key_Obelowdot                   Keysym = 0x1001ecc
+	Key_obelowdot                   Keysym = 0x1001ecd
+	Key_Ohook                       Keysym = 0x1001ece
+	Key_ohook                       Keysym = 0x1001ecf
+	Key_Ocircumflexacute            Keysym = 0x1001ed0
+	Key_ocircumflexacute            Keysym = 0x1001ed1
+	Key_Ocircumflexgrave            Keysym = 0x1001ed2
+	Key_ocircumflexgrave            Keysym = 0x1001ed3
+	Key_Ocircumflexhook             Keysym = 0x1001ed4
+	Key_ocircumflexhook             Keysym = 0x1001ed5
+	Key_Ocircumflextilde            Keysym = 0x1001ed6
+	Key_ocircumflextilde            Keysym = 0x1001ed7
+	Key_Ocircumflexbelowdot         Keysym = 0x1001ed8
+	Key_ocircumflexbelowdot         Keysym = 0x1001ed9
+	Key_Ohornacute                  Keysym = 0x1001eda
+	Key_ohornacute                  Keysym = 0x1001edb
+	Key_Ohorngrave                  Keysym = 0x1001edc
+	Key_ohorngrave                  Keysym = 0x1001edd
+	Key_Ohornhook                   Keysym = 0x1001ede
+	Key_ohornhook                   Keysym = 0x1001edf
+	Key_Ohorntilde                  Keysym = 0x1001ee0
+	Key_ohorntilde                  Keysym = 0x1001ee1
+	Key_Ohornbelowdot               Keysym = 0x1001ee2
+	Key_ohornbelowdot               Keysym = 0x1001ee3
+	Key_Ubelowdot                   Keysym = 0x1001ee4
+	Key_ubelowdot                   Keysym = 0x1001ee5
+	Key_Uhook                       Keysym = 0x1001ee6
+	Key_uhook                       Keysym = 0x1001ee7
+	Key_Uhornacute                  Keysym = 0x1001ee8
+	Key_uhornacute                  Keysym = 0x1001ee9
+	Key_Uhorngrave                  Keysym = 0x1001eea
+	Key_uhorngrave                  Keysym = 0x1001eeb
+	Key_Uhornhook                   Keysym = 0x1001eec
+	Key_uhornhook                   Keysym = 0x1001eed
+	Key_Uhorntilde                  Keysym = 0x1001eee
+	Key_uhorntilde                  Keysym = 0x1001eef
+	Key_Uhornbelowdot               Keysym = 0x1001ef0
+	Key_uhornbelowdot               Keysym = 0x1001ef1
+	Key_Ybelowdot                   Keysym = 0x1001ef4
+	Key_ybelowdot                   Keysym = 0x1001ef5
+	Key_Yhook                       Keysym = 0x1001ef6
+	Key_yhook                       Keysym = 0x1001ef7
+	Key_Ytilde                      Keysym = 0x1001ef8
+	Key_ytilde                      Keysym = 0x1001ef9
+	Key_Ohorn                       Keysym = 0x10001a0
+	Key_ohorn                       Keysym = 0x10001a1
+	Key_Uhorn                       Keysym = 0x10001af
+	Key_uhorn                       Keysym = 0x10001b0
+	Key_combining_tilde             Keysym = 0x1000303
+	Key_combining_grave             Keysym = 0x1000300
+	Key_combining_acute             Keysym = 0x1000301
+	Key_combining_hook              Keysym = 0x1000309
+	Key_combining_belowdot          Keysym = 0x1000323
+	Key_EcuSign                     Keysym = 0x10020a0
+	Key_ColonSign                   Keysym = 0x10020a1
+	Key_CruzeiroSign                Keysym = 0x10020a2
+	Key_FFrancSign                  Keysym = 0x10020a3
+	Key_LiraSign                    Keysym = 0x10020a4
+	Key_MillSign                    Keysym = 0x10020a5
+	Key_NairaSign                   Keysym = 0x10020a6
+	Key_PesetaSign                  Keysym = 0x10020a7
+	Key_RupeeSign                   Keysym = 0x10020a8
+	Key_WonSign                     Keysym = 0x10020a9
+	Key_NewSheqelSign               Keysym = 0x10020aa
+	Key_DongSign                    Keysym = 0x10020ab
+	Key_EuroSign                    Keysym = 0x20ac
+	Key_zerosuperior                Keysym = 0x1002070
+	Key_foursuperior                Keysym = 0x1002074
+	Key_fivesuperior                Keysym = 0x1002075
+	Key_sixsuperior                 Keysym = 0x1002076
+	Key_sevensuperior               Keysym = 0x1002077
+	Key_eightsuperior               Keysym = 0x1002078
+	Key_ninesuperior                Keysym = 0x1002079
+	Key_zerosubscript               Keysym = 0x1002080
+	Key_onesubscript                Keysym = 0x1002081
+	Key_twosubscript                Keysym = 0x1002082
+	Key_threesubscript              Keysym = 0x1002083
+	Key_foursubscript               Keysym = 0x1002084
+	Key_fivesubscript               Keysym = 0x1002085
+	Key_sixsubscript                Keysym = 0x1002086
+	Key_sevensubscript              Keysym = 0x1002087
+	Key_eightsubscript              Keysym = 0x1002088
+	Key_ninesubscript               Keysym = 0x1002089
+	Key_partdifferential            Keysym = 0x1002202
+	Key_emptyset                    Keysym = 0x1002205
+	Key_elementof                   Keysym = 0x1002208
+	Key_notelementof                Keysym = 0x1002209
+	Key_containsas                  Keysym = 0x100220B
+	Key_squareroot                  Keysym = 0x100221A
+	Key_cuberoot                    Keysym = 0x100221B
+	Key_fourthroot                  Keysym = 0x100221C
+	Key_dintegral                   Keysym = 0x100222C
+	Key_tintegral                   Keysym = 0x100222D
+	Key_because                     Keysym = 0x1002235
+	Key_approxeq                    Keysym = 0x1002248
+	Key_notapproxeq                 Keysym = 0x1002247
+	Key_notidentical                Keysym = 0x1002262
+	Key_stricteq                    Keysym = 0x1002263
+	Key_braille_dot_1               Keysym = 0xfff1
+	Key_braille_dot_2               Keysym = 0xfff2
+	Key_braille_dot_3               Keysym = 0xfff3
+	Key_braille_dot_4               Keysym = 0xfff4
+	Key_braille_dot_5               Keysym = 0xfff5
+	Key_braille_dot_6               Keysym = 0xfff6
+	Key_braille_dot_7               Keysym = 0xfff7
+	Key_braille_dot_8               Keysym = 0xfff8
+	Key_braille_dot_9               Keysym = 0xfff9
+	Key_braille_dot_10              Keysym = 0xfffa
+	Key_braille_blank               Keysym = 0x1002800
+	Key_braille_dots_1              Keysym = 0x1002801
+	Key_braille_dots_2              Keysym = 0x1002802
+	Key_braille_dots_12             Keysym = 0x1002803
+	Key_braille_dots_3              Keysym = 0x1002804
+	Key_braille_dots_13             Keysym = 0x1002805
+	Key_braille_dots_23             Keysym = 0x1002806
+	Key_braille_dots_123            Keysym = 0x1002807
+	Key_braille_dots_4              Keysym = 0x1002808
+	Key_braille_dots_14             Keysym = 0x1002809
+	Key_braille_dots_24             Keysym = 0x100280a
+	Key_braille_dots_124            Keysym = 0x100280b
+	Key_braille_dots_34             Keysym = 0x100280c
+	Key_braille_dots_134            Keysym = 0x100280d
+	Key_braille_dots_234            Keysym = 0x100280e
+	Key_braille_dots_1234           Keysym = 0x100280f
+	Key_braille_dots_5              Keysym = 0x1002810
+	Key_braille_dots_15             Keysym = 0x1002811
+	Key_braille_dots_25             Keysym = 0x1002812
+	Key_braille_dots_125            Keysym = 0x1002813
+	Key_braille_dots_35             Keysym = 0x1002814
+	Key_braille_dots_135            Keysym = 0x1002815
+	Key_braille_dots_235            Keysym = 0x1002816
+	Key_braille_dots_1235           Keysym = 0x1002817
+	Key_braille_dots_45             Keysym = 0x1002818
+	Key_braille_dots_145            Keysym = 0x1002819
+	Key_braille_dots_245            Keysym = 0x100281a
+	Key_braille_dots_1245           Keysym = 0x100281b
+	Key_braille_dots_345            Keysym = 0x100281c
+	Key_braille_dots_1345           Keysym = 0x100281d
+	Key_braille_dots_2345           Keysym = 0x100281e
+	Key_braille_dots_12345          Keysym = 0x100281f
+	Key_braille_dots_6              Keysym = 0x1002820
+	Key_braille_dots_16             Keysym = 0x1002821
+	Key_braille_dots_26             Keysym = 0x1002822
+	Key_braille_dots_126            Keysym = 0x1002823
+	Key_braille_dots_36             Keysym = 0x1002824
+	Key_braille_dots_136            Keysym = 0x1002825
+	Key_braille_dots_236            Keysym = 0x1002826
+	Key_braille_dots_1236           Keysym = 0x1002827
+	Key_braille_dots_46             Keysym = 0x1002828
+	Key_braille_dots_146            Keysym = 0x1002829
+	Key_braille_dots_246            Keysym = 0x100282a
+	Key_braille_dots_1246           Keysym = 0x100282b
+	Key_braille_dots_346            Keysym = 0x100282c
+	Key_braille_dots_1346           Keysym = 0x100282d
+	Key_braille_dots_2346           Keysym = 0x100282e
+	Key_braille_dots_12346          Keysym = 0x100282f
+	Key_braille_dots_56             Keysym = 0x1002830
+	Key_braille_dots_156            Keysym = 0x1002831
+	Key_braille_dots_256            Keysym = 0x1002832
+	Key_braille_dots_1256           Keysym = 0x1002833
+	Key_braille_dots_356            Keysym = 0x1002834
+	Key_braille_dots_1356           Keysym = 0x1002835
+	Key_braille_dots_2356           Keysym = 0x1002836
+	Key_braille_dots_12356          Keysym = 0x1002837
+	Key_braille_dots_456            Keysym = 0x1002838
+	Key_braille_dots_1456           Keysym = 0x1002839
+	Key_braille_dots_2456           Keysym = 0x100283a
+	Key_braille_dots_12456          Keysym = 0x100283b
+	Key_braille_dots_3456           Keysym = 0x100283c
+	Key_braille_dots_13456          Keysym = 0x100283d
+	Key_braille_dots_23456          Keysym = 0x100283e
+	Key_braille_dots_123456         Keysym = 0x100283f
+	Key_braille_dots_7              Keysym = 0x1002840
+	Key_braille_dots_17             Keysym = 0x1002841
+	Key_braille_dots_27             Keysym = 0x1002842
+	Key_braille_dots_127            Keysym = 0x1002843
+	Key_braille_dots_37             Keysym = 0x1002844
+	Key_braille_dots_137            Keysym = 0x1002845
+	Key_braille_dots_237            Keysym = 0x1002846
+	Key_braille_dots_1237           Keysym = 0x1002847
+	Key_braille_dots_47             Keysym = 0x1002848
+	Key_braille_dots_147            Keysym = 0x1002849
+	Key_braille_dots_247            Keysym = 0x100284a
+	Key_braille_dots_1247           Keysym = 0x100284b
+	Key_braille_dots_347            Keysym = 0x100284c
+	Key_braille_dots_1347           Keysym = 0x100284d
+	Key_braille_dots_2347           Keysym = 0x100284e
+	Key_braille_dots_12347          Keysym = 0x100284f
+	Key_braille_dots_57             Keysym = 0x1002850
+	Key_braille_dots_157            Keysym = 0x1002851
+	Key_braille_dots_257            Keysym = 0x1002852
+	Key_braille_dots_1257           Keysym = 0x1002853
+	Key_braille_dots_357            Keysym = 0x1002854
+	Key_braille_dots_1357           Keysym = 0x1002855
+	Key_braille_dots_2357           Keysym = 0x1002856
+	Key_braille_dots_12357          Keysym = 0x1002857
+	Key_braille_dots_457            Keysym = 0x1002858
+	Key_braille_dots_1457           Keysym = 0x1002859
+	Key_braille_dots_2457           Keysym = 0x100285a
+	Key_braille_dots_12457          Keysym = 0x100285b
+	Key_braille_dots_3457           Keysym = 0x100285c
+	Key_braille_dots_13457          Keysym = 0x100285d
+	Key_braille_dots_23457          Keysym = 0x100285e
+	Key_braille_dots_123457         Keysym = 0x100285f
+	Key_braille_dots_67             Keysym = 0x1002860
+	Key_braille_dots_167            Keysym = 0x1002861
+	Key_braille_dots_267            Keysym = 0x1002862
+	Key_braille_dots_1267           Keysym = 0x1002863
+	Key_braille_dots_367            Keysym = 0x1002864
+	Key_braille_dots_1367           Keysym = 0x1002865
+	Key_braille_dots_2367           Keysym = 0x1002866
+	Key_braille_dots_12367          Keysym = 0x1002867
+	Key_braille_dots_467            Keysym = 0x1002868
+	Key_braille_dots_1467           Keysym = 0x1002869
+	Key_braille_dots_2467           Keysym = 0x100286a
+	Key_braille_dots_12467          Keysym = 0x100286b
+	Key_braille_dots_3467           Keysym = 0x100286c
+	Key_braille_dots_13467          Keysym = 0x100286d
+	Key_braille_dots_23467          Keysym = 0x100286e
+	Key_braille_dots_123467         Keysym = 0x100286f
+	Key_braille_dots_567            Keysym = 0x1002870
+	Key_braille_dots_1567           Keysym = 0x1002871
+	Key_braille_dots_2567           Keysym = 0x1002872
+	Key_braille_dots_12567          Keysym = 0x1002873
+	Key_braille_dots_3567           Keysym = 0x1002874
+	Key_braille_dots_13567          Keysym = 0x1002875
+	Key_braille_dots_23567          Keysym = 0x1002876
+	Key_braille_dots_123567         Keysym = 0x1002877
+	Key_braille_dots_4567           Keysym = 0x1002878
+	Key_braille_dots_14567          Keysym = 0x1002879
+	Key_braille_dots_24567          Keysym = 0x100287a
+	Key_braille_dots_124567         Keysym = 0x100287b
+	Key_braille_dots_34567          Keysym = 0x100287c
+	Key_braille_dots_134567         Keysym = 0x100287d
+	Key_braille_dots_234567         Keysym = 0x100287e
+	Key_braille_dots_1234567        Keysym = 0x100287f
+	Key_braille_dots_8              Keysym = 0x1002880
+	Key_braille_dots_18             Keysym = 0x1002881
+	Key_braille_dots_28             Keysym = 0x1002882
+	Key_braille_dots_128            Keysym = 0x1002883
+	Key_braille_dots_38             Keysym = 0x1002884
+	Key_braille_dots_138            Keysym = 0x1002885
+	Key_braille_dots_238            Keysym = 0x1002886
+	Key_braille_dots_1238           Keysym = 0x1002887
+	Key_braille_dots_48             Keysym = 0x1002888
+	Key_braille_dots_148            Keysym = 0x1002889
+	Key_braille_dots_248            Keysym = 0x100288a
+	Key_braille_dots_1248           Keysym = 0x100288b
+	Key_braille_dots_348            Keysym = 0x100288c
+	Key_braille_dots_1348           Keysym = 0x100288d
+	Key_braille_dots_2348           Keysym = 0x100288e
+	Key_braille_dots_12348          Keysym = 0x100288f
+	Key_braille_dots_58             Keysym = 0x1002890
+	Key_braille_dots_158            Keysym = 0x1002891
+	Key_braille_dots_258            Keysym = 0x1002892
+	Key_braille_dots_1258           Keysym = 0x1002893
+	Key_braille_dots_358            Keysym = 0x1002894
+	Key_braille_dots_1358           Keysym = 0x1002895
+	Key_braille_dots_2358           Keysym = 0x1002896
+	Key_braille_dots_12358          Keysym = 0x1002897
+	Key_braille_dots_458            Keysym = 0x1002898
+	Key_braille_dots_1458           Keysym = 0x1002899
+	Key_braille_dots_2458           Keysym = 0x100289a
+	Key_braille_dots_12458          Keysym = 0x100289b
+	Key_braille_dots_3458           Keysym = 0x100289c
+	Key_braille_dots_13458          Keysym = 0x100289d
+	Key_braille_dots_23458          Keysym = 0x100289e
+	Key_braille_dots_123458         Keysym = 0x100289f
+	Key_braille_dots_68             Keysym = 0x10028a0
+	Key_braille_dots_168            Keysym = 0x10028a1
+	Key_braille_dots_268            Keysym = 0x10028a2
+	Key_braille_dots_1268           Keysym = 0x10028a3
+	Key_braille_dots_368            Keysym = 0x10028a4
+	Key_braille_dots_1368           Keysym = 0x10028a5
+	Key_braille_dots_2368           Keysym = 0x10028a6
+	Key_braille_dots_12368          Keysym = 0x10028a7
+	Key_braille_dots_468            Keysym = 0x10028a8
+	Key_braille_dots_1468           Keysym = 0x10028a9
+	Key_braille_dots_2468           Keysym = 0x10028aa
+	Key_braille_dots_12468          Keysym = 0x10028ab
+	Key_braille_dots_3468           Keysym = 0x10028ac
+	Key_braille_dots_13468          Keysym = 0x10028ad
+	Key_braille_dots_23468          Keysym = 0x10028ae
+	Key_braille_dots_123468         Keysym = 0x10028af
+	Key_braille_dots_568            Keysym = 0x10028b0
+	Key_braille_dots_1568           Keysym = 0x10028b1
+	Key_braille_dots_2568           Keysym = 0x10028b2
+	Key_braille_dots_12568          Keysym = 0x10028b3
+	Key_braille_dots_3568           Keysym = 0x10028b4
+	Key_braille_dots_13568          Keysym = 0x10028b5
+	Key_braille_dots_23568          Keysym = 0x10028b6
+	Key_braille_dots_123568         Keysym = 0x10028b7
+	Key_braille_dots_4568           Keysym = 0x10028b8
+	Key_braille_dots_14568          Keysym = 0x10028b9
+	Key_braille_dots_24568          Keysym = 0x10028ba
+	Key_braille_dots_124568         Keysym = 0x10028bb
+	Key_braille_dots_34568          Keysym = 0x10028bc
+	Key_braille_dots_134568         Keysym = 0x10028bd
+	Key_braille_dots_234568         Keysym = 0x10028be
+	Key_braille_dots_1234568        Keysym = 0x10028bf
+	Key_braille_dots_78             Keysym = 0x10028c0
+	Key_braille_dots_178            Keysym = 0x10028c1
+	Key_braille_dots_278            Keysym = 0x10028c2
+	Key_braille_dots_1278           Keysym = 0x10028c3
+	Key_braille_dots_378            Keysym = 0x10028c4
+	Key_braille_dots_1378           Keysym = 0x10028c5
+	Key_braille_dots_2378           Keysym = 0x10028c6
+	Key_braille_dots_12378          Keysym = 0x10028c7
+	Key_braille_dots_478            Keysym = 0x10028c8
+	Key_braille_dots_1478           Keysym = 0x10028c9
+	Key_braille_dots_2478           Keysym = 0x10028ca
+	Key_braille_dots_12478          Keysym = 0x10028cb
+	Key_braille_dots_3478           Keysym = 0x10028cc
+	Key_braille_dots_13478          Keysym = 0x10028cd
+	Key_braille_dots_23478          Keysym = 0x10028ce
+	Key_braille_dots_123478         Keysym = 0x10028cf
+	Key_braille_dots_578            Keysym = 0x10028d0
+	Key_braille_dots_1578           Keysym = 0x10028d1
+	Key_braille_dots_2578           Keysym = 0x10028d2
+	Key_braille_dots_12578          Keysym = 0x10028d3
+	Key_braille_dots_3578           Keysym = 0x10028d4
+	Key_braille_dots_13578          Keysym = 0x10028d5
+	Key_braille_dots_23578          Keysym = 0x10028d6
+	Key_braille_dots_123578         Keysym = 0x10028d7
+	Key_braille_dots_4578           Keysym = 0x10028d8
+	Key_braille_dots_14578          Keysym = 0x10028d9
+	Key_braille_dots_24578          Keysym = 0x10028da
+	Key_braille_dots_124578         Keysym = 0x10028db
+	Key_braille_dots_34578          Keysym = 0x10028dc
+	Key_braille_dots_134578         Keysym = 0x10028dd
+	Key_braille_dots_234578         Keysym = 0x10028de
+	Key_braille_dots_1234578        Keysym = 0x10028df
+	Key_braille_dots_678            Keysym = 0x10028e0
+	Key_braille_dots_1678           Keysym = 0x10028e1
+	Key_braille_dots_2678           Keysym = 0x10028e2
+	Key_braille_dots_12678          Keysym = 0x10028e3
+	Key_braille_dots_3678           Keysym = 0x10028e4
+	Key_braille_dots_13678          Keysym = 0x10028e5
+	Key_braille_dots_23678          Keysym = 0x10028e6
+	Key_braille_dots_123678         Keysym = 0x10028e7
+	Key_braille_dots_4678           Keysym = 0x10028e8
+	Key_braille_dots_14678          Keysym = 0x10028e9
+	Key_braille_dots_24678          Keysym = 0x10028ea
+	Key_braille_dots_124678         Keysym = 0x10028eb
+	Key_braille_dots_34678          Keysym = 0x10028ec
+	Key_braille_dots_134678         Keysym = 0x10028ed
+	Key_braille_dots_234678         Keysym = 0x10028ee
+	Key_braille_dots_1234678        Keysym = 0x10028ef
+	Key_braille_dots_5678           Keysym = 0x10028f0
+	Key_braille_dots_15678          Keysym = 0x10028f1
+	Key_braille_dots_25678          Keysym = 0x10028f2
+	Key_braille_dots_125678         Keysym = 0x10028f3
+	Key_braille_dots_35678          Keysym = 0x10028f4
+	Key_braille_dots_135678         Keysym = 0x10028f5
+	Key_braille_dots_235678         Keysym = 0x10028f6
+	Key_braille_dots_1235678        Keysym = 0x10028f7
+	Key_braille_dots_45678          Keysym = 0x10028f8
+	Key_braille_dots_145678         Keysym = 0x10028f9
+	Key_braille_dots_245678         Keysym = 0x10028fa
+	Key_braille_dots_1245678        Keysym = 0x10028fb
+	Key_braille_dots_345678         Keysym = 0x10028fc
+	Key_braille_dots_1345678        Keysym = 0x10028fd
+	Key_braille_dots_2345678        Keysym = 0x10028fe
+	Key_braille_dots_12345678       Keysym = 0x10028ff
+	Key_Sinh_ng                     Keysym = 0x1000d82
+	Key_Sinh_h2                     Keysym = 0x1000d83
+	Key_Sinh_a                      Keysym = 0x1000d85
+	Key_Sinh_aa                     Keysym = 0x1000d86
+	Key_Sinh_ae                     Keysym = 0x1000d87
+	Key_Sinh_aee                    Keysym = 0x1000d88
+	Key_Sinh_i                      Keysym = 0x1000d89
+	Key_Sinh_ii                     Keysym = 0x1000d8a
+	Key_Sinh_u                      Keysym = 0x1000d8b
+	Key_Sinh_uu                     Keysym = 0x1000d8c
+	Key_Sinh_ri                     Keysym = 0x1000d8d
+	Key_Sinh_rii                    Keysym = 0x1000d8e
+	Key_Sinh_lu                     Keysym = 0x1000d8f
+	Key_Sinh_luu                    Keysym = 0x1000d90
+	Key_Sinh_e                      Keysym = 0x1000d91
+	Key_Sinh_ee                     Keysym = 0x1000d92
+	Key_Sinh_ai                     Keysym = 0x1000d93
+	Key_Sinh_o                      Keysym = 0x1000d94
+	Key_Sinh_oo                     Keysym = 0x1000d95
+	Key_Sinh_au                     Keysym = 0x1000d96
+	Key_Sinh_ka                     Keysym = 0x1000d9a
+	Key_Sinh_kha                    Keysym = 0x1000d9b
+	Key_Sinh_ga                     Keysym = 0x1000d9c
+	Key_Sinh_gha                    Keysym = 0x1000d9d
+	Key_Sinh_ng2                    Keysym = 0x1000d9e
+	Key_Sinh_nga                    Keysym = 0x1000d9f
+	Key_Sinh_ca                     Keysym = 0x1000da0
+	Key_Sinh_cha                    Keysym = 0x1000da1
+	Key_Sinh_ja                     Keysym = 0x1000da2
+	Key_Sinh_jha                    Keysym = 0x1000da3
+	Key_Sinh_nya                    Keysym = 0x1000da4
+	Key_Sinh_jnya                   Keysym = 0x1000da5
+	Key_Sinh_nja                    Keysym = 0x1000da6
+	Key_Sinh_tta                    Keysym = 0x1000da7
+	Key_Sinh_ttha                   Keysym = 0x1000da8
+	Key_Sinh_dda                    Keysym = 0x1000da9
+	Key_Sinh_ddha                   Keysym = 0x1000daa
+	Key_Sinh_nna                    Keysym = 0x1000dab
+	Key_Sinh_ndda                   Keysym = 0x1000dac
+	Key_Sinh_tha                    Keysym = 0x1000dad
+	Key_Sinh_thha                   Keysym = 0x1000dae
+	Key_Sinh_dha                    Keysym = 0x1000daf
+	Key_Sinh_dhha                   Keysym = 0x1000db0
+	Key_Sinh_na                     Keysym = 0x1000db1
+	Key_Sinh_ndha                   Keysym = 0x1000db3
+	Key_Sinh_pa                     Keysym = 0x1000db4
+	Key_Sinh_pha                    Keysym = 0x1000db5
+	Key_Sinh_ba                     Keysym = 0x1000db6
+	Key_Sinh_bha                    Keysym = 0x1000db7
+	Key_Sinh_ma                     Keysym = 0x1000db8
+	Key_Sinh_mba                    Keysym = 0x1000db9
+	Key_Sinh_ya                     Keysym = 0x1000dba
+	Key_Sinh_ra                     Keysym = 0x1000dbb
+	Key_Sinh_la                     Keysym = 0x1000dbd
+	Key_Sinh_va                     Keysym = 0x1000dc0
+	Key_Sinh_sha                    Keysym = 0x1000dc1
+	Key_Sinh_ssha                   Keysym = 0x1000dc2
+	Key_Sinh_sa                     Keysym = 0x1000dc3
+	Key_Sinh_ha                     Keysym = 0x1000dc4
+	Key_Sinh_lla                    Keysym = 0x1000dc5
+	Key_Sinh_fa                     Keysym = 0x1000dc6
+	Key_Sinh_al                     Keysym = 0x1000dca
+	Key_Sinh_aa2                    Keysym = 0x1000dcf
+	Key_Sinh_ae2                    Keysym = 0x1000dd0
+	Key_Sinh_aee2                   Keysym = 0x1000dd1
+	Key_Sinh_i2                     Keysym = 0x1000dd2
+	Key_Sinh_ii2                    Keysym = 0x1000dd3
+	Key_Sinh_u2                     Keysym = 0x1000dd4
+	Key_Sinh_uu2                    Keysym = 0x1000dd6
+	Key_Sinh_ru2                    Keysym = 0x1000dd8
+	Key_Sinh_e2                     Keysym = 0x1000dd9
+	Key_Sinh_ee2                    Keysym = 0x1000dda
+	Key_Sinh_ai2                    Keysym = 0x1000ddb
+	Key_Sinh_o2                     Keysym = 0x1000ddc
+	Key_Sinh_oo2                    Keysym = 0x1000ddd
+	Key_Sinh_au2                    Keysym = 0x1000dde
+	Key_Sinh_lu2                    Keysym = 0x1000ddf
+	Key_Sinh_ruu2                   Keysym = 0x1000df2
+	Key_Sinh_luu2                   Keysym = 0x1000df3
+	Key_Sinh_kunddaliya             Keysym = 0x1000df4
 )
+
+var keysymDefs = []keysymDef{
+	{"VoidSymbol", Key_VoidSymbol, -1, true},
+	{"BackSpace", Key_BackSpace, -1, true},
+	{"Tab", Key_Tab, -1, true},
+	{"Linefeed", Key_Linefeed, -1, true},
+	{"Clear", Key_Clear, -1, true},
+	{"Return", Key_Return, -1, true},
+	{"Pause", Key_Pause, -1, true},
+	{"Scroll_Lock", Key_Scroll_Lock, -1, true},
+	{"Sys_Req", Key_Sys_Req, -1, true},
+	{"Escape", Key_Escape, -1, true},
+	{"Delete", Key_Delete, -1, true},
+	{"Multi_key", Key_Multi_key, -1, true},
+	{"Codeinput", Key_Codeinput, -1, true},
+	{"SingleCandidate", Key_SingleCandidate, -1, true},
+	{"MultipleCandidate", Key_MultipleCandidate, -1, true},
+	{"PreviousCandidate", Key_PreviousCandidate, -1, true},
+	{"Kanji", Key_Kanji, -1, true},
+	{"Muhenkan", Key_Muhenkan, -1, true},
+	{"Henkan_Mode", Key_Henkan_Mode, -1, true},
+	{"Henkan", Key_Henkan, -1, false},
+	{"Romaji", Key_Romaji, -1, true},
+	{"Hiragana", Key_Hiragana, -1, true},
+	{"Katakana", Key_Katakana, -1, true},
+	{"Hiragana_Katakana", Key_Hiragana_Katakana, -1, true},
+	{"Zenkaku", Key_Zenkaku, -1, true},
+	{"Hankaku", Key_Hankaku, -1, true},
+	{"Zenkaku_Hankaku", Key_Zenkaku_Hankaku, -1, true},
+	{"Touroku", Key_Touroku, -1, true},
+	{"Massyo", Key_Massyo, -1, true},
+	{"Kana_Lock", Key_Kana_Lock, -1, true},
+	{"Kana_Shift", Key_Kana_Shift, -1, true},
+	{"Eisu_Shift", Key_Eisu_Shift, -1, true},
+	{"Eisu_toggle", Key_Eisu_toggle, -1, true},
+	{"Kanji_Bangou", Key_Kanji_Bangou, -1, false},
+	{"Zen_Koho", Key_Zen_Koho, -1, false},
+	{"Mae_Koho", Key_Mae_Koho, -1, false},
+	{"Home", Key_Home, -1, true},
+	{"Left", Key_Left, -1, true},
+	{"Up", Key_Up, -1, true},
+	{"Right", Key_Right, -1, true},
+	{"Down", Key_Down, -1, true},
+	{"Prior", Key_Prior, -1, true},
+	{"Page_Up", Key_Page_Up, -1, false},
+	{"Next", Key_Next, -1, true},
+	{"Page_Down", Key_Page_Down, -1, false},
+	{"End", Key_End, -1, true},
+	{"Begin", Key_Begin, -1, true},
+	{"Select", Key_Select, -1, true},
+	{"Print", Key_Print, -1, true},
+	{"Execute", Key_Execute, -1, true},
+	{"Insert", Key_Insert, -1, true},
+	{"Undo", Key_Undo, -1, true},
+	{"Redo", Key_Redo, -1, true},
+	{"Menu", Key_Menu, -1, true},
+	{"Find", Key_Find, -1, true},
+	{"Cancel", Key_Cancel, -1, true},
+	{"Help", Key_Help, -1, true},
+	{"Break", Key_Break, -1, true},
+	{"Mode_switch", Key_Mode_switch, -1, true},
+	{"script_switch", Key_script_switch, -1, false},
+	{"Num_Lock", Key_Num_Lock, -1, true},
+	{"KP_Space", Key_KP_Space, -1, true},
+	{"KP_Tab", Key_KP_Tab, -1, true},
+	{"KP_Enter", Key_KP_Enter, -1, true},
+	{"KP_F1", Key_KP_F1, -1, true},
+	{"KP_F2", Key_KP_F2, -1, true},
+	{"KP_F3", Key_KP_F3, -1, true},
+	{"KP_F4", Key_KP_F4, -1, true},
+	{"KP_Home", Key_KP_Home, -1, true},
+	{"KP_Left", Key_KP_Left, -1, true},
+	{"KP_Up", Key_KP_Up, -1, true},
+	{"KP_Right", Key_KP_Right, -1, true},
+	{"KP_Down", Key_KP_Down, -1, true},
+	{"KP_Prior", Key_KP_Prior, -1, true},
+	{"KP_Page_Up", Key_KP_Page_Up, -1, false},
+	{"KP_Next", Key_KP_Next, -1, true},
+	{"KP_Page_Down", Key_KP_Page_Down, -1, false},
+	{"KP_End", Key_KP_End, -1, true},
+	{"KP_Begin", Key_KP_Begin, -1, true},
+	{"KP_Insert", Key_KP_Insert, -1, true},
+	{"KP_Delete", Key_KP_Delete, -1, true},
+	{"KP_Equal", Key_KP_Equal, -1, true},
+	{"KP_Multiply", Key_KP_Multiply, -1, true},
+	{"KP_Add", Key_KP_Add, -1, true},
+	{"KP_Separator", Key_KP_Separator, -1, true},
+	{"KP_Subtract", Key_KP_Subtract, -1, true},
+	{"KP_Decimal", Key_KP_Decimal, -1, true},
+	{"KP_Divide", Key_KP_Divide, -1, true},
+	{"KP_0", Key_KP_0, -1, true},
+	{"KP_1", Key_KP_1, -1, true},
+	{"KP_2", Key_KP_2, -1, true},
+	{"KP_3", Key_KP_3, -1, true},
+	{"KP_4", Key_KP_4, -1, true},
+	{"KP_5", Key_KP_5, -1, true},
+	{"KP_6", Key_KP_6, -1, true},
+	{"KP_7", Key_KP_7, -1, true},
+	{"KP_8", Key_KP_8, -1, true},
+	{"KP_9", Key_KP_9, -1, true},
+	{"F1", Key_F1, -1, true},
+	{"F2", Key_F2, -1, true},
+	{"F3", Key_F3, -1, true},
+	{"F4", Key_F4, -1, true},
+	{"F5", Key_F5, -1, true},
+	{"F6", Key_F6, -1, true},
+	{"F7", Key_F7, -1, true},
+	{"F8", Key_F8, -1, true},
+	{"F9", Key_F9, -1, true},
+	{"F10", Key_F10, -1, true},
+	{"F11", Key_F11, -1, true},
+	{"L1", Key_L1, -1, false},
+	{"F12", Key_F12, -1, true},
+	{"L2", Key_L2, -1, false},
+	{"F13", Key_F13, -1, true},
+	{"L3", Key_L3, -1, false},
+	{"F14", Key_F14, -1, true},
+	{"L4", Key_L4, -1, false},
+	{"F15", Key_F15, -1, true},
+	{"L5", Key_L5, -1, false},
+	{"F16", Key_F16, -1, true},
+	{"L6", Key_L6, -1, false},
+	{"F17", Key_F17, -1, true},
+	{"L7", Key_L7, -1, false},
+	{"F18", Key_F18, -1, true},
+	{"L8", Key_L8, -1, false},
+	{"F19", Key_F19, -1, true},
+	{"L9", Key_L9, -1, false},
+	{"F20", Key_F20, -1, true},
+	{"L10", Key_L10, -1, false},
+	{"F21", Key_F21, -1, true},
+	{"R1", Key_R1, -1, false},
+	{"F22", Key_F22, -1, true},
+	{"R2", Key_R2, -1, false},
+	{"F23", Key_F23, -1, true},
+	{"R3", Key_R3, -1, false},
+	{"F24", Key_F24, -1, true},
+	{"R4", Key_R4, -1, false},
+	{"F25", Key_F25, -1, true},
+	{"R5", Key_R5, -1, false},
+	{"F26", Key_F26, -1, true},
+	{"R6", Key_R6, -1, false},
+	{"F27", Key_F27, -1, true},
+	{"R7", Key_R7, -1, false},
+	{"F28", Key_F28, -1, true},
+	{"R8", Key_R8, -1, false},
+	{"F29", Key_F29, -1, true},
+	{"R9", Key_R9, -1, false},
+	{"F30", Key_F30, -1, true},
+	{"R10", Key_R10, -1, false},
+	{"F31", Key_F31, -1, true},
+	{"R11", Key_R11, -1, false},
+	{"F32", Key_F32, -1, true},
+	{"R12", Key_R12, -1, false},
+	{"F33", Key_F33, -1, true},
+	{"R13", Key_R13, -1, false},
+	{"F34", Key_F34, -1, true},
+	{"R14", Key_R14, -1, false},
+	{"F35", Key_F35, -1, true},
+	{"R15", Key_R15, -1, false},
+	{"Shift_L", Key_Shift_L, -1, true},
+	{"Shift_R", Key_Shift_R, -1, true},
+	{"Control_L", Key_Control_L, -1, true},
+	{"Control_R", Key_Control_R, -1, true},
+	{"Caps_Lock", Key_Caps_Lock, -1, true},
+	{"Shift_Lock", Key_Shift_Lock, -1, true},
+	{"Meta_L", Key_Meta_L, -1, true},
+	{"Meta_R", Key_Meta_R, -1, true},
+	{"Alt_L", Key_Alt_L, -1, true},
+	{"Alt_R", Key_Alt_R, -1, true},
+	{"Super_L", Key_Super_L, -1, true},
+	{"Super_R", Key_Super_R, -1, true},
+	{"Hyper_L", Key_Hyper_L, -1, true},
+	{"Hyper_R", Key_Hyper_R, -1, true},
+	{"ISO_Lock", Key_ISO_Lock, -1, true},
+	{"ISO_Level2_Latch", Key_ISO_Level2_Latch, -1, true},
+	{"ISO_Level3_Shift", Key_ISO_Level3_Shift, -1, true},
+	{"ISO_Level3_Latch", Key_ISO_Level3_Latch, -1, true},
+	{"ISO_Level3_Lock", Key_ISO_Level3_Lock, -1, true},
+	{"ISO_Level5_Shift", Key_ISO_Level5_Shift, -1, true},
+	{"ISO_Level5_Latch", Key_ISO_Level5_Latch, -1, true},
+	{"ISO_Level5_Lock", Key_ISO_Level5_Lock, -1, true},
+	{"ISO_Group_Shift", Key_ISO_Group_Shift, -1, false},
+	{"ISO_Group_Latch", Key_ISO_Group_Latch, -1, true},
+	{"ISO_Group_Lock", Key_ISO_Group_Lock, -1, true},
+	{"ISO_Next_Group", Key_ISO_Next_Group, -1, true},
+	{"ISO_Next_Group_Lock", Key_ISO_Next_Group_Lock, -1, true},
+	{"ISO_Prev_Group", Key_ISO_Prev_Group, -1, true},
+	{"ISO_Prev_Group_Lock", Key_ISO_Prev_Group_Lock, -1, true},
+	{"ISO_First_Group", Key_ISO_First_Group, -1, true},
+	{"ISO_First_Group_Lock", Key_ISO_First_Group_Lock, -1, true},
+	{"ISO_Last_Group", Key_ISO_Last_Group, -1, true},
+	{"ISO_Last_Group_Lock", Key_ISO_Last_Group_Lock, -1, true},
+	{"ISO_Left_Tab", Key_ISO_Left_Tab, -1, true},
+	{"ISO_Move_Line_Up", Key_ISO_Move_Line_Up, -1, true},
+	{"ISO_Move_Line_Down", Key_ISO_Move_Line_Down, -1, true},
+	{"ISO_Partial_Line_Up", Key_ISO_Partial_Line_Up, -1, true},
+	{"ISO_Partial_Line_Down", Key_ISO_Partial_Line_Down, -1, true},
+	{"ISO_Partial_Space_Left", Key_ISO_Partial_Space_Left, -1, true},
+	{"ISO_Partial_Space_Right", Key_ISO_Partial_Space_Right, -1, true},
+	{"ISO_Set_Margin_Left", Key_ISO_Set_Margin_Left, -1, true},
+	{"ISO_Set_Margin_Right", Key_ISO_Set_Margin_Right, -1, true},
+	{"ISO_Release_Margin_Left", Key_ISO_Release_Margin_Left, -1, true},
+	{"ISO_Release_Margin_Right", Key_ISO_Release_Margin_Right, -1, true},
+	{"ISO_Release_Both_Margins", Key_ISO_Release_Both_Margins, -1, true},
+	{"ISO_Fast_Cursor_Left", Key_ISO_Fast_Cursor_Left, -1, true},
+	{"ISO_Fast_Cursor_Right", Key_ISO_Fast_Cursor_Right, -1, true},
+	{"ISO_Fast_Cursor_Up", Key_ISO_Fast_Cursor_Up, -1, true},
+	{"ISO_Fast_Cursor_Down", Key_ISO_Fast_Cursor_Down, -1, true},
+	{"ISO_Continuous_Underline", Key_ISO_Continuous_Underline, -1, true},
+	{"ISO_Discontinuous_Underline", Key_ISO_Discontinuous_Underline, -1, true},
+	{"ISO_Emphasize", Key_ISO_Emphasize, -1, true},
+	{"ISO_Center_Object", Key_ISO_Center_Object, -1, true},
+	{"ISO_Enter", Key_ISO_Enter, -1, true},
+	{"dead_grave", Key_dead_grave, -1, true},
+	{"dead_acute", Key_dead_acute, -1, true},
+	{"dead_circumflex", Key_dead_circumflex, -1, true},
+	{"dead_tilde", Key_dead_tilde, -1, true},
+	{"dead_perispomeni", Key_dead_perispomeni, -1, false},
+	{"dead_macron", Key_dead_macron, -1, true},
+	{"dead_breve", Key_dead_breve, -1, true},
+	{"dead_abovedot", Key_dead_abovedot, -1, true},
+	{"dead_diaeresis", Key_dead_diaeresis, -1, true},
+	{"dead_abovering", Key_dead_abovering, -1, true},
+	{"dead_doubleacute", Key_dead_doubleacute, -1, true},
+	{"dead_caron", Key_dead_caron, -1, true},
+	{"dead_cedilla", Key_dead_cedilla, -1, true},
+	{"dead_ogonek", Key_dead_ogonek, -1, true},
+	{"dead_iota", Key_dead_iota, -1, true},
+	{"dead_voiced_sound", Key_dead_voiced_sound, -1, true},
+	{"dead_semivoiced_sound", Key_dead_semivoiced_sound, -1, true},
+	{"dead_belowdot", Key_dead_belowdot, -1, true},
+	{"dead_hook", Key_dead_hook, -1, true},
+	{"dead_horn", Key_dead_horn, -1, true},
+	{"dead_stroke", Key_dead_stroke, -1, true},
+	{"dead_abovecomma", Key_dead_abovecomma, -1, true},
+	{"dead_psili", Key_dead_psili, -1, false},
+	{"dead_abovereversedcomma", Key_dead_abovereversedcomma, -1, true},
+	{"dead_dasia", Key_dead_dasia, -1, false},
+	{"dead_doublegrave", Key_dead_doublegrave, -1, true},
+	{"dead_belowring", Key_dead_belowring, -1, true},
+	{"dead_belowmacron", Key_dead_belowmacron, -1, true},
+	{"dead_belowcircumflex", Key_dead_belowcircumflex, -1, true},
+	{"dead_belowtilde", Key_dead_belowtilde, -1, true},
+	{"dead_belowbreve", Key_dead_belowbreve, -1, true},
+	{"dead_belowdiaeresis", Key_dead_belowdiaeresis, -1, true},
+	{"dead_invertedbreve", Key_dead_invertedbreve, -1, true},
+	{"dead_belowcomma", Key_dead_belowcomma, -1, true},
+	{"dead_currency", Key_dead_currency, -1, true},
+	{"dead_lowline", Key_dead_lowline, -1, true},
+	{"dead_aboveverticalline", Key_dead_aboveverticalline, -1, true},
+	{"dead_belowverticalline", Key_dead_belowverticalline, -1, true},
+	{"dead_longsolidusoverlay", Key_dead_longsolidusoverlay, -1, true},
+	{"dead_a", Key_dead_a, -1, true},
+	{"dead_A", Key_dead_A, -1, true},
+	{"dead_e", Key_dead_e, -1, true},
+	{"dead_E", Key_dead_E, -1, true},
+	{"dead_i", Key_dead_i, -1, true},
+	{"dead_I", Key_dead_I, -1, true},
+	{"dead_o", Key_dead_o, -1, true},
+	{"dead_O", Key_dead_O, -1, true},
+	{"dead_u", Key_dead_u, -1, true},
+	{"dead_U", Key_dead_U, -1, true},
+	{"dead_small_schwa", Key_dead_small_schwa, -1, true},
+	{"dead_capital_schwa", Key_dead_capital_schwa, -1, true},
+	{"dead_greek", Key_dead_greek, -1, true},
+	{"First_Virtual_Screen", Key_First_Virtual_Screen, -1, true},
+	{"Prev_Virtual_Screen", Key_Prev_Virtual_Screen, -1, true},
+	{"Next_Virtual_Screen", Key_Next_Virtual_Screen, -1, true},
+	{"Last_Virtual_Screen", Key_Last_Virtual_Screen, -1, true},
+	{"Terminate_Server", Key_Terminate_Server, -1, true},
+	{"AccessX_Enable", Key_AccessX_Enable, -1, true},
+	{"AccessX_Feedback_Enable", Key_AccessX_Feedback_Enable, -1, true},
+	{"RepeatKeys_Enable", Key_RepeatKeys_Enable, -1, true},
+	{"SlowKeys_Enable", Key_SlowKeys_Enable, -1, true},
+	{"BounceKeys_Enable", Key_BounceKeys_Enable, -1, true},
+	{"StickyKeys_Enable", Key_StickyKeys_Enable, -1, true},
+	{"MouseKeys_Enable", Key_MouseKeys_Enable, -1, true},
+	{"MouseKeys_Accel_Enable", Key_MouseKeys_Accel_Enable, -1, true},
+	{"Overlay1_Enable", Key_Overlay1_Enable, -1, true},
+	{"Overlay2_Enable", Key_Overlay2_Enable, -1, true},
+	{"AudibleBell_Enable", Key_AudibleBell_Enable, -1, true},
+	{"Pointer_Left", Key_Pointer_Left, -1, true},
+	{"Pointer_Right", Key_Pointer_Right, -1, true},
+	{"Pointer_Up", Key_Pointer_Up, -1, true},
+	{"Pointer_Down", Key_Pointer_Down, -1, true},
+	{"Pointer_UpLeft", Key_Pointer_UpLeft, -1, true},
+	{"Pointer_UpRight", Key_Pointer_UpRight, -1, true},
+	{"Pointer_DownLeft", Key_Pointer_DownLeft, -1, true},
+	{"Pointer_DownRight", Key_Pointer_DownRight, -1, true},
+	{"Pointer_Button_Dflt", Key_Pointer_Button_Dflt, -1, true},
+	{"Pointer_Button1", Key_Pointer_Button1, -1, true},
+	{"Pointer_Button2", Key_Pointer_Button2, -1, true},
+	{"Pointer_Button3", Key_Pointer_Button3, -1, true},
+	{"Pointer_Button4", Key_Pointer_Button4, -1, true},
+	{"Pointer_Button5", Key_Pointer_Button5, -1, true},
+	{"Pointer_DblClick_Dflt", Key_Pointer_DblClick_Dflt, -1, true},
+	{"Pointer_DblClick1", Key_Pointer_DblClick1, -1, true},
+	{"Pointer_DblClick2", Key_Pointer_DblClick2, -1, true},
+	{"Pointer_DblClick3", Key_Pointer_DblClick3, -1, true},
+	{"Pointer_DblClick4", Key_Pointer_DblClick4, -1, true},
+	{"Pointer_DblClick5", Key_Pointer_DblClick5, -1, true},
+	{"Pointer_Drag_Dflt", Key_Pointer_Drag_Dflt, -1, true},
+	{"Pointer_Drag1", Key_Pointer_Drag1, -1, true},
+	{"Pointer_Drag2", Key_Pointer_Drag2, -1, true},
+	{"Pointer_Drag3", Key_Pointer_Drag3, -1, true},
+	{"Pointer_Drag4", Key_Pointer_Drag4, -1, true},
+	{"Pointer_Drag5", Key_Pointer_Drag5, -1, true},
+	{"Pointer_EnableKeys", Key_Pointer_EnableKeys, -1, true},
+	{"Pointer_Accelerate", Key_Pointer_Accelerate, -1, true},
+	{"Pointer_DfltBtnNext", Key_Pointer_DfltBtnNext, -1, true},
+	{"Pointer_DfltBtnPrev", Key_Pointer_DfltBtnPrev, -1, true},
+	{"ch", Key_ch, -1, true},
+	{"Ch", Key_Ch, -1, true},
+	{"CH", Key_CH, -1, true},
+	{"c_h", Key_c_h, -1, true},
+	{"C_h", Key_C_h, -1, true},
+	{"C_H", Key_C_H, -1, true},
+	{"3270_Duplicate", Key_3270_Duplicate, -1, true},
+	{"3270_FieldMark", Key_3270_FieldMark, -1, true},
+	{"3270_Right2", Key_3270_Right2, -1, true},
+	{"3270_Left2", Key_3270_Left2, -1, true},
+	{"3270_BackTab", Key_3270_BackTab, -1, true},
+	{"3270_EraseEOF", Key_3270_EraseEOF, -1, true},
+	{"3270_EraseInput", Key_3270_EraseInput, -1, true},
+	{"3270_Reset", Key_3270_Reset, -1, true},
+	{"3270_Quit", Key_3270_Quit, -1, true},
+	{"3270_PA1", Key_3270_PA1, -1, true},
+	{"3270_PA2", Key_3270_PA2, -1, true},
+	{"3270_PA3", Key_3270_PA3, -1, true},
+	{"3270_Test", Key_3270_Test, -1, true},
+	{"3270_Attn", Key_3270_Attn, -1, true},
+	{"3270_CursorBlink", Key_3270_CursorBlink, -1, true},
+	{"3270_AltCursor", Key_3270_AltCursor, -1, true},
+	{"3270_KeyClick", Key_3270_KeyClick, -1, true},
+	{"3270_Jump", Key_3270_Jump, -1, true},
+	{"3270_Ident", Key_3270_Ident, -1, true},
+	{"3270_Rule", Key_3270_Rule, -1, true},
+	{"3270_Copy", Key_3270_Copy, -1, true},
+	{"3270_Play", Key_3270_Play, -1, true},
+	{"3270_Setup", Key_3270_Setup, -1, true},
+	{"3270_Record", Key_3270_Record, -1, true},
+	{"3270_ChangeScreen", Key_3270_ChangeScreen, -1, true},
+	{"3270_DeleteWord", Key_3270_DeleteWord, -1, true},
+	{"3270_ExSelect", Key_3270_ExSelect, -1, true},
+	{"3270_CursorSelect", Key_3270_CursorSelect, -1, true},
+	{"3270_PrintScreen", Key_3270_PrintScreen, -1, true},
+	{"3270_Enter", Key_3270_Enter, -1, true},
+	{"space", Key_space, 0x0020, true},
+	{"exclam", Key_exclam, 0x0021, true},
+	{"quotedbl", Key_quotedbl, 0x0022, true},
+	{"numbersign", Key_numbersign, 0x0023, true},
+	{"dollar", Key_dollar, 0x0024, true},
+	{"percent", Key_percent, 0x0025, true},
+	{"ampersand", Key_ampersand, 0x0026, true},
+	{"apostrophe", Key_apostrophe, 0x0027, true},
+	{"quoteright", Key_quoteright, -1, false},
+	{"parenleft", Key_parenleft, 0x0028, true},
+	{"parenright", Key_parenright, 0x0029, true},
+	{"asterisk", Key_asterisk, 0x002A, true},
+	{"plus", Key_plus, 0x002B, true},
+	{"comma", Key_comma, 0x002C, true},
+	{"minus", Key_minus, 0x002D, true},
+	{"period", Key_period, 0x002E, true},
+	{"slash", Key_slash, 0x002F, true},
+	{"0", Key_0, 0x0030, true},
+	{"1", Key_1, 0x0031, true},
+	{"2", Key_2, 0x0032, true},
+	{"3", Key_3, 0x0033, true},
+	{"4", Key_4, 0x0034, true},
+	{"5", Key_5, 0x0035, true},
+	{"6", Key_6, 0x0036, true},
+	{"7", Key_7, 0x0037, true},
+	{"8", Key_8, 0x0038, true},
+	{"9", Key_9, 0x0039, true},
+	{"colon", Key_colon, 0x003A, true},
+	{"semicolon", Key_semicolon, 0x003B, true},
+	{"less", Key_less, 0x003C, true},
+	{"equal", Key_equal, 0x003D, true},
+	{"greater", Key_greater, 0x003E, true},
+	{"question", Key_question, 0x003F, true},
+	{"at", Key_at, 0x0040, true},
+	{"A", Key_A, 0x0041, true},
+	{"B", Key_B, 0x0042, true},
+	{"C", Key_C, 0x0043, true},
+	{"D", Key_D, 0x0044, true},
+	{"E", Key_E, 0x0045, true},
+	{"F", Key_F, 0x0046, true},
+	{"G", Key_G, 0x0047, true},
+	{"H", Key_H, 0x0048, true},
+	{"I", Key_I, 0x0049, true},
+	{"J", Key_J, 0x004A, true},
+	{"K", Key_K, 0x004B, true},
+	{"L", Key_L, 0x004C, true},
+	{"M", Key_M, 0x004D, true},
+	{"N", Key_N, 0x004E, true},
+	{"O", Key_O, 0x004F, true},
+	{"P", Key_P, 0x0050, true},
+	{"Q", Key_Q, 0x0051, true},
+	{"R", Key_R, 0x0052, true},
+	{"S", Key_S, 0x0053, true},
+	{"T", Key_T, 0x0054, true},
+	{"U", Key_U, 0x0055, true},
+	{"V", Key_V, 0x0056, true},
+	{"W", Key_W, 0x0057, true},
+	{"X", Key_X, 0x0058, true},
+	{"Y", Key_Y, 0x0059, true},
+	{"Z", Key_Z, 0x005A, true},
+	{"bracketleft", Key_bracketleft, 0x005B, true},
+	{"backslash", Key_backslash, 0x005C, true},
+	{"bracketright", Key_bracketright, 0x005D, true},
+	{"asciicircum", Key_asciicircum, 0x005E, true},
+	{"underscore", Key_underscore, 0x005F, true},
+	{"grave", Key_grave, 0x0060, true},
+	{"quoteleft", Key_quoteleft, -1, false},
+	{"a", Key_a, 0x0061, true},
+	{"b", Key_b, 0x0062, true},
+	{"c", Key_c, 0x0063, true},
+	{"d", Key_d, 0x0064, true},
+	{"e", Key_e, 0x0065, true},
+	{"f", Key_f, 0x0066, true},
+	{"g", Key_g, 0x0067, true},
+	{"h", Key_h, 0x0068, true},
+	{"i", Key_i, 0x0069, true},
+	{"j", Key_j, 0x006A, true},
+	{"k", Key_k, 0x006B, true},
+	{"l", Key_l, 0x006C, true},
+	{"m", Key_m, 0x006D, true},
+	{"n", Key_n, 0x006E, true},
+	{"o", Key_o, 0x006F, true},
+	{"p", Key_p, 0x0070, true},
+	{"q", Key_q, 0x0071, true},
+	{"r", Key_r, 0x0072, true},
+	{"s", Key_s, 0x0073, true},
+	{"t", Key_t, 0x0074, true},
+	{"u", Key_u, 0x0075, true},
+	{"v", Key_v, 0x0076, true},
+	{"w", Key_w, 0x0077, true},
+	{"x", Key_x, 0x0078, true},
+	{"y", Key_y, 0x0079, true},
+	{"z", Key_z, 0x007A, true},
+	{"braceleft", Key_braceleft, 0x007B, true},
+	{"bar", Key_bar, 0x007C, true},
+	{"braceright", Key_braceright, 0x007D, true},
+	{"asciitilde", Key_asciitilde, 0x007E, true},
+	{"nobreakspace", Key_nobreakspace, 0x00A0, true},
+	{"exclamdown", Key_exclamdown, 0x00A1, true},
+	{"cent", Key_cent, 0x00A2, true},
+	{"sterling", Key_sterling, 0x00A3, true},
+	{"currency", Key_currency, 0x00A4, true},
+	{"yen", Key_yen, 0x00A5, true},
+	{"brokenbar", Key_brokenbar, 0x00A6, true},
+	{"section", Key_section, 0x00A7, true},
+	{"diaeresis", Key_diaeresis, 0x00A8, true},
+	{"copyright", Key_copyright, 0x00A9, true},
+	{"ordfeminine", Key_ordfeminine, 0x00AA, true},
+	{"guillemotleft", Key_guillemotleft, 0x00AB, true},
+	{"notsign", Key_notsign, 0x00AC, true},
+	{"hyphen", Key_hyphen, 0x00AD, true},
+	{"registered", Key_registered, 0x00AE, true},
+	{"macron", Key_macron, 0x00AF, true},
+	{"degree", Key_degree, 0x00B0, true},
+	{"plusminus", Key_plusminus, 0x00B1, true},
+	{"twosuperior", Key_twosuperior, 0x00B2, true},
+	{"threesuperior", Key_threesuperior, 0x00B3, true},
+	{"acute", Key_acute, 0x00B4, true},
+	{"mu", Key_mu, 0x00B5, true},
+	{"paragraph", Key_paragraph, 0x00B6, true},
+	{"periodcentered", Key_periodcentered, 0x00B7, true},
+	{"cedilla", Key_cedilla, 0x00B8, true},
+	{"onesuperior", Key_onesuperior, 0x00B9, true},
+	{"masculine", Key_masculine, 0x00BA, true},
+	{"guillemotright", Key_guillemotright, 0x00BB, true},
+	{"onequarter", Key_onequarter, 0x00BC, true},
+	{"onehalf", Key_onehalf, 0x00BD, true},
+	{"threequarters", Key_threequarters, 0x00BE, true},
+	{"questiondown", Key_questiondown, 0x00BF, true},
+	{"Agrave", Key_Agrave, 0x00C0, true},
+	{"Aacute", Key_Aacute, 0x00C1, true},
+	{"Acircumflex", Key_Acircumflex, 0x00C2, true},
+	{"Atilde", Key_Atilde, 0x00C3, true},
+	{"Adiaeresis", Key_Adiaeresis, 0x00C4, true},
+	{"Aring", Key_Aring, 0x00C5, true},
+	{"AE", Key_AE, 0x00C6, true},
+	{"Ccedilla", Key_Ccedilla, 0x00C7, true},
+	{"Egrave", Key_Egrave, 0x00C8, true},
+	{"Eacute", Key_Eacute, 0x00C9, true},
+	{"Ecircumflex", Key_Ecircumflex, 0x00CA, true},
+	{"Ediaeresis", Key_Ediaeresis, 0x00CB, true},
+	{"Igrave", Key_Igrave, 0x00CC, true},
+	{"Iacute", Key_Iacute, 0x00CD, true},
+	{"Icircumflex", Key_Icircumflex, 0x00CE, true},
+	{"Idiaeresis", Key_Idiaeresis, 0x00CF, true},
+	{"ETH", Key_ETH, 0x00D0, true},
+	{"Eth", Key_Eth, -1, false},
+	{"Ntilde", Key_Ntilde, 0x00D1, true},
+	{"Ograve", Key_Ograve, 0x00D2, true},
+	{"Oacute", Key_Oacute, 0x00D3, true},
+	{"Ocircumflex", Key_Ocircumflex, 0x00D4, true},
+	{"Otilde", Key_Otilde, 0x00D5, true},
+	{"Odiaeresis", Key_Odiaeresis, 0x00D6, true},
+	{"multiply", Key_multiply, 0x00D7, true},
+	{"Oslash", Key_Oslash, 0x00D8, true},
+	{"Ooblique", Key_Ooblique, 0x00D8, false},
+	{"Ugrave", Key_Ugrave, 0x00D9, true},
+	{"Uacute", Key_Uacute, 0x00DA, true},
+	{"Ucircumflex", Key_Ucircumflex, 0x00DB, true},
+	{"Udiaeresis", Key_Udiaeresis, 0x00DC, true},
+	{"Yacute", Key_Yacute, 0x00DD, true},
+	{"THORN", Key_THORN, 0x00DE, true},
+	{"Thorn", Key_Thorn, -1, false},
+	{"ssharp", Key_ssharp, 0x00DF, true},
+	{"agrave", Key_agrave, 0x00E0, true},
+	{"aacute", Key_aacute, 0x00E1, true},
+	{"acircumflex", Key_acircumflex, 0x00E2, true},
+	{"atilde", Key_atilde, 0x00E3, true},
+	{"adiaeresis", Key_adiaeresis, 0x00E4, true},
+	{"aring", Key_aring, 0x00E5, true},
+	{"ae", Key_ae, 0x00E6, true},
+	{"ccedilla", Key_ccedilla, 0x00E7, true},
+	{"egrave", Key_egrave, 0x00E8, true},
+	{"eacute", Key_eacute, 0x00E9, true},
+	{"ecircumflex", Key_ecircumflex, 0x00EA, true},
+	{"ediaeresis", Key_ediaeresis, 0x00EB, true},
+	{"igrave", Key_igrave, 0x00EC, true},
+	{"iacute", Key_iacute, 0x00ED, true},
+	{"icircumflex", Key_icircumflex, 0x00EE, true},
+	{"idiaeresis", Key_idiaeresis, 0x00EF, true},
+	{"eth", Key_eth, 0x00F0, true},
+	{"ntilde", Key_ntilde, 0x00F1, true},
+	{"ograve", Key_ograve, 0x00F2, true},
+	{"oacute", Key_oacute, 0x00F3, true},
+	{"ocircumflex", Key_ocircumflex, 0x00F4, true},
+	{"otilde", Key_otilde, 0x00F5, true},
+	{"odiaeresis", Key_odiaeresis, 0x00F6, true},
+	{"division", Key_division, 0x00F7, true},
+	{"oslash", Key_oslash, 0x00F8, true},
+	{"ooblique", Key_ooblique, 0x00F8, false},
+	{"ugrave", Key_ugrave, 0x00F9, true},
+	{"uacute", Key_uacute, 0x00FA, true},
+	{"ucircumflex", Key_ucircumflex, 0x00FB, true},
+	{"udiaeresis", Key_udiaeresis, 0x00FC, true},
+	{"yacute", Key_yacute, 0x00FD, true},
+	{"thorn", Key_thorn, 0x00FE, true},
+	{"ydiaeresis", Key_ydiaeresis, 0x00FF, true},
+	{"Aogonek", Key_Aogonek, 0x0104, true},
+	{"breve", Key_breve, 0x02D8, true},
+	{"Lstroke", Key_Lstroke, 0x0141, true},
+	{"Lcaron", Key_Lcaron, 0x013D, true},
+	{"Sacute", Key_Sacute, 0x015A, true},
+	{"Scaron", Key_Scaron, 0x0160, true},
+	{"Scedilla", Key_Scedilla, 0x015E, true},
+	{"Tcaron", Key_Tcaron, 0x0164, true},
+	{"Zacute", Key_Zacute, 0x0179, true},
+	{"Zcaron", Key_Zcaron, 0x017D, true},
+	{"Zabovedot", Key_Zabovedot, 0x017B, true},
+	{"aogonek", Key_aogonek, 0x0105, true},
+	{"ogonek", Key_ogonek, 0x02DB, true},
+	{"lstroke", Key_lstroke, 0x0142, true},
+	{"lcaron", Key_lcaron, 0x013E, true},
+	{"sacute", Key_sacute, 0x015B, true},
+	{"caron", Key_caron, 0x02C7, true},
+	{"scaron", Key_scaron, 0x0161, true},
+	{"scedilla", Key_scedilla, 0x015F, true},
+	{"tcaron", Key_tcaron, 0x0165, true},
+	{"zacute", Key_zacute, 0x017A, true},
+	{"doubleacute", Key_doubleacute, 0x02DD, true},
+	{"zcaron", Key_zcaron, 0x017E, true},
+	{"zabovedot", Key_zabovedot, 0x017C, true},
+	{"Racute", Key_Racute, 0x0154, true},
+	{"Abreve", Key_Abreve, 0x0102, true},
+	{"Lacute", Key_Lacute, 0x0139, true},
+	{"Cacute", Key_Cacute, 0x0106, true},
+	{"Ccaron", Key_Ccaron, 0x010C, true},
+	{"Eogonek", Key_Eogonek, 0x0118, true},
+	{"Ecaron", Key_Ecaron, 0x011A, true},
+	{"Dcaron", Key_Dcaron, 0x010E, true},
+	{"Dstroke", Key_Dstroke, 0x0110, true},
+	{"Nacute", Key_Nacute, 0x0143, true},
+	{"Ncaron", Key_Ncaron, 0x0147, true},
+	{"Odoubleacute", Key_Odoubleacute, 0x0150, true},
+	{"Rcaron", Key_Rcaron, 0x0158, true},
+	{"Uring", Key_Uring, 0x016E, true},
+	{"Udoubleacute", Key_Udoubleacute, 0x0170, true},
+	{"Tcedilla", Key_Tcedilla, 0x0162, true},
+	{"racute", Key_racute, 0x0155, true},
+	{"abreve", Key_abreve, 0x0103, true},
+	{"lacute", Key_lacute, 0x013A, true},
+	{"cacute", Key_cacute, 0x0107, true},
+	{"ccaron", Key_ccaron, 0x010D, true},
+	{"eogonek", Key_eogonek, 0x0119, true},
+	{"ecaron", Key_ecaron, 0x011B, true},
+	{"dcaron", Key_dcaron, 0x010F, true},
+	{"dstroke", Key_dstroke, 0x0111, true},
+	{"nacute", Key_nacute, 0x0144, true},
+	{"ncaron", Key_ncaron, 0x0148, true},
+	{"odoubleacute", Key_odoubleacute, 0x0151, true},
+	{"rcaron", Key_rcaron, 0x0159, true},
+	{"uring", Key_uring, 0x016F, true},
+	{"udoubleacute", Key_udoubleacute, 0x0171, true},
+	{"tcedilla", Key_tcedilla, 0x0163, true},
+	{"abovedot", Key_abovedot, 0x02D9, true},
+	{"Hstroke", Key_Hstroke, 0x0126, true},
+	{"Hcircumflex", Key_Hcircumflex, 0x0124, true},
+	{"Iabovedot", Key_Iabovedot, 0x0130, true},
+	{"Gbreve", Key_Gbreve, 0x011E, true},
+	{"Jcircumflex", Key_Jcircumflex, 0x0134, true},
+	{"hstroke", Key_hstroke, 0x0127, true},
+	{"hcircumflex", Key_hcircumflex, 0x0125, true},
+	{"idotless", Key_idotless, 0x0131, true},
+	{"gbreve", Key_gbreve, 0x011F, true},
+	{"jcircumflex", Key_jcircumflex, 0x0135, true},
+	{"Cabovedot", Key_Cabovedot, 0x010A, true},
+	{"Ccircumflex", Key_Ccircumflex, 0x0108, true},
+	{"Gabovedot", Key_Gabovedot, 0x0120, true},
+	{"Gcircumflex", Key_Gcircumflex, 0x011C, true},
+	{"Ubreve", Key_Ubreve, 0x016C, true},
+	{"Scircumflex", Key_Scircumflex, 0x015C, true},
+	{"cabovedot", Key_cabovedot, 0x010B, true},
+	{"ccircumflex", Key_ccircumflex, 0x0109, true},
+	{"gabovedot", Key_gabovedot, 0x0121, true},
+	{"gcircumflex", Key_gcircumflex, 0x011D, true},
+	{"ubreve", Key_ubreve, 0x016D, true},
+	{"scircumflex", Key_scircumflex, 0x015D, true},
+	{"kra", Key_kra, 0x0138, true},
+	{"kappa", Key_kappa, -1, false},
+	{"Rcedilla", Key_Rcedilla, 0x0156, true},
+	{"Itilde", Key_Itilde, 0x0128, true},
+	{"Lcedilla", Key_Lcedilla, 0x013B, true},
+	{"Emacron", Key_Emacron, 0x0112, true},
+	{"Gcedilla", Key_Gcedilla, 0x0122, true},
+	{"Tslash", Key_Tslash, 0x0166, true},
+	{"rcedilla", Key_rcedilla, 0x0157, true},
+	{"itilde", Key_itilde, 0x0129, true},
+	{"lcedilla", Key_lcedilla, 0x013C, true},
+	{"emacron", Key_emacron, 0x0113, true},
+	{"gcedilla", Key_gcedilla, 0x0123, true},
+	{"tslash", Key_tslash, 0x0167, true},
+	{"ENG", Key_ENG, 0x014A, true},
+	{"eng", Key_eng, 0x014B, true},
+	{"Amacron", Key_Amacron, 0x0100, true},
+	{"Iogonek", Key_Iogonek, 0x012E, true},
+	{"Eabovedot", Key_Eabovedot, 0x0116, true},
+	{"Imacron", Key_Imacron, 0x012A, true},
+	{"Ncedilla", Key_Ncedilla, 0x0145, true},
+	{"Omacron", Key_Omacron, 0x014C, true},
+	{"Kcedilla", Key_Kcedilla, 0x0136, true},
+	{"Uogonek", Key_Uogonek, 0x0172, true},
+	{"Utilde", Key_Utilde, 0x0168, true},
+	{"Umacron", Key_Umacron, 0x016A, true},
+	{"amacron", Key_amacron, 0x0101, true},
+	{"iogonek", Key_iogonek, 0x012F, true},
+	{"eabovedot", Key_eabovedot, 0x0117, true},
+	{"imacron", Key_imacron, 0x012B, true},
+	{"ncedilla", Key_ncedilla, 0x0146, true},
+	{"omacron", Key_omacron, 0x014D, true},
+	{"kcedilla", Key_kcedilla, 0x0137, true},
+	{"uogonek", Key_uogonek, 0x0173, true},
+	{"utilde", Key_utilde, 0x0169, true},
+	{"umacron", Key_umacron, 0x016B, true},
+	{"Wcircumflex", Key_Wcircumflex, 0x0174, true},
+	{"wcircumflex", Key_wcircumflex, 0x0175, true},
+	{"Ycircumflex", Key_Ycircumflex, 0x0176, true},
+	{"ycircumflex", Key_ycircumflex, 0x0177, true},
+	{"Babovedot", Key_Babovedot, 0x1E02, true},
+	{"babovedot", Key_babovedot, 0x1E03, true},
+	{"Dabovedot", Key_Dabovedot, 0x1E0A, true},
+	{"dabovedot", Key_dabovedot, 0x1E0B, true},
+	{"Fabovedot", Key_Fabovedot, 0x1E1E, true},
+	{"fabovedot", Key_fabovedot, 0x1E1F, true},
+	{"Mabovedot", Key_Mabovedot, 0x1E40, true},
+	{"mabovedot", Key_mabovedot, 0x1E41, true},
+	{"Pabovedot", Key_Pabovedot, 0x1E56, true},
+	{"pabovedot", Key_pabovedot, 0x1E57, true},
+	{"Sabovedot", Key_Sabovedot, 0x1E60, true},
+	{"sabovedot", Key_sabovedot, 0x1E61, true},
+	{"Tabovedot", Key_Tabovedot, 0x1E6A, true},
+	{"tabovedot", Key_tabovedot, 0x1E6B, true},
+	{"Wgrave", Key_Wgrave, 0x1E80, true},
+	{"wgrave", Key_wgrave, 0x1E81, true},
+	{"Wacute", Key_Wacute, 0x1E82, true},
+	{"wacute", Key_wacute, 0x1E83, true},
+	{"Wdiaeresis", Key_Wdiaeresis, 0x1E84, true},
+	{"wdiaeresis", Key_wdiaeresis, 0x1E85, true},
+	{"Ygrave", Key_Ygrave, 0x1EF2, true},
+	{"ygrave", Key_ygrave, 0x1EF3, true},
+	{"OE", Key_OE, 0x0152, true},
+	{"oe", Key_oe, 0x0153, true},
+	{"Ydiaeresis", Key_Ydiaeresis, 0x0178, true},
+	{"overline", Key_overline, 0x203E, true},
+	{"kana_fullstop", Key_kana_fullstop, 0x3002, true},
+	{"kana_openingbracket", Key_kana_openingbracket, 0x300C, true},
+	{"kana_closingbracket", Key_kana_closingbracket, 0x300D, true},
+	{"kana_comma", Key_kana_comma, 0x3001, true},
+	{"kana_conjunctive", Key_kana_conjunctive, 0x30FB, true},
+	{"kana_middledot", Key_kana_middledot, -1, false},
+	{"kana_WO", Key_kana_WO, 0x30F2, true},
+	{"kana_a", Key_kana_a, 0x30A1, true},
+	{"kana_i", Key_kana_i, 0x30A3, true},
+	{"kana_u", Key_kana_u, 0x30A5, true},
+	{"kana_e", Key_kana_e, 0x30A7, true},
+	{"kana_o", Key_kana_o, 0x30A9, true},
+	{"kana_ya", Key_kana_ya, 0x30E3, true},
+	{"kana_yu", Key_kana_yu, 0x30E5, true},
+	{"kana_yo", Key_kana_yo, 0x30E7, true},
+	{"kana_tsu", Key_kana_tsu, 0x30C3, true},
+	{"kana_tu", Key_kana_tu, -1, false},
+	{"prolongedsound", Key_prolongedsound, 0x30FC, true},
+	{"kana_A", Key_kana_A, 0x30A2, true},
+	{"kana_I", Key_kana_I, 0x30A4, true},
+	{"kana_U", Key_kana_U, 0x30A6, true},
+	{"kana_E", Key_kana_E, 0x30A8, true},
+	{"kana_O", Key_kana_O, 0x30AA, true},
+	{"kana_KA", Key_kana_KA, 0x30AB, true},
+	{"kana_KI", Key_kana_KI, 0x30AD, true},
+	{"kana_KU", Key_kana_KU, 0x30AF, true},
+	{"kana_KE", Key_kana_KE, 0x30B1, true},
+	{"kana_KO", Key_kana_KO, 0x30B3, true},
+	{"kana_SA", Key_kana_SA, 0x30B5, true},
+	{"kana_SHI", Key_kana_SHI, 0x30B7, true},
+	{"kana_SU", Key_kana_SU, 0x30B9, true},
+	{"kana_SE", Key_kana_SE, 0x30BB, true},
+	{"kana_SO", Key_kana_SO, 0x30BD, true},
+	{"kana_TA", Key_kana_TA, 0x30BF, true},
+	{"kana_CHI", Key_kana_CHI, 0x30C1, true},
+	{"kana_TI", Key_kana_TI, -1, false},
+	{"kana_TSU", Key_kana_TSU, 0x30C4, true},
+	{"kana_TU", Key_kana_TU, -1, false},
+	{"kana_TE", Key_kana_TE, 0x30C6, true},
+	{"kana_TO", Key_kana_TO, 0x30C8, true},
+	{"kana_NA", Key_kana_NA, 0x30CA, true},
+	{"kana_NI", Key_kana_NI, 0x30CB, true},
+	{"kana_NU", Key_kana_NU, 0x30CC, true},
+	{"kana_NE", Key_kana_NE, 0x30CD, true},
+	{"kana_NO", Key_kana_NO, 0x30CE, true},
+	{"kana_HA", Key_kana_HA, 0x30CF, true},
+	{"kana_HI", Key_kana_HI, 0x30D2, true},
+	{"kana_FU", Key_kana_FU, 0x30D5, true},
+	{"kana_HU", Key_kana_HU, -1, false},
+	{"kana_HE", Key_kana_HE, 0x30D8, true},
+	{"kana_HO", Key_kana_HO, 0x30DB, true},
+	{"kana_MA", Key_kana_MA, 0x30DE, true},
+	{"kana_MI", Key_kana_MI, 0x30DF, true},
+	{"kana_MU", Key_kana_MU, 0x30E0, true},
+	{"kana_ME", Key_kana_ME, 0x30E1, true},
+	{"kana_MO", Key_kana_MO, 0x30E2, true},
+	{"kana_YA", Key_kana_YA, 0x30E4, true},
+	{"kana_YU", Key_kana_YU, 0x30E6, true},
+	{"kana_YO", Key_kana_YO, 0x30E8, true},
+	{"kana_RA", Key_kana_RA, 0x30E9, true},
+	{"kana_RI", Key_kana_RI, 0x30EA, true},
+	{"kana_RU", Key_kana_RU, 0x30EB, true},
+	{"kana_RE", Key_kana_RE, 0x30EC, true},
+	{"kana_RO", Key_kana_RO, 0x30ED, true},
+	{"kana_WA", Key_kana_WA, 0x30EF, true},
+	{"kana_N", Key_kana_N, 0x30F3, true},
+	{"voicedsound", Key_voicedsound, 0x309B, true},
+	{"semivoicedsound", Key_semivoicedsound, 0x309C, true},
+	{"kana_switch", Key_kana_switch, -1, false},
+	{"Farsi_0", Key_Farsi_0, 0x06F0, true},
+	{"Farsi_1", Key_Farsi_1, 0x06F1, true},
+	{"Farsi_2", Key_Farsi_2, 0x06F2, true},
+	{"Farsi_3", Key_Farsi_3, 0x06F3, true},
+	{"Farsi_4", Key_Farsi_4, 0x06F4, true},
+	{"Farsi_5", Key_Farsi_5, 0x06F5, true},
+	{"Farsi_6", Key_Farsi_6, 0x06F6, true},
+	{"Farsi_7", Key_Farsi_7, 0x06F7, true},
+	{"Farsi_8", Key_Farsi_8, 0x06F8, true},
+	{"Farsi_9", Key_Farsi_9, 0x06F9, true},
+	{"Arabic_percent", Key_Arabic_percent, 0x066A, true},
+	{"Arabic_superscript_alef", Key_Arabic_superscript_alef, 0x0670, true},
+	{"Arabic_tteh", Key_Arabic_tteh, 0x0679, true},
+	{"Arabic_peh", Key_Arabic_peh, 0x067E, true},
+	{"Arabic_tcheh", Key_Arabic_tcheh, 0x0686, true},
+	{"Arabic_ddal", Key_Arabic_ddal, 0x0688, true},
+	{"Arabic_rreh", Key_Arabic_rreh, 0x0691, true},
+	{"Arabic_comma", Key_Arabic_comma, 0x060C, true},
+	{"Arabic_fullstop", Key_Arabic_fullstop, 0x06D4, true},
+	{"Arabic_0", Key_Arabic_0, 0x0660, true},
+	{"Arabic_1", Key_Arabic_1, 0x0661, true},
+	{"Arabic_2", Key_Arabic_2, 0x0662, true},
+	{"Arabic_3", Key_Arabic_3, 0x0663, true},
+	{"Arabic_4", Key_Arabic_4, 0x0664, true},
+	{"Arabic_5", Key_Arabic_5, 0x0665, true},
+	{"Arabic_6", Key_Arabic_6, 0x0666, true},
+	{"Arabic_7", Key_Arabic_7, 0x0667, true},
+	{"Arabic_8", Key_Arabic_8, 0x0668, true},
+	{"Arabic_9", Key_Arabic_9, 0x0669, true},
+	{"Arabic_semicolon", Key_Arabic_semicolon, 0x061B, true},
+	{"Arabic_question_mark", Key_Arabic_question_mark, 0x061F, true},
+	{"Arabic_hamza", Key_Arabic_hamza, 0x0621, true},
+	{"Arabic_maddaonalef", Key_Arabic_maddaonalef, 0x0622, true},
+	{"Arabic_hamzaonalef", Key_Arabic_hamzaonalef, 0x0623, true},
+	{"Arabic_hamzaonwaw", Key_Arabic_hamzaonwaw, 0x0624, true},
+	{"Arabic_hamzaunderalef", Key_Arabic_hamzaunderalef, 0x0625, true},
+	{"Arabic_hamzaonyeh", Key_Arabic_hamzaonyeh, 0x0626, true},
+	{"Arabic_alef", Key_Arabic_alef, 0x0627, true},
+	{"Arabic_beh", Key_Arabic_beh, 0x0628, true},
+	{"Arabic_tehmarbuta", Key_Arabic_tehmarbuta, 0x0629, true},
+	{"Arabic_teh", Key_Arabic_teh, 0x062A, true},
+	{"Arabic_theh", Key_Arabic_theh, 0x062B, true},
+	{"Arabic_jeem", Key_Arabic_jeem, 0x062C, true},
+	{"Arabic_hah", Key_Arabic_hah, 0x062D, true},
+	{"Arabic_khah", Key_Arabic_khah, 0x062E, true},
+	{"Arabic_dal", Key_Arabic_dal, 0x062F, true},
+	{"Arabic_thal", Key_Arabic_thal, 0x0630, true},
+	{"Arabic_ra", Key_Arabic_ra, 0x0631, true},
+	{"Arabic_zain", Key_Arabic_zain, 0x0632, true},
+	{"Arabic_seen", Key_Arabic_seen, 0x0633, true},
+	{"Arabic_sheen", Key_Arabic_sheen, 0x0634, true},
+	{"Arabic_sad", Key_Arabic_sad, 0x0635, true},
+	{"Arabic_dad", Key_Arabic_dad, 0x0636, true},
+	{"Arabic_tah", Key_Arabic_tah, 0x0637, true},
+	{"Arabic_zah", Key_Arabic_zah, 0x0638, true},
+	{"Arabic_ain", Key_Arabic_ain, 0x0639, true},
+	{"Arabic_ghain", Key_Arabic_ghain, 0x063A, true},
+	{"Arabic_tatweel", Key_Arabic_tatweel, 0x0640, true},
+	{"Arabic_feh", Key_Arabic_feh, 0x0641, true},
+	{"Arabic_qaf", Key_Arabic_qaf, 0x0642, true},
+	{"Arabic_kaf", Key_Arabic_kaf, 0x0643, true},
+	{"Arabic_lam", Key_Arabic_lam, 0x0644, true},
+	{"Arabic_meem", Key_Arabic_meem, 0x0645, true},
+	{"Arabic_noon", Key_Arabic_noon, 0x0646, true},
+	{"Arabic_ha", Key_Arabic_ha, 0x0647, true},
+	{"Arabic_heh", Key_Arabic_heh, -1, false},
+	{"Arabic_waw", Key_Arabic_waw, 0x0648, true},
+	{"Arabic_alefmaksura", Key_Arabic_alefmaksura, 0x0649, true},
+	{"Arabic_yeh", Key_Arabic_yeh, 0x064A, true},
+	{"Arabic_fathatan", Key_Arabic_fathatan, 0x064B, true},
+	{"Arabic_dammatan", Key_Arabic_dammatan, 0x064C, true},
+	{"Arabic_kasratan", Key_Arabic_kasratan, 0x064D, true},
+	{"Arabic_fatha", Key_Arabic_fatha, 0x064E, true},
+	{"Arabic_damma", Key_Arabic_damma, 0x064F, true},
+	{"Arabic_kasra", Key_Arabic_kasra, 0x0650, true},
+	{"Arabic_shadda", Key_Arabic_shadda, 0x0651, true},
+	{"Arabic_sukun", Key_Arabic_sukun, 0x0652, true},
+	{"Arabic_madda_above", Key_Arabic_madda_above, 0x0653, true},
+	{"Arabic_hamza_above", Key_Arabic_hamza_above, 0x0654, true},
+	{"Arabic_hamza_below", Key_Arabic_hamza_below, 0x0655, true},
+	{"Arabic_jeh", Key_Arabic_jeh, 0x0698, true},
+	{"Arabic_veh", Key_Arabic_veh, 0x06A4, true},
+	{"Arabic_keheh", Key_Arabic_keheh, 0x06A9, true},
+	{"Arabic_gaf", Key_Arabic_gaf, 0x06AF, true},
+	{"Arabic_noon_ghunna", Key_Arabic_noon_ghunna, 0x06BA, true},
+	{"Arabic_heh_doachashmee", Key_Arabic_heh_doachashmee, 0x06BE, true},
+	{"Farsi_yeh", Key_Farsi_yeh, 0x06CC, true},
+	{"Arabic_farsi_yeh", Key_Arabic_farsi_yeh, 0x06CC, false},
+	{"Arabic_yeh_baree", Key_Arabic_yeh_baree, 0x06D2, true},
+	{"Arabic_heh_goal", Key_Arabic_heh_goal, 0x06C1, true},
+	{"Arabic_switch", Key_Arabic_switch, -1, false},
+	{"Cyrillic_GHE_bar", Key_Cyrillic_GHE_bar, 0x0492, true},
+	{"Cyrillic_ghe_bar", Key_Cyrillic_ghe_bar, 0x0493, true},
+	{"Cyrillic_ZHE_descender", Key_Cyrillic_ZHE_descender, 0x0496, true},
+	{"Cyrillic_zhe_descender", Key_Cyrillic_zhe_descender, 0x0497, true},
+	{"Cyrillic_KA_descender", Key_Cyrillic_KA_descender, 0x049A, true},
+	{"Cyrillic_ka_descender", Key_Cyrillic_ka_descender, 0x049B, true},
+	{"Cyrillic_KA_vertstroke", Key_Cyrillic_KA_vertstroke, 0x049C, true},
+	{"Cyrillic_ka_vertstroke", Key_Cyrillic_ka_vertstroke, 0x049D, true},
+	{"Cyrillic_EN_descender", Key_Cyrillic_EN_descender, 0x04A2, true},
+	{"Cyrillic_en_descender", Key_Cyrillic_en_descender, 0x04A3, true},
+	{"Cyrillic_U_straight", Key_Cyrillic_U_straight, 0x04AE, true},
+	{"Cyrillic_u_straight", Key_Cyrillic_u_straight, 0x04AF, true},
+	{"Cyrillic_U_straight_bar", Key_Cyrillic_U_straight_bar, 0x04B0, true},
+	{"Cyrillic_u_straight_bar", Key_Cyrillic_u_straight_bar, 0x04B1, true},
+	{"Cyrillic_HA_descender", Key_Cyrillic_HA_descender, 0x04B2, true},
+	{"Cyrillic_ha_descender", Key_Cyrillic_ha_descender, 0x04B3, true},
+	{"Cyrillic_CHE_descender", Key_Cyrillic_CHE_descender, 0x04B6, true},
+	{"Cyrillic_che_descender", Key_Cyrillic_che_descender, 0x04B7, true},
+	{"Cyrillic_CHE_vertstroke", Key_Cyrillic_CHE_vertstroke, 0x04B8, true},
+	{"Cyrillic_che_vertstroke", Key_Cyrillic_che_vertstroke, 0x04B9, true},
+	{"Cyrillic_SHHA", Key_Cyrillic_SHHA, 0x04BA, true},
+	{"Cyrillic_shha", Key_Cyrillic_shha, 0x04BB, true},
+	{"Cyrillic_SCHWA", Key_Cyrillic_SCHWA, 0x04D8, true},
+	{"Cyrillic_schwa", Key_Cyrillic_schwa, 0x04D9, true},
+	{"Cyrillic_I_macron", Key_Cyrillic_I_macron, 0x04E2, true},
+	{"Cyrillic_i_macron", Key_Cyrillic_i_macron, 0x04E3, true},
+	{"Cyrillic_O_bar", Key_Cyrillic_O_bar, 0x04E8, true},
+	{"Cyrillic_o_bar", Key_Cyrillic_o_bar, 0x04E9, true},
+	{"Cyrillic_U_macron", Key_Cyrillic_U_macron, 0x04EE, true},
+	{"Cyrillic_u_macron", Key_Cyrillic_u_macron, 0x04EF, true},
+	{"Serbian_dje", Key_Serbian_dje, 0x0452, true},
+	{"Macedonia_gje", Key_Macedonia_gje, 0x0453, true},
+	{"Cyrillic_io", Key_Cyrillic_io, 0x0451, true},
+	{"Ukrainian_ie", Key_Ukrainian_ie, 0x0454, true},
+	{"Ukranian_je", Key_Ukranian_je, -1, false},
+	{"Macedonia_dse", Key_Macedonia_dse, 0x0455, true},
+	{"Ukrainian_i", Key_Ukrainian_i, 0x0456, true},
+	{"Ukranian_i", Key_Ukranian_i, -1, false},
+	{"Ukrainian_yi", Key_Ukrainian_yi, 0x0457, true},
+	{"Ukranian_yi", Key_Ukranian_yi, -1, false},
+	{"Cyrillic_je", Key_Cyrillic_je, 0x0458, true},
+	{"Serbian_je", Key_Serbian_je, -1, false},
+	{"Cyrillic_lje", Key_Cyrillic_lje, 0x0459, true},
+	{"Serbian_lje", Key_Serbian_lje, -1, false},
+	{"Cyrillic_nje", Key_Cyrillic_nje, 0x045A, true},
+	{"Serbian_nje", Key_Serbian_nje, -1, false},
+	{"Serbian_tshe", Key_Serbian_tshe, 0x045B, true},
+	{"Macedonia_kje", Key_Macedonia_kje, 0x045C, true},
+	{"Ukrainian_ghe_with_upturn", Key_Ukrainian_ghe_with_upturn, 0x0491, true},
+	{"Byelorussian_shortu", Key_Byelorussian_shortu, 0x045E, true},
+	{"Cyrillic_dzhe", Key_Cyrillic_dzhe, 0x045F, true},
+	{"Serbian_dze", Key_Serbian_dze, -1, false},
+	{"numerosign", Key_numerosign, 0x2116, true},
+	{"Serbian_DJE", Key_Serbian_DJE, 0x0402, true},
+	{"Macedonia_GJE", Key_Macedonia_GJE, 0x0403, true},
+	{"Cyrillic_IO", Key_Cyrillic_IO, 0x0401, true},
+	{"Ukrainian_IE", Key_Ukrainian_IE, 0x0404, true},
+	{"Ukranian_JE", Key_Ukranian_JE, -1, false},
+	{"Macedonia_DSE", Key_Macedonia_DSE, 0x0405, true},
+	{"Ukrainian_I", Key_Ukrainian_I, 0x0406, true},
+	{"Ukranian_I", Key_Ukranian_I, -1, false},
+	{"Ukrainian_YI", Key_Ukrainian_YI, 0x0407, true},
+	{"Ukranian_YI", Key_Ukranian_YI, -1, false},
+	{"Cyrillic_JE", Key_Cyrillic_JE, 0x0408, true},
+	{"Serbian_JE", Key_Serbian_JE, -1, false},
+	{"Cyrillic_LJE", Key_Cyrillic_LJE, 0x0409, true},
+	{"Serbian_LJE", Key_Serbian_LJE, -1, false},
+	{"Cyrillic_NJE", Key_Cyrillic_NJE, 0x040A, true},
+	{"Serbian_NJE", Key_Serbian_NJE, -1, false},
+	{"Serbian_TSHE", Key_Serbian_TSHE, 0x040B, true},
+	{"Macedonia_KJE", Key_Macedonia_KJE, 0x040C, true},
+	{"Ukrainian_GHE_WITH_UPTURN", Key_Ukrainian_GHE_WITH_UPTURN, 0x0490, true},
+	{"Byelorussian_SHORTU", Key_Byelorussian_SHORTU, 0x040E, true},
+	{"Cyrillic_DZHE", Key_Cyrillic_DZHE, 0x040F, true},
+	{"Serbian_DZE", Key_Serbian_DZE, -1, false},
+	{"Cyrillic_yu", Key_Cyrillic_yu, 0x044E, true},
+	{"Cyrillic_a", Key_Cyrillic_a, 0x0430, true},
+	{"Cyrillic_be", Key_Cyrillic_be, 0x0431, true},
+	{"Cyrillic_tse", Key_Cyrillic_tse, 0x0446, true},
+	{"Cyrillic_de", Key_Cyrillic_de, 0x0434, true},
+	{"Cyrillic_ie", Key_Cyrillic_ie, 0x0435, true},
+	{"Cyrillic_ef", Key_Cyrillic_ef, 0x0444, true},
+	{"Cyrillic_ghe", Key_Cyrillic_ghe, 0x0433, true},
+	{"Cyrillic_ha", Key_Cyrillic_ha, 0x0445, true},
+	{"Cyrillic_i", Key_Cyrillic_i, 0x0438, true},
+	{"Cyrillic_shorti", Key_Cyrillic_shorti, 0x0439, true},
+	{"Cyrillic_ka", Key_Cyrillic_ka, 0x043A, true},
+	{"Cyrillic_el", Key_Cyrillic_el, 0x043B, true},
+	{"Cyrillic_em", Key_Cyrillic_em, 0x043C, true},
+	{"Cyrillic_en", Key_Cyrillic_en, 0x043D, true},
+	{"Cyrillic_o", Key_Cyrillic_o, 0x043E, true},
+	{"Cyrillic_pe", Key_Cyrillic_pe, 0x043F, true},
+	{"Cyrillic_ya", Key_Cyrillic_ya, 0x044F, true},
+	{"Cyrillic_er", Key_Cyrillic_er, 0x0440, true},
+	{"Cyrillic_es", Key_Cyrillic_es, 0x0441, true},
+	{"Cyrillic_te", Key_Cyrillic_te, 0x0442, true},
+	{"Cyrillic_u", Key_Cyrillic_u, 0x0443, true},
+	{"Cyrillic_zhe", Key_Cyrillic_zhe, 0x0436, true},
+	{"Cyrillic_ve", Key_Cyrillic_ve, 0x0432, true},
+	{"Cyrillic_softsign", Key_Cyrillic_softsign, 0x044C, true},
+	{"Cyrillic_yeru", Key_Cyrillic_yeru, 0x044B, true},
+	{"Cyrillic_ze", Key_Cyrillic_ze, 0x0437, true},
+	{"Cyrillic_sha", Key_Cyrillic_sha, 0x0448, true},
+	{"Cyrillic_e", Key_Cyrillic_e, 0x044D, true},
+	{"Cyrillic_shcha", Key_Cyrillic_shcha, 0x0449, true},
+	{"Cyrillic_che", Key_Cyrillic_che, 0x0447, true},
+	{"Cyrillic_hardsign", Key_Cyrillic_hardsign, 0x044A, true},
+	{"Cyrillic_YU", Key_Cyrillic_YU, 0x042E, true},
+	{"Cyrillic_A", Key_Cyrillic_A, 0x0410, true},
+	{"Cyrillic_BE", Key_Cyrillic_BE, 0x0411, true},
+	{"Cyrillic_TSE", Key_Cyrillic_TSE, 0x0426, true},
+	{"Cyrillic_DE", Key_Cyrillic_DE, 0x0414, true},
+	{"Cyrillic_IE", Key_Cyrillic_IE, 0x0415, true},
+	{"Cyrillic_EF", Key_Cyrillic_EF, 0x0424, true},
+	{"Cyrillic_GHE", Key_Cyrillic_GHE, 0x0413, true},
+	{"Cyrillic_HA", Key_Cyrillic_HA, 0x0425, true},
+	{"Cyrillic_I", Key_Cyrillic_I, 0x0418, true},
+	{"Cyrillic_SHORTI", Key_Cyrillic_SHORTI, 0x0419, true},
+	{"Cyrillic_KA", Key_Cyrillic_KA, 0x041A, true},
+	{"Cyrillic_EL", Key_Cyrillic_EL, 0x041B, true},
+	{"Cyrillic_EM", Key_Cyrillic_EM, 0x041C, true},
+	{"Cyrillic_EN", Key_Cyrillic_EN, 0x041D, true},
+	{"Cyrillic_O", Key_Cyrillic_O, 0x041E, true},
+	{"Cyrillic_PE", Key_Cyrillic_PE, 0x041F, true},
+	{"Cyrillic_YA", Key_Cyrillic_YA, 0x042F, true},
+	{"Cyrillic_ER", Key_Cyrillic_ER, 0x0420, true},
+	{"Cyrillic_ES", Key_Cyrillic_ES, 0x0421, true},
+	{"Cyrillic_TE", Key_Cyrillic_TE, 0x0422, true},
+	{"Cyrillic_U", Key_Cyrillic_U, 0x0423, true},
+	{"Cyrillic_ZHE", Key_Cyrillic_ZHE, 0x0416, true},
+	{"Cyrillic_VE", Key_Cyrillic_VE, 0x0412, true},
+	{"Cyrillic_SOFTSIGN", Key_Cyrillic_SOFTSIGN, 0x042C, true},
+	{"Cyrillic_YERU", Key_Cyrillic_YERU, 0x042B, true},
+	{"Cyrillic_ZE", Key_Cyrillic_ZE, 0x0417, true},
+	{"Cyrillic_SHA", Key_Cyrillic_SHA, 0x0428, true},
+	{"Cyrillic_E", Key_Cyrillic_E, 0x042D, true},
+	{"Cyrillic_SHCHA", Key_Cyrillic_SHCHA, 0x0429, true},
+	{"Cyrillic_CHE", Key_Cyrillic_CHE, 0x0427, true},
+	{"Cyrillic_HARDSIGN", Key_Cyrillic_HARDSIGN, 0x042A, true},
+	{"Greek_ALPHAaccent", Key_Greek_ALPHAaccent, 0x0386, true},
+	{"Greek_EPSILONaccent", Key_Greek_EPSILONaccent, 0x0388, true},
+	{"Greek_ETAaccent", Key_Greek_ETAaccent, 0x0389, true},
+	{"Greek_IOTAaccent", Key_Greek_IOTAaccent, 0x038A, true},
+	{"Greek_IOTAdieresis", Key_Greek_IOTAdieresis, 0x03AA, true},
+	{"Greek_IOTAdiaeresis", Key_Greek_IOTAdiaeresis, -1, false},
+	{"Greek_OMICRONaccent", Key_Greek_OMICRONaccent, 0x038C, true},
+	{"Greek_UPSILONaccent", Key_Greek_UPSILONaccent, 0x038E, true},
+	{"Greek_UPSILONdieresis", Key_Greek_UPSILONdieresis, 0x03AB, true},
+	{"Greek_OMEGAaccent", Key_Greek_OMEGAaccent, 0x038F, true},
+	{"Greek_accentdieresis", Key_Greek_accentdieresis, 0x0385, true},
+	{"Greek_horizbar", Key_Greek_horizbar, 0x2015, true},
+	{"Greek_alphaaccent", Key_Greek_alphaaccent, 0x03AC, true},
+	{"Greek_epsilonaccent", Key_Greek_epsilonaccent, 0x03AD, true},
+	{"Greek_etaaccent", Key_Greek_etaaccent, 0x03AE, true},
+	{"Greek_iotaaccent", Key_Greek_iotaaccent, 0x03AF, true},
+	{"Greek_iotadieresis", Key_Greek_iotadieresis, 0x03CA, true},
+	{"Greek_iotaaccentdieresis", Key_Greek_iotaaccentdieresis, 0x0390, true},
+	{"Greek_omicronaccent", Key_Greek_omicronaccent, 0x03CC, true},
+	{"Greek_upsilonaccent", Key_Greek_upsilonaccent, 0x03CD, true},
+	{"Greek_upsilondieresis", Key_Greek_upsilondieresis, 0x03CB, true},
+	{"Greek_upsilonaccentdieresis", Key_Greek_upsilonaccentdieresis, 0x03B0, true},
+	{"Greek_omegaaccent", Key_Greek_omegaaccent, 0x03CE, true},
+	{"Greek_ALPHA", Key_Greek_ALPHA, 0x0391, true},
+	{"Greek_BETA", Key_Greek_BETA, 0x0392, true},
+	{"Greek_GAMMA", Key_Greek_GAMMA, 0x0393, true},
+	{"Greek_DELTA", Key_Greek_DELTA, 0x0394, true},
+	{"Greek_EPSILON", Key_Greek_EPSILON, 0x0395, true},
+	{"Greek_ZETA", Key_Greek_ZETA, 0x0396, true},
+	{"Greek_ETA", Key_Greek_ETA, 0x0397, true},
+	{"Greek_THETA", Key_Greek_THETA, 0x0398, true},
+	{"Greek_IOTA", Key_Greek_IOTA, 0x0399, true},
+	{"Greek_KAPPA", Key_Greek_KAPPA, 0x039A, true},
+	{"Greek_LAMDA", Key_Greek_LAMDA, 0x039B, true},
+	{"Greek_LAMBDA", Key_Greek_LAMBDA, 0x039B, false},
+	{"Greek_MU", Key_Greek_MU, 0x039C, true},
+	{"Greek_NU", Key_Greek_NU, 0x039D, true},
+	{"Greek_XI", Key_Greek_XI, 0x039E, true},
+	{"Greek_OMICRON", Key_Greek_OMICRON, 0x039F, true},
+	{"Greek_PI", Key_Greek_PI, 0x03A0, true},
+	{"Greek_RHO", Key_Greek_RHO, 0x03A1, true},
+	{"Greek_SIGMA", Key_Greek_SIGMA, 0x03A3, true},
+	{"Greek_TAU", Key_Greek_TAU, 0x03A4, true},
+	{"Greek_UPSILON", Key_Greek_UPSILON, 0x03A5, true},
+	{"Greek_PHI", Key_Greek_PHI, 0x03A6, true},
+	{"Greek_CHI", Key_Greek_CHI, 0x03A7, true},
+	{"Greek_PSI", Key_Greek_PSI, 0x03A8, true},
+	{"Greek_OMEGA", Key_Greek_OMEGA, 0x03A9, true},
+	{"Greek_alpha", Key_Greek_alpha, 0x03B1, true},
+	{"Greek_beta", Key_Greek_beta, 0x03B2, true},
+	{"Greek_gamma", Key_Greek_gamma, 0x03B3, true},
+	{"Greek_delta", Key_Greek_delta, 0x03B4, true},
+	{"Greek_epsilon", Key_Greek_epsilon, 0x03B5, true},
+	{"Greek_zeta", Key_Greek_zeta, 0x03B6, true},
+	{"Greek_eta", Key_Greek_eta, 0x03B7, true},
+	{"Greek_theta", Key_Greek_theta, 0x03B8, true},
+	{"Greek_iota", Key_Greek_iota, 0x03B9, true},
+	{"Greek_kappa", Key_Greek_kappa, 0x03BA, true},
+	{"Greek_lamda", Key_Greek_lamda, 0x03BB, true},
+	{"Greek_lambda", Key_Greek_lambda, 0x03BB, false},
+	{"Greek_mu", Key_Greek_mu, 0x03BC, true},
+	{"Greek_nu", Key_Greek_nu, 0x03BD, true},
+	{"Greek_xi", Key_Greek_xi, 0x03BE, true},
+	{"Greek_omicron", Key_Greek_omicron, 0x03BF, true},
+	{"Greek_pi", Key_Greek_pi, 0x03C0, true},
+	{"Greek_rho", Key_Greek_rho, 0x03C1, true},
+	{"Greek_sigma", Key_Greek_sigma, 0x03C3, true},
+	{"Greek_finalsmallsigma", Key_Greek_finalsmallsigma, 0x03C2, true},
+	{"Greek_tau", Key_Greek_tau, 0x03C4, true},
+	{"Greek_upsilon", Key_Greek_upsilon, 0x03C5, true},
+	{"Greek_phi", Key_Greek_phi, 0x03C6, true},
+	{"Greek_chi", Key_Greek_chi, 0x03C7, true},
+	{"Greek_psi", Key_Greek_psi, 0x03C8, true},
+	{"Greek_omega", Key_Greek_omega, 0x03C9, true},
+	{"Greek_switch", Key_Greek_switch, -1, false},
+	{"leftradical", Key_leftradical, 0x23B7, true},
+	{"topleftradical", Key_topleftradical, 0x250C, true},
+	{"horizconnector", Key_horizconnector, 0x2500, true},
+	{"topintegral", Key_topintegral, 0x2320, true},
+	{"botintegral", Key_botintegral, 0x2321, true},
+	{"vertconnector", Key_vertconnector, 0x2502, true},
+	{"topleftsqbracket", Key_topleftsqbracket, 0x23A1, true},
+	{"botleftsqbracket", Key_botleftsqbracket, 0x23A3, true},
+	{"toprightsqbracket", Key_toprightsqbracket, 0x23A4, true},
+	{"botrightsqbracket", Key_botrightsqbracket, 0x23A6, true},
+	{"topleftparens", Key_topleftparens, 0x239B, true},
+	{"botleftparens", Key_botleftparens, 0x239D, true},
+	{"toprightparens", Key_toprightparens, 0x239E, true},
+	{"botrightparens", Key_botrightparens, 0x23A0, true},
+	{"leftmiddlecurlybrace", Key_leftmiddlecurlybrace, 0x23A8, true},
+	{"rightmiddlecurlybrace", Key_rightmiddlecurlybrace, 0x23AC, true},
+	{"topleftsummation", Key_topleftsummation, -1, true},
+	{"botleftsummation", Key_botleftsummation, -1, true},
+	{"topvertsummationconnector", Key_topvertsummationconnector, -1, true},
+	{"botvertsummationconnector", Key_botvertsummationconnector, -1, true},
+	{"toprightsummation", Key_toprightsummation, -1, true},
+	{"botrightsummation", Key_botrightsummation, -1, true},
+	{"rightmiddlesummation", Key_rightmiddlesummation, -1, true},
+	{"lessthanequal", Key_lessthanequal, 0x2264, true},
+	{"notequal", Key_notequal, 0x2260, true},
+	{"greaterthanequal", Key_greaterthanequal, 0x2265, true},
+	{"integral", Key_integral, 0x222B, true},
+	{"therefore", Key_therefore, 0x2234, true},
+	{"variation", Key_variation, 0x221D, true},
+	{"infinity", Key_infinity, 0x221E, true},
+	{"nabla", Key_nabla, 0x2207, true},
+	{"approximate", Key_approximate, 0x223C, true},
+	{"similarequal", Key_similarequal, 0x2243, true},
+	{"ifonlyif", Key_ifonlyif, 0x21D4, true},
+	{"implies", Key_implies, 0x21D2, true},
+	{"identical", Key_identical, 0x2261, true},
+	{"radical", Key_radical, 0x221A, true},
+	{"includedin", Key_includedin, 0x2282, true},
+	{"includes", Key_includes, 0x2283, true},
+	{"intersection", Key_intersection, 0x2229, true},
+	{"union", Key_union, 0x222A, true},
+	{"logicaland", Key_logicaland, 0x2227, true},
+	{"logicalor", Key_logicalor, 0x2228, true},
+	{"partialderivative", Key_partialderivative, 0x2202, true},
+	{"function", Key_function, 0x0192, true},
+	{"leftarrow", Key_leftarrow, 0x2190, true},
+	{"uparrow", Key_uparrow, 0x2191, true},
+	{"rightarrow", Key_rightarrow, 0x2192, true},
+	{"downarrow", Key_downarrow, 0x2193, true},
+	{"blank", Key_blank, -1, true},
+	{"soliddiamond", Key_soliddiamond, 0x25C6, true},
+	{"checkerboard", Key_checkerboard, 0x2592, true},
+	{"ht", Key_ht, 0x2409, true},
+	{"ff", Key_ff, 0x240C, true},
+	{"cr", Key_cr, 0x240D, true},
+	{"lf", Key_lf, 0x240A, true},
+	{"nl", Key_nl, 0x2424, true},
+	{"vt", Key_vt, 0x240B, true},
+	{"lowrightcorner", Key_lowrightcorner, 0x2518, true},
+	{"uprightcorner", Key_uprightcorner, 0x2510, true},
+	{"upleftcorner", Key_upleftcorner, 0x250C, true},
+	{"lowleftcorner", Key_lowleftcorner, 0x2514, true},
+	{"crossinglines", Key_crossinglines, 0x253C, true},
+	{"horizlinescan1", Key_horizlinescan1, 0x23BA, true},
+	{"horizlinescan3", Key_horizlinescan3, 0x23BB, true},
+	{"horizlinescan5", Key_horizlinescan5, 0x2500, true},
+	{"horizlinescan7", Key_horizlinescan7, 0x23BC, true},
+	{"horizlinescan9", Key_horizlinescan9, 0x23BD, true},
+	{"leftt", Key_leftt, 0x251C, true},
+	{"rightt", Key_rightt, 0x2524, true},
+	{"bott", Key_bott, 0x2534, true},
+	{"topt", Key_topt, 0x252C, true},
+	{"vertbar", Key_vertbar, 0x2502, true},
+	{"emspace", Key_emspace, 0x2003, true},
+	{"enspace", Key_enspace, 0x2002, true},
+	{"em3space", Key_em3space, 0x2004, true},
+	{"em4space", Key_em4space, 0x2005, true},
+	{"digitspace", Key_digitspace, 0x2007, true},
+	{"punctspace", Key_punctspace, 0x2008, true},
+	{"thinspace", Key_thinspace, 0x2009, true},
+	{"hairspace", Key_hairspace, 0x200A, true},
+	{"emdash", Key_emdash, 0x2014, true},
+	{"endash", Key_endash, 0x2013, true},
+	{"signifblank", Key_signifblank, 0x2423, true},
+	{"ellipsis", Key_ellipsis, 0x2026, true},
+	{"doubbaselinedot", Key_doubbaselinedot, 0x2025, true},
+	{"onethird", Key_onethird, 0x2153, true},
+	{"twothirds", Key_twothirds, 0x2154, true},
+	{"onefifth", Key_onefifth, 0x2155, true},
+	{"twofifths", Key_twofifths, 0x2156, true},
+	{"threefifths", Key_threefifths, 0x2157, true},
+	{"fourfifths", Key_fourfifths, 0x2158, true},
+	{"onesixth", Key_onesixth, 0x2159, true},
+	{"fivesixths", Key_fivesixths, 0x215A, true},
+	{"careof", Key_careof, 0x2105, true},
+	{"figdash", Key_figdash, 0x2012, true},
+	{"leftanglebracket", Key_leftanglebracket, 0x2329, true},
+	{"decimalpoint", Key_decimalpoint, 0x002E, true},
+	{"rightanglebracket", Key_rightanglebracket, 0x232A, true},
+	{"marker", Key_marker, -1, true},
+	{"oneeighth", Key_oneeighth, 0x215B, true},
+	{"threeeighths", Key_threeeighths, 0x215C, true},
+	{"fiveeighths", Key_fiveeighths, 0x215D, true},
+	{"seveneighths", Key_seveneighths, 0x215E, true},
+	{"trademark", Key_trademark, 0x2122, true},
+	{"signaturemark", Key_signaturemark, 0x2613, true},
+	{"trademarkincircle", Key_trademarkincircle, -1, true},
+	{"leftopentriangle", Key_leftopentriangle, 0x25C1, true},
+	{"rightopentriangle", Key_rightopentriangle, 0x25B7, true},
+	{"emopencircle", Key_emopencircle, 0x25CB, true},
+	{"emopenrectangle", Key_emopenrectangle, 0x25AF, true},
+	{"leftsinglequotemark", Key_leftsinglequotemark, 0x2018, true},
+	{"rightsinglequotemark", Key_rightsinglequotemark, 0x2019, true},
+	{"leftdoublequotemark", Key_leftdoublequotemark, 0x201C, true},
+	{"rightdoublequotemark", Key_rightdoublequotemark, 0x201D, true},
+	{"prescription", Key_prescription, 0x211E, true},
+	{"permille", Key_permille, 0x2030, true},
+	{"minutes", Key_minutes, 0x2032, true},
+	{"seconds", Key_seconds, 0x2033, true},
+	{"latincross", Key_latincross, 0x271D, true},
+	{"hexagram", Key_hexagram, -1, true},
+	{"filledrectbullet", Key_filledrectbullet, 0x25AC, true},
+	{"filledlefttribullet", Key_filledlefttribullet, 0x25C0, true},
+	{"filledrighttribullet", Key_filledrighttribullet, 0x25B6, true},
+	{"emfilledcircle", Key_emfilledcircle, 0x25CF, true},
+	{"emfilledrect", Key_emfilledrect, 0x25AE, true},
+	{"enopencircbullet", Key_enopencircbullet, 0x25E6, true},
+	{"enopensquarebullet", Key_enopensquarebullet, 0x25AB, true},
+	{"openrectbullet", Key_openrectbullet, 0x25AD, true},
+	{"opentribulletup", Key_opentribulletup, 0x25B3, true},
+	{"opentribulletdown", Key_opentribulletdown, 0x25BD, true},
+	{"openstar", Key_openstar, 0x2606, true},
+	{"enfilledcircbullet", Key_enfilledcircbullet, 0x2022, true},
+	{"enfilledsqbullet", Key_enfilledsqbullet, 0x25AA, true},
+	{"filledtribulletup", Key_filledtribulletup, 0x25B2, true},
+	{"filledtribulletdown", Key_filledtribulletdown, 0x25BC, true},
+	{"leftpointer", Key_leftpointer, 0x261C, true},
+	{"rightpointer", Key_rightpointer, 0x261E, true},
+	{"club", Key_club, 0x2663, true},
+	{"diamond", Key_diamond, 0x2666, true},
+	{"heart", Key_heart, 0x2665, true},
+	{"maltesecross", Key_maltesecross, 0x2720, true},
+	{"dagger", Key_dagger, 0x2020, true},
+	{"doubledagger", Key_doubledagger, 0x2021, true},
+	{"checkmark", Key_checkmark, 0x2713, true},
+	{"ballotcross", Key_ballotcross, 0x2717, true},
+	{"musicalsharp", Key_musicalsharp, 0x266F, true},
+	{"musicalflat", Key_musicalflat, 0x266D, true},
+	{"malesymbol", Key_malesymbol, 0x2642, true},
+	{"femalesymbol", Key_femalesymbol, 0x2640, true},
+	{"telephone", Key_telephone, 0x260E, true},
+	{"telephonerecorder", Key_telephonerecorder, 0x2315, true},
+	{"phonographcopyright", Key_phonographcopyright, 0x2117, true},
+	{"caret", Key_caret, 0x2038, true},
+	{"singlelowquotemark", Key_singlelowquotemark, 0x201A, true},
+	{"doublelowquotemark", Key_doublelowquotemark, 0x201E, true},
+	{"cursor", Key_cursor, -1, true},
+	{"leftcaret", Key_leftcaret, 0x003C, true},
+	{"rightcaret", Key_rightcaret, 0x003E, true},
+	{"downcaret", Key_downcaret, 0x2228, true},
+	{"upcaret", Key_upcaret, 0x2227, true},
+	{"overbar", Key_overbar, 0x00AF, true},
+	{"downtack", Key_downtack, 0x22A4, true},
+	{"upshoe", Key_upshoe, 0x2229, true},
+	{"downstile", Key_downstile, 0x230A, true},
+	{"underbar", Key_underbar, 0x005F, true},
+	{"jot", Key_jot, 0x2218, true},
+	{"quad", Key_quad, 0x2395, true},
+	{"uptack", Key_uptack, 0x22A5, true},
+	{"circle", Key_circle, 0x25CB, true},
+	{"upstile", Key_upstile, 0x2308, true},
+	{"downshoe", Key_downshoe, 0x222A, true},
+	{"rightshoe", Key_rightshoe, 0x2283, true},
+	{"leftshoe", Key_leftshoe, 0x2282, true},
+	{"lefttack", Key_lefttack, 0x22A3, true},
+	{"righttack", Key_righttack, 0x22A2, true},
+	{"hebrew_doublelowline", Key_hebrew_doublelowline, 0x2017, true},
+	{"hebrew_aleph", Key_hebrew_aleph, 0x05D0, true},
+	{"hebrew_bet", Key_hebrew_bet, 0x05D1, true},
+	{"hebrew_beth", Key_hebrew_beth, -1, false},
+	{"hebrew_gimel", Key_hebrew_gimel, 0x05D2, true},
+	{"hebrew_gimmel", Key_hebrew_gimmel, -1, false},
+	{"hebrew_dalet", Key_hebrew_dalet, 0x05D3, true},
+	{"hebrew_daleth", Key_hebrew_daleth, -1, false},
+	{"hebrew_he", Key_hebrew_he, 0x05D4, true},
+	{"hebrew_waw", Key_hebrew_waw, 0x05D5, true},
+	{"hebrew_zain", Key_hebrew_zain, 0x05D6, true},
+	{"hebrew_zayin", Key_hebrew_zayin, -1, false},
+	{"hebrew_chet", Key_hebrew_chet, 0x05D7, true},
+	{"hebrew_het", Key_hebrew_het, -1, false},
+	{"hebrew_tet", Key_hebrew_tet, 0x05D8, true},
+	{"hebrew_teth", Key_hebrew_teth, -1, false},
+	{"hebrew_yod", Key_hebrew_yod, 0x05D9, true},
+	{"hebrew_finalkaph", Key_hebrew_finalkaph, 0x05DA, true},
+	{"hebrew_kaph", Key_hebrew_kaph, 0x05DB, true},
+	{"hebrew_lamed", Key_hebrew_lamed, 0x05DC, true},
+	{"hebrew_finalmem", Key_hebrew_finalmem, 0x05DD, true},
+	{"hebrew_mem", Key_hebrew_mem, 0x05DE, true},
+	{"hebrew_finalnun", Key_hebrew_finalnun, 0x05DF, true},
+	{"hebrew_nun", Key_hebrew_nun, 0x05E0, true},
+	{"hebrew_samech", Key_hebrew_samech, 0x05E1, true},
+	{"hebrew_samekh", Key_hebrew_samekh, -1, false},
+	{"hebrew_ayin", Key_hebrew_ayin, 0x05E2, true},
+	{"hebrew_finalpe", Key_hebrew_finalpe, 0x05E3, true},
+	{"hebrew_pe", Key_hebrew_pe, 0x05E4, true},
+	{"hebrew_finalzade", Key_hebrew_finalzade, 0x05E5, true},
+	{"hebrew_finalzadi", Key_hebrew_finalzadi, -1, false},
+	{"hebrew_zade", Key_hebrew_zade, 0x05E6, true},
+	{"hebrew_zadi", Key_hebrew_zadi, -1, false},
+	{"hebrew_qoph", Key_hebrew_qoph, 0x05E7, true},
+	{"hebrew_kuf", Key_hebrew_kuf, -1, false},
+	{"hebrew_resh", Key_hebrew_resh, 0x05E8, true},
+	{"hebrew_shin", Key_hebrew_shin, 0x05E9, true},
+	{"hebrew_taw", Key_hebrew_taw, 0x05EA, true},
+	{"hebrew_taf", Key_hebrew_taf, -1, false},
+	{"Hebrew_switch", Key_Hebrew_switch, -1, false},
+	{"Thai_kokai", Key_Thai_kokai, 0x0E01, true},
+	{"Thai_khokhai", Key_Thai_khokhai, 0x0E02, true},
+	{"Thai_khokhuat", Key_Thai_khokhuat, 0x0E03, true},
+	{"Thai_khokhwai", Key_Thai_khokhwai, 0x0E04, true},
+	{"Thai_khokhon", Key_Thai_khokhon, 0x0E05, true},
+	{"Thai_khorakhang", Key_Thai_khorakhang, 0x0E06, true},
+	{"Thai_ngongu", Key_Thai_ngongu, 0x0E07, true},
+	{"Thai_chochan", Key_Thai_chochan, 0x0E08, true},
+	{"Thai_choching", Key_Thai_choching, 0x0E09, true},
+	{"Thai_chochang", Key_Thai_chochang, 0x0E0A, true},
+	{"Thai_soso", Key_Thai_soso, 0x0E0B, true},
+	{"Thai_chochoe", Key_Thai_chochoe, 0x0E0C, true},
+	{"Thai_yoying", Key_Thai_yoying, 0x0E0D, true},
+	{"Thai_dochada", Key_Thai_dochada, 0x0E0E, true},
+	{"Thai_topatak", Key_Thai_topatak, 0x0E0F, true},
+	{"Thai_thothan", Key_Thai_thothan, 0x0E10, true},
+	{"Thai_thonangmontho", Key_Thai_thonangmontho, 0x0E11, true},
+	{"Thai_thophuthao", Key_Thai_thophuthao, 0x0E12, true},
+	{"Thai_nonen", Key_Thai_nonen, 0x0E13, true},
+	{"Thai_dodek", Key_Thai_dodek, 0x0E14, true},
+	{"Thai_totao", Key_Thai_totao, 0x0E15, true},
+	{"Thai_thothung", Key_Thai_thothung, 0x0E16, true},
+	{"Thai_thothahan", Key_Thai_thothahan, 0x0E17, true},
+	{"Thai_thothong", Key_Thai_thothong, 0x0E18, true},
+	{"Thai_nonu", Key_Thai_nonu, 0x0E19, true},
+	{"Thai_bobaimai", Key_Thai_bobaimai, 0x0E1A, true},
+	{"Thai_popla", Key_Thai_popla, 0x0E1B, true},
+	{"Thai_phophung", Key_Thai_phophung, 0x0E1C, true},
+	{"Thai_fofa", Key_Thai_fofa, 0x0E1D, true},
+	{"Thai_phophan", Key_Thai_phophan, 0x0E1E, true},
+	{"Thai_fofan", Key_Thai_fofan, 0x0E1F, true},
+	{"Thai_phosamphao", Key_Thai_phosamphao, 0x0E20, true},
+	{"Thai_moma", Key_Thai_moma, 0x0E21, true},
+	{"Thai_yoyak", Key_Thai_yoyak, 0x0E22, true},
+	{"Thai_rorua", Key_Thai_rorua, 0x0E23, true},
+	{"Thai_ru", Key_Thai_ru, 0x0E24, true},
+	{"Thai_loling", Key_Thai_loling, 0x0E25, true},
+	{"Thai_lu", Key_Thai_lu, 0x0E26, true},
+	{"Thai_wowaen", Key_Thai_wowaen, 0x0E27, true},
+	{"Thai_sosala", Key_Thai_sosala, 0x0E28, true},
+	{"Thai_sorusi", Key_Thai_sorusi, 0x0E29, true},
+	{"Thai_sosua", Key_Thai_sosua, 0x0E2A, true},
+	{"Thai_hohip", Key_Thai_hohip, 0x0E2B, true},
+	{"Thai_lochula", Key_Thai_lochula, 0x0E2C, true},
+	{"Thai_oang", Key_Thai_oang, 0x0E2D, true},
+	{"Thai_honokhuk", Key_Thai_honokhuk, 0x0E2E, true},
+	{"Thai_paiyannoi", Key_Thai_paiyannoi, 0x0E2F, true},
+	{"Thai_saraa", Key_Thai_saraa, 0x0E30, true},
+	{"Thai_maihanakat", Key_Thai_maihanakat, 0x0E31, true},
+	{"Thai_saraaa", Key_Thai_saraaa, 0x0E32, true},
+	{"Thai_saraam", Key_Thai_saraam, 0x0E33, true},
+	{"Thai_sarai", Key_Thai_sarai, 0x0E34, true},
+	{"Thai_saraii", Key_Thai_saraii, 0x0E35, true},
+	{"Thai_saraue", Key_Thai_saraue, 0x0E36, true},
+	{"Thai_sarauee", Key_Thai_sarauee, 0x0E37, true},
+	{"Thai_sarau", Key_Thai_sarau, 0x0E38, true},
+	{"Thai_sarauu", Key_Thai_sarauu, 0x0E39, true},
+	{"Thai_phinthu", Key_Thai_phinthu, 0x0E3A, true},
+	{"Thai_maihanakat_maitho", Key_Thai_maihanakat_maitho, -1, true},
+	{"Thai_baht", Key_Thai_baht, 0x0E3F, true},
+	{"Thai_sarae", Key_Thai_sarae, 0x0E40, true},
+	{"Thai_saraae", Key_Thai_saraae, 0x0E41, true},
+	{"Thai_sarao", Key_Thai_sarao, 0x0E42, true},
+	{"Thai_saraaimaimuan", Key_Thai_saraaimaimuan, 0x0E43, true},
+	{"Thai_saraaimaimalai", Key_Thai_saraaimaimalai, 0x0E44, true},
+	{"Thai_lakkhangyao", Key_Thai_lakkhangyao, 0x0E45, true},
+	{"Thai_maiyamok", Key_Thai_maiyamok, 0x0E46, true},
+	{"Thai_maitaikhu", Key_Thai_maitaikhu, 0x0E47, true},
+	{"Thai_maiek", Key_Thai_maiek, 0x0E48, true},
+	{"Thai_maitho", Key_Thai_maitho, 0x0E49, true},
+	{"Thai_maitri", Key_Thai_maitri, 0x0E4A, true},
+	{"Thai_maichattawa", Key_Thai_maichattawa, 0x0E4B, true},
+	{"Thai_thanthakhat", Key_Thai_thanthakhat, 0x0E4C, true},
+	{"Thai_nikhahit", Key_Thai_nikhahit, 0x0E4D, true},
+	{"Thai_leksun", Key_Thai_leksun, 0x0E50, true},
+	{"Thai_leknung", Key_Thai_leknung, 0x0E51, true},
+	{"Thai_leksong", Key_Thai_leksong, 0x0E52, true},
+	{"Thai_leksam", Key_Thai_leksam, 0x0E53, true},
+	{"Thai_leksi", Key_Thai_leksi, 0x0E54, true},
+	{"Thai_lekha", Key_Thai_lekha, 0x0E55, true},
+	{"Thai_lekhok", Key_Thai_lekhok, 0x0E56, true},
+	{"Thai_lekchet", Key_Thai_lekchet, 0x0E57, true},
+	{"Thai_lekpaet", Key_Thai_lekpaet, 0x0E58, true},
+	{"Thai_lekkao", Key_Thai_lekkao, 0x0E59, true},
+	{"Hangul", Key_Hangul, -1, true},
+	{"Hangul_Start", Key_Hangul_Start, -1, true},
+	{"Hangul_End", Key_Hangul_End, -1, true},
+	{"Hangul_Hanja", Key_Hangul_Hanja, -1, true},
+	{"Hangul_Jamo", Key_Hangul_Jamo, -1, true},
+	{"Hangul_Romaja", Key_Hangul_Romaja, -1, true},
+	{"Hangul_Codeinput", Key_Hangul_Codeinput, -1, false},
+	{"Hangul_Jeonja", Key_Hangul_Jeonja, -1, true},
+	{"Hangul_Banja", Key_Hangul_Banja, -1, true},
+	{"Hangul_PreHanja", Key_Hangul_PreHanja, -1, true},
+	{"Hangul_PostHanja", Key_Hangul_PostHanja, -1, true},
+	{"Hangul_SingleCandidate", Key_Hangul_SingleCandidate, -1, false},
+	{"Hangul_MultipleCandidate", Key_Hangul_MultipleCandidate, -1, false},
+	{"Hangul_PreviousCandidate", Key_Hangul_PreviousCandidate, -1, false},
+	{"Hangul_Special", Key_Hangul_Special, -1, true},
+	{"Hangul_switch", Key_Hangul_switch, -1, false},
+	{"Hangul_Kiyeog", Key_Hangul_Kiyeog, 0x3131, true},
+	{"Hangul_SsangKiyeog", Key_Hangul_SsangKiyeog, 0x3132, true},
+	{"Hangul_KiyeogSios", Key_Hangul_KiyeogSios, 0x3133, true},
+	{"Hangul_Nieun", Key_Hangul_Nieun, 0x3134, true},
+	{"Hangul_NieunJieuj", Key_Hangul_NieunJieuj, 0x3135, true},
+	{"Hangul_NieunHieuh", Key_Hangul_NieunHieuh, 0x3136, true},
+	{"Hangul_Dikeud", Key_Hangul_Dikeud, 0x3137, true},
+	{"Hangul_SsangDikeud", Key_Hangul_SsangDikeud, 0x3138, true},
+	{"Hangul_Rieul", Key_Hangul_Rieul, 0x3139, true},
+	{"Hangul_RieulKiyeog", Key_Hangul_RieulKiyeog, 0x313A, true},
+	{"Hangul_RieulMieum", Key_Hangul_RieulMieum, 0x313B, true},
+	{"Hangul_RieulPieub", Key_Hangul_RieulPieub, 0x313C, true},
+	{"Hangul_RieulSios", Key_Hangul_RieulSios, 0x313D, true},
+	{"Hangul_RieulTieut", Key_Hangul_RieulTieut, 0x313E, true},
+	{"Hangul_RieulPhieuf", Key_Hangul_RieulPhieuf, 0x313F, true},
+	{"Hangul_RieulHieuh", Key_Hangul_RieulHieuh, 0x3140, true},
+	{"Hangul_Mieum", Key_Hangul_Mieum, 0x3141, true},
+	{"Hangul_Pieub", Key_Hangul_Pieub, 0x3142, true},
+	{"Hangul_SsangPieub", Key_Hangul_SsangPieub, 0x3143, true},
+	{"Hangul_PieubSios", Key_Hangul_PieubSios, 0x3144, true},
+	{"Hangul_Sios", Key_Hangul_Sios, 0x3145, true},
+	{"Hangul_SsangSios", Key_Hangul_SsangSios, 0x3146, true},
+	{"Hangul_Ieung", Key_Hangul_Ieung, 0x3147, true},
+	{"Hangul_Jieuj", Key_Hangul_Jieuj, 0x3148, true},
+	{"Hangul_SsangJieuj", Key_Hangul_SsangJieuj, 0x3149, true},
+	{"Hangul_Cieuc", Key_Hangul_Cieuc, 0x314A, true},
+	{"Hangul_Khieuq", Key_Hangul_Khieuq, 0x314B, true},
+	{"Hangul_Tieut", Key_Hangul_Tieut, 0x314C, true},
+	{"Hangul_Phieuf", Key_Hangul_Phieuf, 0x314D, true},
+	{"Hangul_Hieuh", Key_Hangul_Hieuh, 0x314E, true},
+	{"Hangul_A", Key_Hangul_A, 0x314F, true},
+	{"Hangul_AE", Key_Hangul_AE, 0x3150, true},
+	{"Hangul_YA", Key_Hangul_YA, 0x3151, true},
+	{"Hangul_YAE", Key_Hangul_YAE, 0x3152, true},
+	{"Hangul_EO", Key_Hangul_EO, 0x3153, true},
+	{"Hangul_E", Key_Hangul_E, 0x3154, true},
+	{"Hangul_YEO", Key_Hangul_YEO, 0x3155, true},
+	{"Hangul_YE", Key_Hangul_YE, 0x3156, true},
+	{"Hangul_O", Key_Hangul_O, 0x3157, true},
+	{"Hangul_WA", Key_Hangul_WA, 0x3158, true},
+	{"Hangul_WAE", Key_Hangul_WAE, 0x3159, true},
+	{"Hangul_OE", Key_Hangul_OE, 0x315A, true},
+	{"Hangul_YO", Key_Hangul_YO, 0x315B, true},
+	{"Hangul_U", Key_Hangul_U, 0x315C, true},
+	{"Hangul_WEO", Key_Hangul_WEO, 0x315D, true},
+	{"Hangul_WE", Key_Hangul_WE, 0x315E, true},
+	{"Hangul_WI", Key_Hangul_WI, 0x315F, true},
+	{"Hangul_YU", Key_Hangul_YU, 0x3160, true},
+	{"Hangul_EU", Key_Hangul_EU, 0x3161, true},
+	{"Hangul_YI", Key_Hangul_YI, 0x3162, true},
+	{"Hangul_I", Key_Hangul_I, 0x3163, true},
+	{"Hangul_J_Kiyeog", Key_Hangul_J_Kiyeog, 0x11A8, true},
+	{"Hangul_J_SsangKiyeog", Key_Hangul_J_SsangKiyeog, 0x11A9, true},
+	{"Hangul_J_KiyeogSios", Key_Hangul_J_KiyeogSios, 0x11AA, true},
+	{"Hangul_J_Nieun", Key_Hangul_J_Nieun, 0x11AB, true},
+	{"Hangul_J_NieunJieuj", Key_Hangul_J_NieunJieuj, 0x11AC, true},
+	{"Hangul_J_NieunHieuh", Key_Hangul_J_NieunHieuh, 0x11AD, true},
+	{"Hangul_J_Dikeud", Key_Hangul_J_Dikeud, 0x11AE, true},
+	{"Hangul_J_Rieul", Key_Hangul_J_Rieul, 0x11AF, true},
+	{"Hangul_J_RieulKiyeog", Key_Hangul_J_RieulKiyeog, 0x11B0, true},
+	{"Hangul_J_RieulMieum", Key_Hangul_J_RieulMieum, 0x11B1, true},
+	{"Hangul_J_RieulPieub", Key_Hangul_J_RieulPieub, 0x11B2, true},
+	{"Hangul_J_RieulSios", Key_Hangul_J_RieulSios, 0x11B3, true},
+	{"Hangul_J_RieulTieut", Key_Hangul_J_RieulTieut, 0x11B4, true},
+	{"Hangul_J_RieulPhieuf", Key_Hangul_J_RieulPhieuf, 0x11B5, true},
+	{"Hangul_J_RieulHieuh", Key_Hangul_J_RieulHieuh, 0x11B6, true},
+	{"Hangul_J_Mieum", Key_Hangul_J_Mieum, 0x11B7, true},
+	{"Hangul_J_Pieub", Key_Hangul_J_Pieub, 0x11B8, true},
+	{"Hangul_J_PieubSios", Key_Hangul_J_PieubSios, 0x11B9, true},
+	{"Hangul_J_Sios", Key_Hangul_J_Sios, 0x11BA, true},
+	{"Hangul_J_SsangSios", Key_Hangul_J_SsangSios, 0x11BB, true},
+	{"Hangul_J_Ieung", Key_Hangul_J_Ieung, 0x11BC, true},
+	{"Hangul_J_Jieuj", Key_Hangul_J_Jieuj, 0x11BD, true},
+	{"Hangul_J_Cieuc", Key_Hangul_J_Cieuc, 0x11BE, true},
+	{"Hangul_J_Khieuq", Key_Hangul_J_Khieuq, 0x11BF, true},
+	{"Hangul_J_Tieut", Key_Hangul_J_Tieut, 0x11C0, true},
+	{"Hangul_J_Phieuf", Key_Hangul_J_Phieuf, 0x11C1, true},
+	{"Hangul_J_Hieuh", Key_Hangul_J_Hieuh, 0x11C2, true},
+	{"Hangul_RieulYeorinHieuh", Key_Hangul_RieulYeorinHieuh, 0x316D, true},
+	{"Hangul_SunkyeongeumMieum", Key_Hangul_SunkyeongeumMieum, 0x3171, true},
+	{"Hangul_SunkyeongeumPieub", Key_Hangul_SunkyeongeumPieub, 0x3178, true},
+	{"Hangul_PanSios", Key_Hangul_PanSios, 0x317F, true},
+	{"Hangul_KkogjiDalrinIeung", Key_Hangul_KkogjiDalrinIeung, 0x3181, true},
+	{"Hangul_SunkyeongeumPhieuf", Key_Hangul_SunkyeongeumPhieuf, 0x3184, true},
+	{"Hangul_YeorinHieuh", Key_Hangul_YeorinHieuh, 0x3186, true},
+	{"Hangul_AraeA", Key_Hangul_AraeA, 0x318D, true},
+	{"Hangul_AraeAE", Key_Hangul_AraeAE, 0x318E, true},
+	{"Hangul_J_PanSios", Key_Hangul_J_PanSios, 0x11EB, true},
+	{"Hangul_J_KkogjiDalrinIeung", Key_Hangul_J_KkogjiDalrinIeung, 0x11F0, true},
+	{"Hangul_J_YeorinHieuh", Key_Hangul_J_YeorinHieuh, 0x11F9, true},
+	{"Korean_Won", Key_Korean_Won, 0x20A9, true},
+	{"Armenian_ligature_ew", Key_Armenian_ligature_ew, 0x0587, true},
+	{"Armenian_full_stop", Key_Armenian_full_stop, 0x0589, true},
+	{"Armenian_verjaket", Key_Armenian_verjaket, 0x0589, false},
+	{"Armenian_separation_mark", Key_Armenian_separation_mark, 0x055D, true},
+	{"Armenian_but", Key_Armenian_but, 0x055D, false},
+	{"Armenian_hyphen", Key_Armenian_hyphen, 0x058A, true},
+	{"Armenian_yentamna", Key_Armenian_yentamna, 0x058A, false},
+	{"Armenian_exclam", Key_Armenian_exclam, 0x055C, true},
+	{"Armenian_amanak", Key_Armenian_amanak, 0x055C, false},
+	{"Armenian_accent", Key_Armenian_accent, 0x055B, true},
+	{"Armenian_shesht", Key_Armenian_shesht, 0x055B, false},
+	{"Armenian_question", Key_Armenian_question, 0x055E, true},
+	{"Armenian_paruyk", Key_Armenian_paruyk, 0x055E, false},
+	{"Armenian_AYB", Key_Armenian_AYB, 0x0531, true},
+	{"Armenian_ayb", Key_Armenian_ayb, 0x0561, true},
+	{"Armenian_BEN", Key_Armenian_BEN, 0x0532, true},
+	{"Armenian_ben", Key_Armenian_ben, 0x0562, true},
+	{"Armenian_GIM", Key_Armenian_GIM, 0x0533, true},
+	{"Armenian_gim", Key_Armenian_gim, 0x0563, true},
+	{"Armenian_DA", Key_Armenian_DA, 0x0534, true},
+	{"Armenian_da", Key_Armenian_da, 0x0564, true},
+	{"Armenian_YECH", Key_Armenian_YECH, 0x0535, true},
+	{"Armenian_yech", Key_Armenian_yech, 0x0565, true},
+	{"Armenian_ZA", Key_Armenian_ZA, 0x0536, true},
+	{"Armenian_za", Key_Armenian_za, 0x0566, true},
+	{"Armenian_E", Key_Armenian_E, 0x0537, true},
+	{"Armenian_e", Key_Armenian_e, 0x0567, true},
+	{"Armenian_AT", Key_Armenian_AT, 0x0538, true},
+	{"Armenian_at", Key_Armenian_at, 0x0568, true},
+	{"Armenian_TO", Key_Armenian_TO, 0x0539, true},
+	{"Armenian_to", Key_Armenian_to, 0x0569, true},
+	{"Armenian_ZHE", Key_Armenian_ZHE, 0x053A, true},
+	{"Armenian_zhe", Key_Armenian_zhe, 0x056A, true},
+	{"Armenian_INI", Key_Armenian_INI, 0x053B, true},
+	{"Armenian_ini", Key_Armenian_ini, 0x056B, true},
+	{"Armenian_LYUN", Key_Armenian_LYUN, 0x053C, true},
+	{"Armenian_lyun", Key_Armenian_lyun, 0x056C, true},
+	{"Armenian_KHE", Key_Armenian_KHE, 0x053D, true},
+	{"Armenian_khe", Key_Armenian_khe, 0x056D, true},
+	{"Armenian_TSA", Key_Armenian_TSA, 0x053E, true},
+	{"Armenian_tsa", Key_Armenian_tsa, 0x056E, true},
+	{"Armenian_KEN", Key_Armenian_KEN, 0x053F, true},
+	{"Armenian_ken", Key_Armenian_ken, 0x056F, true},
+	{"Armenian_HO", Key_Armenian_HO, 0x0540, true},
+	{"Armenian_ho", Key_Armenian_ho, 0x0570, true},
+	{"Armenian_DZA", Key_Armenian_DZA, 0x0541, true},
+	{"Armenian_dza", Key_Armenian_dza, 0x0571, true},
+	{"Armenian_GHAT", Key_Armenian_GHAT, 0x0542, true},
+	{"Armenian_ghat", Key_Armenian_ghat, 0x0572, true},
+	{"Armenian_TCHE", Key_Armenian_TCHE, 0x0543, true},
+	{"Armenian_tche", Key_Armenian_tche, 0x0573, true},
+	{"Armenian_MEN", Key_Armenian_MEN, 0x0544, true},
+	{"Armenian_men", Key_Armenian_men, 0x0574, true},
+	{"Armenian_HI", Key_Armenian_HI, 0x0545, true},
+	{"Armenian_hi", Key_Armenian_hi, 0x0575, true},
+	{"Armenian_NU", Key_Armenian_NU, 0x0546, true},
+	{"Armenian_nu", Key_Armenian_nu, 0x0576, true},
+	{"Armenian_SHA", Key_Armenian_SHA, 0x0547, true},
+	{"Armenian_sha", Key_Armenian_sha, 0x0577, true},
+	{"Armenian_VO", Key_Armenian_VO, 0x0548, true},
+	{"Armenian_vo", Key_Armenian_vo, 0x0578, true},
+	{"Armenian_CHA", Key_Armenian_CHA, 0x0549, true},
+	{"Armenian_cha", Key_Armenian_cha, 0x0579, true},
+	{"Armenian_PE", Key_Armenian_PE, 0x054A, true},
+	{"Armenian_pe", Key_Armenian_pe, 0x057A, true},
+	{"Armenian_JE", Key_Armenian_JE, 0x054B, true},
+	{"Armenian_je", Key_Armenian_je, 0x057B, true},
+	{"Armenian_RA", Key_Armenian_RA, 0x054C, true},
+	{"Armenian_ra", Key_Armenian_ra, 0x057C, true},
+	{"Armenian_SE", Key_Armenian_SE, 0x054D, true},
+	{"Armenian_se", Key_Armenian_se, 0x057D, true},
+	{"Armenian_VEV", Key_Armenian_VEV, 0x054E, true},
+	{"Armenian_vev", Key_Armenian_vev, 0x057E, true},
+	{"Armenian_TYUN", Key_Armenian_TYUN, 0x054F, true},
+	{"Armenian_tyun", Key_Armenian_tyun, 0x057F, true},
+	{"Armenian_RE", Key_Armenian_RE, 0x0550, true},
+	{"Armenian_re", Key_Armenian_re, 0x0580, true},
+	{"Armenian_TSO", Key_Armenian_TSO, 0x0551, true},
+	{"Armenian_tso", Key_Armenian_tso, 0x0581, true},
+	{"Armenian_VYUN", Key_Armenian_VYUN, 0x0552, true},
+	{"Armenian_vyun", Key_Armenian_vyun, 0x0582, true},
+	{"Armenian_PYUR", Key_Armenian_PYUR, 0x0553, true},
+	{"Armenian_pyur", Key_Armenian_pyur, 0x0583, true},
+	{"Armenian_KE", Key_Armenian_KE, 0x0554, true},
+	{"Armenian_ke", Key_Armenian_ke, 0x0584, true},
+	{"Armenian_O", Key_Armenian_O, 0x0555, true},
+	{"Armenian_o", Key_Armenian_o, 0x0585, true},
+	{"Armenian_FE", Key_Armenian_FE, 0x0556, true},
+	{"Armenian_fe", Key_Armenian_fe, 0x0586, true},
+	{"Armenian_apostrophe", Key_Armenian_apostrophe, 0x055A, true},
+	{"Georgian_an", Key_Georgian_an, 0x10D0, true},
+	{"Georgian_ban", Key_Georgian_ban, 0x10D1, true},
+	{"Georgian_gan", Key_Georgian_gan, 0x10D2, true},
+	{"Georgian_don", Key_Georgian_don, 0x10D3, true},
+	{"Georgian_en", Key_Georgian_en, 0x10D4, true},
+	{"Georgian_vin", Key_Georgian_vin, 0x10D5, true},
+	{"Georgian_zen", Key_Georgian_zen, 0x10D6, true},
+	{"Georgian_tan", Key_Georgian_tan, 0x10D7, true},
+	{"Georgian_in", Key_Georgian_in, 0x10D8, true},
+	{"Georgian_kan", Key_Georgian_kan, 0x10D9, true},
+	{"Georgian_las", Key_Georgian_las, 0x10DA, true},
+	{"Georgian_man", Key_Georgian_man, 0x10DB, true},
+	{"Georgian_nar", Key_Georgian_nar, 0x10DC, true},
+	{"Georgian_on", Key_Georgian_on, 0x10DD, true},
+	{"Georgian_par", Key_Georgian_par, 0x10DE, true},
+	{"Georgian_zhar", Key_Georgian_zhar, 0x10DF, true},
+	{"Georgian_rae", Key_Georgian_rae, 0x10E0, true},
+	{"Georgian_san", Key_Georgian_san, 0x10E1, true},
+	{"Georgian_tar", Key_Georgian_tar, 0x10E2, true},
+	{"Georgian_un", Key_Georgian_un, 0x10E3, true},
+	{"Georgian_phar", Key_Georgian_phar, 0x10E4, true},
+	{"Georgian_khar", Key_Georgian_khar, 0x10E5, true},
+	{"Georgian_ghan", Key_Georgian_ghan, 0x10E6, true},
+	{"Georgian_qar", Key_Georgian_qar, 0x10E7, true},
+	{"Georgian_shin", Key_Georgian_shin, 0x10E8, true},
+	{"Georgian_chin", Key_Georgian_chin, 0x10E9, true},
+	{"Georgian_can", Key_Georgian_can, 0x10EA, true},
+	{"Georgian_jil", Key_Georgian_jil, 0x10EB, true},
+	{"Georgian_cil", Key_Georgian_cil, 0x10EC, true},
+	{"Georgian_char", Key_Georgian_char, 0x10ED, true},
+	{"Georgian_xan", Key_Georgian_xan, 0x10EE, true},
+	{"Georgian_jhan", Key_Georgian_jhan, 0x10EF, true},
+	{"Georgian_hae", Key_Georgian_hae, 0x10F0, true},
+	{"Georgian_he", Key_Georgian_he, 0x10F1, true},
+	{"Georgian_hie", Key_Georgian_hie, 0x10F2, true},
+	{"Georgian_we", Key_Georgian_we, 0x10F3, true},
+	{"Georgian_har", Key_Georgian_har, 0x10F4, true},
+	{"Georgian_hoe", Key_Georgian_hoe, 0x10F5, true},
+	{"Georgian_fi", Key_Georgian_fi, 0x10F6, true},
+	{"Xabovedot", Key_Xabovedot, 0x1E8A, true},
+	{"Ibreve", Key_Ibreve, 0x012C, true},
+	{"Zstroke", Key_Zstroke, 0x01B5, true},
+	{"Gcaron", Key_Gcaron, 0x01E6, true},
+	{"Ocaron", Key_Ocaron, 0x01D1, true},
+	{"Obarred", Key_Obarred, 0x019F, true},
+	{"xabovedot", Key_xabovedot, 0x1E8B, true},
+	{"ibreve", Key_ibreve, 0x012D, true},
+	{"zstroke", Key_zstroke, 0x01B6, true},
+	{"gcaron", Key_gcaron, 0x01E7, true},
+	{"ocaron", Key_ocaron, 0x01D2, true},
+	{"obarred", Key_obarred, 0x0275, true},
+	{"SCHWA", Key_SCHWA, 0x018F, true},
+	{"schwa", Key_schwa, 0x0259, true},
+	{"EZH", Key_EZH, 0x01B7, true},
+	{"ezh", Key_ezh, 0x0292, true},
+	{"Lbelowdot", Key_Lbelowdot, 0x1E36, true},
+	{"lbelowdot", Key_lbelowdot, 0x1E37, true},
+	{"Abelowdot", Key_Abelowdot, 0x1EA0, true},
+	{"abelowdot", Key_abelowdot, 0x1EA1, true},
+	{"Ahook", Key_Ahook, 0x1EA2, true},
+	{"ahook", Key_ahook, 0x1EA3, true},
+	{"Acircumflexacute", Key_Acircumflexacute, 0x1EA4, true},
+	{"acircumflexacute", Key_acircumflexacute, 0x1EA5, true},
+	{"Acircumflexgrave", Key_Acircumflexgrave, 0x1EA6, true},
+	{"acircumflexgrave", Key_acircumflexgrave, 0x1EA7, true},
+	{"Acircumflexhook", Key_Acircumflexhook, 0x1EA8, true},
+	{"acircumflexhook", Key_acircumflexhook, 0x1EA9, true},
+	{"Acircumflextilde", Key_Acircumflextilde, 0x1EAA, true},
+	{"acircumflextilde", Key_acircumflextilde, 0x1EAB, true},
+	{"Acircumflexbelowdot", Key_Acircumflexbelowdot, 0x1EAC, true},
+	{"acircumflexbelowdot", Key_acircumflexbelowdot, 0x1EAD, true},
+	{"Abreveacute", Key_Abreveacute, 0x1EAE, true},
+	{"abreveacute", Key_abreveacute, 0x1EAF, true},
+	{"Abrevegrave", Key_Abrevegrave, 0x1EB0, true},
+	{"abrevegrave", Key_abrevegrave, 0x1EB1, true},
+	{"Abrevehook", Key_Abrevehook, 0x1EB2, true},
+	{"abrevehook", Key_abrevehook, 0x1EB3, true},
+	{"Abrevetilde", Key_Abrevetilde, 0x1EB4, true},
+	{"abrevetilde", Key_abrevetilde, 0x1EB5, true},
+	{"Abrevebelowdot", Key_Abrevebelowdot, 0x1EB6, true},
+	{"abrevebelowdot", Key_abrevebelowdot, 0x1EB7, true},
+	{"Ebelowdot", Key_Ebelowdot, 0x1EB8, true},
+	{"ebelowdot", Key_ebelowdot, 0x1EB9, true},
+	{"Ehook", Key_Ehook, 0x1EBA, true},
+	{"ehook", Key_ehook, 0x1EBB, true},
+	{"Etilde", Key_Etilde, 0x1EBC, true},
+	{"etilde", Key_etilde, 0x1EBD, true},
+	{"Ecircumflexacute", Key_Ecircumflexacute, 0x1EBE, true},
+	{"ecircumflexacute", Key_ecircumflexacute, 0x1EBF, true},
+	{"Ecircumflexgrave", Key_Ecircumflexgrave, 0x1EC0, true},
+	{"ecircumflexgrave", Key_ecircumflexgrave, 0x1EC1, true},
+	{"Ecircumflexhook", Key_Ecircumflexhook, 0x1EC2, true},
+	{"ecircumflexhook", Key_ecircumflexhook, 0x1EC3, true},
+	{"Ecircumflextilde", Key_Ecircumflextilde, 0x1EC4, true},
+	{"ecircumflextilde", Key_ecircumflextilde, 0x1EC5, true},
+	{"Ecircumflexbelowdot", Key_Ecircumflexbelowdot, 0x1EC6, true},
+	{"ecircumflexbelowdot", Key_ecircumflexbelowdot, 0x1EC7, true},
+	{"Ihook", Key_Ihook, 0x1EC8, true},
+	{"ihook", Key_ihook, 0x1EC9, true},
+	{"Ibelowdot", Key_Ibelowdot, 0x1ECA, true},
+	{"ibelowdot", Key_ibelowdot, 0x1ECB, true},
+	{"Obelowdot", Key_Obelowdot, 0x1ECC, true},
+	{"obelowdot", Key_obelowdot, 0x1ECD, true},
+	{"Ohook", Key_Ohook, 0x1ECE, true},
+	{"ohook", Key_ohook, 0x1ECF, true},
+	{"Ocircumflexacute", Key_Ocircumflexacute, 0x1ED0, true},
+	{"ocircumflexacute", Key_ocircumflexacute, 0x1ED1, true},
+	{"Ocircumflexgrave", Key_Ocircumflexgrave, 0x1ED2, true},
+	{"ocircumflexgrave", Key_ocircumflexgrave, 0x1ED3, true},
+	{"Ocircumflexhook", Key_Ocircumflexhook, 0x1ED4, true},
+	{"ocircumflexhook", Key_ocircumflexhook, 0x1ED5, true},
+	{"Ocircumflextilde", Key_Ocircumflextilde, 0x1ED6, true},
+	{"ocircumflextilde", Key_ocircumflextilde, 0x1ED7, true},
+	{"Ocircumflexbelowdot", Key_Ocircumflexbelowdot, 0x1ED8, true},
+	{"ocircumflexbelowdot", Key_ocircumflexbelowdot, 0x1ED9, true},
+	{"Ohornacute", Key_Ohornacute, 0x1EDA, true},
+	{"ohornacute", Key_ohornacute, 0x1EDB, true},
+	{"Ohorngrave", Key_Ohorngrave, 0x1EDC, true},
+	{"ohorngrave", Key_ohorngrave, 0x1EDD, true},
+	{"Ohornhook", Key_Ohornhook, 0x1EDE, true},
+	{"ohornhook", Key_ohornhook, 0x1EDF, true},
+	{"Ohorntilde", Key_Ohorntilde, 0x1EE0, true},
+	{"ohorntilde", Key_ohorntilde, 0x1EE1, true},
+	{"Ohornbelowdot", Key_Ohornbelowdot, 0x1EE2, true},
+	{"ohornbelowdot", Key_ohornbelowdot, 0x1EE3, true},
+	{"Ubelowdot", Key_Ubelowdot, 0x1EE4, true},
+	{"ubelowdot", Key_ubelowdot, 0x1EE5, true},
+	{"Uhook", Key_Uhook, 0x1EE6, true},
+	{"uhook", Key_uhook, 0x1EE7, true},
+	{"Uhornacute", Key_Uhornacute, 0x1EE8, true},
+	{"uhornacute", Key_uhornacute, 0x1EE9, true},
+	{"Uhorngrave", Key_Uhorngrave, 0x1EEA, true},
+	{"uhorngrave", Key_uhorngrave, 0x1EEB, true},
+	{"Uhornhook", Key_Uhornhook, 0x1EEC, true},
+	{"uhornhook", Key_uhornhook, 0x1EED, true},
+	{"Uhorntilde", Key_Uhorntilde, 0x1EEE, true},
+	{"uhorntilde", Key_uhorntilde, 0x1EEF, true},
+	{"Uhornbelowdot", Key_Uhornbelowdot, 0x1EF0, true},
+	{"uhornbelowdot", Key_uhornbelowdot, 0x1EF1, true},
+	{"Ybelowdot", Key_Ybelowdot, 0x1EF4, true},
+	{"ybelowdot", Key_ybelowdot, 0x1EF5, true},
+	{"Yhook", Key_Yhook, 0x1EF6, true},
+	{"yhook", Key_yhook, 0x1EF7, true},
+	{"Ytilde", Key_Ytilde, 0x1EF8, true},
+	{"ytilde", Key_ytilde, 0x1EF9, true},
+	{"Ohorn", Key_Ohorn, 0x01A0, true},
+	{"ohorn", Key_ohorn, 0x01A1, true},
+	{"Uhorn", Key_Uhorn, 0x01AF, true},
+	{"uhorn", Key_uhorn, 0x01B0, true},
+	{"combining_tilde", Key_combining_tilde, 0x0303, true},
+	{"combining_grave", Key_combining_grave, 0x0300, true},
+	{"combining_acute", Key_combining_acute, 0x0301, true},
+	{"combining_hook", Key_combining_hook, 0x0309, true},
+	{"combining_belowdot", Key_combining_belowdot, 0x0323, true},
+	{"EcuSign", Key_EcuSign, 0x20A0, true},
+	{"ColonSign", Key_ColonSign, 0x20A1, true},
+	{"CruzeiroSign", Key_CruzeiroSign, 0x20A2, true},
+	{"FFrancSign", Key_FFrancSign, 0x20A3, true},
+	{"LiraSign", Key_LiraSign, 0x20A4, true},
+	{"MillSign", Key_MillSign, 0x20A5, true},
+	{"NairaSign", Key_NairaSign, 0x20A6, true},
+	{"PesetaSign", Key_PesetaSign, 0x20A7, true},
+	{"RupeeSign", Key_RupeeSign, 0x20A8, true},
+	{"WonSign", Key_WonSign, 0x20A9, true},
+	{"NewSheqelSign", Key_NewSheqelSign, 0x20AA, true},
+	{"DongSign", Key_DongSign, 0x20AB, true},
+	{"EuroSign", Key_EuroSign, 0x20AC, true},
+	{"zerosuperior", Key_zerosuperior, 0x2070, true},
+	{"foursuperior", Key_foursuperior, 0x2074, true},
+	{"fivesuperior", Key_fivesuperior, 0x2075, true},
+	{"sixsuperior", Key_sixsuperior, 0x2076, true},
+	{"sevensuperior", Key_sevensuperior, 0x2077, true},
+	{"eightsuperior", Key_eightsuperior, 0x2078, true},
+	{"ninesuperior", Key_ninesuperior, 0x2079, true},
+	{"zerosubscript", Key_zerosubscript, 0x2080, true},
+	{"onesubscript", Key_onesubscript, 0x2081, true},
+	{"twosubscript", Key_twosubscript, 0x2082, true},
+	{"threesubscript", Key_threesubscript, 0x2083, true},
+	{"foursubscript", Key_foursubscript, 0x2084, true},
+	{"fivesubscript", Key_fivesubscript, 0x2085, true},
+	{"sixsubscript", Key_sixsubscript, 0x2086, true},
+	{"sevensubscript", Key_sevensubscript, 0x2087, true},
+	{"eightsubscript", Key_eightsubscript, 0x2088, true},
+	{"ninesubscript", Key_ninesubscript, 0x2089, true},
+	{"partdifferential", Key_partdifferential, 0x2202, true},
+	{"emptyset", Key_emptyset, 0x2205, true},
+	{"elementof", Key_elementof, 0x2208, true},
+	{"notelementof", Key_notelementof, 0x2209, true},
+	{"containsas", Key_containsas, 0x220B, true},
+	{"squareroot", Key_squareroot, 0x221A, true},
+	{"cuberoot", Key_cuberoot, 0x221B, true},
+	{"fourthroot", Key_fourthroot, 0x221C, true},
+	{"dintegral", Key_dintegral, 0x222C, true},
+	{"tintegral", Key_tintegral, 0x222D, true},
+	{"because", Key_because, 0x2235, true},
+	{"approxeq", Key_approxeq, 0x2248, true},
+	{"notapproxeq", Key_notapproxeq, 0x2247, true},
+	{"notidentical", Key_notidentical, 0x2262, true},
+	{"stricteq", Key_stricteq, 0x2263, true},
+	{"braille_dot_1", Key_braille_dot_1, -1, true},
+	{"braille_dot_2", Key_braille_dot_2, -1, true},
+	{"braille_dot_3", Key_braille_dot_3, -1, true},
+	{"braille_dot_4", Key_braille_dot_4, -1, true},
+	{"braille_dot_5", Key_braille_dot_5, -1, true},
+	{"braille_dot_6", Key_braille_dot_6, -1, true},
+	{"braille_dot_7", Key_braille_dot_7, -1, true},
+	{"braille_dot_8", Key_braille_dot_8, -1, true},
+	{"braille_dot_9", Key_braille_dot_9, -1, true},
+	{"braille_dot_10", Key_braille_dot_10, -1, true},
+	{"braille_blank", Key_braille_blank, 0x2800, true},
+	{"braille_dots_1", Key_braille_dots_1, 0x2801, true},
+	{"braille_dots_2", Key_braille_dots_2, 0x2802, true},
+	{"braille_dots_12", Key_braille_dots_12, 0x2803, true},
+	{"braille_dots_3", Key_braille_dots_3, 0x2804, true},
+	{"braille_dots_13", Key_braille_dots_13, 0x2805, true},
+	{"braille_dots_23", Key_braille_dots_23, 0x2806, true},
+	{"braille_dots_123", Key_braille_dots_123, 0x2807, true},
+	{"braille_dots_4", Key_braille_dots_4, 0x2808, true},
+	{"braille_dots_14", Key_braille_dots_14, 0x2809, true},
+	{"braille_dots_24", Key_braille_dots_24, -1, true},
+	{"braille_dots_124", Key_braille_dots_124, -1, true},
+	{"braille_dots_34", Key_braille_dots_34, -1, true},
+	{"braille_dots_134", Key_braille_dots_134, -1, true},
+	{"braille_dots_234", Key_braille_dots_234, -1, true},
+	{"braille_dots_1234", Key_braille_dots_1234, -1, true},
+	{"braille_dots_5", Key_braille_dots_5, 0x2810, true},
+	{"braille_dots_15", Key_braille_dots_15, 0x2811, true},
+	{"braille_dots_25", Key_braille_dots_25, 0x2812, true},
+	{"braille_dots_125", Key_braille_dots_125, 0x2813, true},
+	{"braille_dots_35", Key_braille_dots_35, 0x2814, true},
+	{"braille_dots_135", Key_braille_dots_135, 0x2815, true},
+	{"braille_dots_235", Key_braille_dots_235, 0x2816, true},
+	{"braille_dots_1235", Key_braille_dots_1235, 0x2817, true},
+	{"braille_dots_45", Key_braille_dots_45, 0x2818, true},
+	{"braille_dots_145", Key_braille_dots_145, 0x2819, true},
+	{"braille_dots_245", Key_braille_dots_245, -1, true},
+	{"braille_dots_1245", Key_braille_dots_1245, -1, true},
+	{"braille_dots_345", Key_braille_dots_345, -1, true},
+	{"braille_dots_1345", Key_braille_dots_1345, -1, true},
+	{"braille_dots_2345", Key_braille_dots_2345, -1, true},
+	{"braille_dots_12345", Key_braille_dots_12345, -1, true},
+	{"braille_dots_6", Key_braille_dots_6, 0x2820, true},
+	{"braille_dots_16", Key_braille_dots_16, 0x2821, true},
+	{"braille_dots_26", Key_braille_dots_26, 0x2822, true},
+	{"braille_dots_126", Key_braille_dots_126, 0x2823, true},
+	{"braille_dots_36", Key_braille_dots_36, 0x2824, true},
+	{"braille_dots_136", Key_braille_dots_136, 0x2825, true},
+	{"braille_dots_236", Key_braille_dots_236, 0x2826, true},
+	{"braille_dots_1236", Key_braille_dots_1236, 0x2827, true},
+	{"braille_dots_46", Key_braille_dots_46, 0x2828, true},
+	{"braille_dots_146", Key_braille_dots_146, 0x2829, true},
+	{"braille_dots_246", Key_braille_dots_246, -1, true},
+	{"braille_dots_1246", Key_braille_dots_1246, -1, true},
+	{"braille_dots_346", Key_braille_dots_346, -1, true},
+	{"braille_dots_1346", Key_braille_dots_1346, -1, true},
+	{"braille_dots_2346", Key_braille_dots_2346, -1, true},
+	{"braille_dots_12346", Key_braille_dots_12346, -1, true},
+	{"braille_dots_56", Key_braille_dots_56, 0x2830, true},
+	{"braille_dots_156", Key_braille_dots_156, 0x2831, true},
+	{"braille_dots_256", Key_braille_dots_256, 0x2832, true},
+	{"braille_dots_1256", Key_braille_dots_1256, 0x2833, true},
+	{"braille_dots_356", Key_braille_dots_356, 0x2834, true},
+	{"braille_dots_1356", Key_braille_dots_1356, 0x2835, true},
+	{"braille_dots_2356", Key_braille_dots_2356, 0x2836, true},
+	{"braille_dots_12356", Key_braille_dots_12356, 0x2837, true},
+	{"braille_dots_456", Key_braille_dots_456, 0x2838, true},
+	{"braille_dots_1456", Key_braille_dots_1456, 0x2839, true},
+	{"braille_dots_2456", Key_braille_dots_2456, -1, true},
+	{"braille_dots_12456", Key_braille_dots_12456, -1, true},
+	{"braille_dots_3456", Key_braille_dots_3456, -1, true},
+	{"braille_dots_13456", Key_braille_dots_13456, -1, true},
+	{"braille_dots_23456", Key_braille_dots_23456, -1, true},
+	{"braille_dots_123456", Key_braille_dots_123456, -1, true},
+	{"braille_dots_7", Key_braille_dots_7, 0x2840, true},
+	{"braille_dots_17", Key_braille_dots_17, 0x2841, true},
+	{"braille_dots_27", Key_braille_dots_27, 0x2842, true},
+	{"braille_dots_127", Key_braille_dots_127, 0x2843, true},
+	{"braille_dots_37", Key_braille_dots_37, 0x2844, true},
+	{"braille_dots_137", Key_braille_dots_137, 0x2845, true},
+	{"braille_dots_237", Key_braille_dots_237, 0x2846, true},
+	{"braille_dots_1237", Key_braille_dots_1237, 0x2847, true},
+	{"braille_dots_47", Key_braille_dots_47, 0x2848, true},
+	{"braille_dots_147", Key_braille_dots_147, 0x2849, true},
+	{"braille_dots_247", Key_braille_dots_247, -1, true},
+	{"braille_dots_1247", Key_braille_dots_1247, -1, true},
+	{"braille_dots_347", Key_braille_dots_347, -1, true},
+	{"braille_dots_1347", Key_braille_dots_1347, -1, true},
+	{"braille_dots_2347", Key_braille_dots_2347, -1, true},
+	{"braille_dots_12347", Key_braille_dots_12347, -1, true},
+	{"braille_dots_57", Key_braille_dots_57, 0x2850, true},
+	{"braille_dots_157", Key_braille_dots_157, 0x2851, true},
+	{"braille_dots_257", Key_braille_dots_257, 0x2852, true},
+	{"braille_dots_1257", Key_braille_dots_1257, 0x2853, true},
+	{"braille_dots_357", Key_braille_dots_357, 0x2854, true},
+	{"braille_dots_1357", Key_braille_dots_1357, 0x2855, true},
+	{"braille_dots_2357", Key_braille_dots_2357, 0x2856, true},
+	{"braille_dots_12357", Key_braille_dots_12357, 0x2857, true},
+	{"braille_dots_457", Key_braille_dots_457, 0x2858, true},
+	{"braille_dots_1457", Key_braille_dots_1457, 0x2859, true},
+	{"braille_dots_2457", Key_braille_dots_2457, -1, true},
+	{"braille_dots_12457", Key_braille_dots_12457, -1, true},
+	{"braille_dots_3457", Key_braille_dots_3457, -1, true},
+	{"braille_dots_13457", Key_braille_dots_13457, -1, true},
+	{"braille_dots_23457", Key_braille_dots_23457, -1, true},
+	{"braille_dots_123457", Key_braille_dots_123457, -1, true},
+	{"braille_dots_67", Key_braille_dots_67, 0x2860, true},
+	{"braille_dots_167", Key_braille_dots_167, 0x2861, true},
+	{"braille_dots_267", Key_braille_dots_267, 0x2862, true},
+	{"braille_dots_1267", Key_braille_dots_1267, 0x2863, true},
+	{"braille_dots_367", Key_braille_dots_367, 0x2864, true},
+	{"braille_dots_1367", Key_braille_dots_1367, 0x2865, true},
+	{"braille_dots_2367", Key_braille_dots_2367, 0x2866, true},
+	{"braille_dots_12367", Key_braille_dots_12367, 0x2867, true},
+	{"braille_dots_467", Key_braille_dots_467, 0x2868, true},
+	{"braille_dots_1467", Key_braille_dots_1467, 0x2869, true},
+	{"braille_dots_2467", Key_braille_dots_2467, -1, true},
+	{"braille_dots_12467", Key_braille_dots_12467, -1, true},
+	{"braille_dots_3467", Key_braille_dots_3467, -1, true},
+	{"braille_dots_13467", Key_braille_dots_13467, -1, true},
+	{"braille_dots_23467", Key_braille_dots_23467, -1, true},
+	{"braille_dots_123467", Key_braille_dots_123467, -1, true},
+	{"braille_dots_567", Key_braille_dots_567, 0x2870, true},
+	{"braille_dots_1567", Key_braille_dots_1567, 0x2871, true},
+	{"braille_dots_2567", Key_braille_dots_2567, 0x2872, true},
+	{"braille_dots_12567", Key_braille_dots_12567, 0x2873, true},
+	{"braille_dots_3567", Key_braille_dots_3567, 0x2874, true},
+	{"braille_dots_13567", Key_braille_dots_13567, 0x2875, true},
+	{"braille_dots_23567", Key_braille_dots_23567, 0x2876, true},
+	{"braille_dots_123567", Key_braille_dots_123567, 0x2877, true},
+	{"braille_dots_4567", Key_braille_dots_4567, 0x2878, true},
+	{"braille_dots_14567", Key_braille_dots_14567, 0x2879, true},
+	{"braille_dots_24567", Key_braille_dots_24567, -1, true},
+	{"braille_dots_124567", Key_braille_dots_124567, -1, true},
+	{"braille_dots_34567", Key_braille_dots_34567, -1, true},
+	{"braille_dots_134567", Key_braille_dots_134567, -1, true},
+	{"braille_dots_234567", Key_braille_dots_234567, -1, true},
+	{"braille_dots_1234567", Key_braille_dots_1234567, -1, true},
+	{"braille_dots_8", Key_braille_dots_8, 0x2880, true},
+	{"braille_dots_18", Key_braille_dots_18, 0x2881, true},
+	{"braille_dots_28", Key_braille_dots_28, 0x2882, true},
+	{"braille_dots_128", Key_braille_dots_128, 0x2883, true},
+	{"braille_dots_38", Key_braille_dots_38, 0x2884, true},
+	{"braille_dots_138", Key_braille_dots_138, 0x2885, true},
+	{"braille_dots_238", Key_braille_dots_238, 0x2886, true},
+	{"braille_dots_1238", Key_braille_dots_1238, 0x2887, true},
+	{"braille_dots_48", Key_braille_dots_48, 0x2888, true},
+	{"braille_dots_148", Key_braille_dots_148, 0x2889, true},
+	{"braille_dots_248", Key_braille_dots_248, -1, true},
+	{"braille_dots_1248", Key_braille_dots_1248, -1, true},
+	{"braille_dots_348", Key_braille_dots_348, -1, true},
+	{"braille_dots_1348", Key_braille_dots_1348, -1, true},
+	{"braille_dots_2348", Key_braille_dots_2348, -1, true},
+	{"braille_dots_12348", Key_braille_dots_12348, -1, true},
+	{"braille_dots_58", Key_braille_dots_58, 0x2890, true},
+	{"braille_dots_158", Key_braille_dots_158, 0x2891, true},
+	{"braille_dots_258", Key_braille_dots_258, 0x2892, true},
+	{"braille_dots_1258", Key_braille_dots_1258, 0x2893, true},
+	{"braille_dots_358", Key_braille_dots_358, 0x2894, true},
+	{"braille_dots_1358", Key_braille_dots_1358, 0x2895, true},
+	{"braille_dots_2358", Key_braille_dots_2358, 0x2896, true},
+	{"braille_dots_12358", Key_braille_dots_12358, 0x2897, true},
+	{"braille_dots_458", Key_braille_dots_458, 0x2898, true},
+	{"braille_dots_1458", Key_braille_dots_1458, 0x2899, true},
+	{"braille_dots_2458", Key_braille_dots_2458, -1, true},
+	{"braille_dots_12458", Key_braille_dots_12458, -1, true},
+	{"braille_dots_3458", Key_braille_dots_3458, -1, true},
+	{"braille_dots_13458", Key_braille_dots_13458, -1, true},
+	{"braille_dots_23458", Key_braille_dots_23458, -1, true},
+	{"braille_dots_123458", Key_braille_dots_123458, -1, true},
+	{"braille_dots_68", Key_braille_dots_68, -1, true},
+	{"braille_dots_168", Key_braille_dots_168, -1, true},
+	{"braille_dots_268", Key_braille_dots_268, -1, true},
+	{"braille_dots_1268", Key_braille_dots_1268, -1, true},
+	{"braille_dots_368", Key_braille_dots_368, -1, true},
+	{"braille_dots_1368", Key_braille_dots_1368, -1, true},
+	{"braille_dots_2368", Key_braille_dots_2368, -1, true},
+	{"braille_dots_12368", Key_braille_dots_12368, -1, true},
+	{"braille_dots_468", Key_braille_dots_468, -1, true},
+	{"braille_dots_1468", Key_braille_dots_1468, -1, true},
+	{"braille_dots_2468", Key_braille_dots_2468, -1, true},
+	{"braille_dots_12468", Key_braille_dots_12468, -1, true},
+	{"braille_dots_3468", Key_braille_dots_3468, -1, true},
+	{"braille_dots_13468", Key_braille_dots_13468, -1, true},
+	{"braille_dots_23468", Key_braille_dots_23468, -1, true},
+	{"braille_dots_123468", Key_braille_dots_123468, -1, true},
+	{"braille_dots_568", Key_braille_dots_568, -1, true},
+	{"braille_dots_1568", Key_braille_dots_1568, -1, true},
+	{"braille_dots_2568", Key_braille_dots_2568, -1, true},
+	{"braille_dots_12568", Key_braille_dots_12568, -1, true},
+	{"braille_dots_3568", Key_braille_dots_3568, -1, true},
+	{"braille_dots_13568", Key_braille_dots_13568, -1, true},
+	{"braille_dots_23568", Key_braille_dots_23568, -1, true},
+	{"braille_dots_123568", Key_braille_dots_123568, -1, true},
+	{"braille_dots_4568", Key_braille_dots_4568, -1, true},
+	{"braille_dots_14568", Key_braille_dots_14568, -1, true},
+	{"braille_dots_24568", Key_braille_dots_24568, -1, true},
+	{"braille_dots_124568", Key_braille_dots_124568, -1, true},
+	{"braille_dots_34568", Key_braille_dots_34568, -1, true},
+	{"braille_dots_134568", Key_braille_dots_134568, -1, true},
+	{"braille_dots_234568", Key_braille_dots_234568, -1, true},
+	{"braille_dots_1234568", Key_braille_dots_1234568, -1, true},
+	{"braille_dots_78", Key_braille_dots_78, -1, true},
+	{"braille_dots_178", Key_braille_dots_178, -1, true},
+	{"braille_dots_278", Key_braille_dots_278, -1, true},
+	{"braille_dots_1278", Key_braille_dots_1278, -1, true},
+	{"braille_dots_378", Key_braille_dots_378, -1, true},
+	{"braille_dots_1378", Key_braille_dots_1378, -1, true},
+	{"braille_dots_2378", Key_braille_dots_2378, -1, true},
+	{"braille_dots_12378", Key_braille_dots_12378, -1, true},
+	{"braille_dots_478", Key_braille_dots_478, -1, true},
+	{"braille_dots_1478", Key_braille_dots_1478, -1, true},
+	{"braille_dots_2478", Key_braille_dots_2478, -1, true},
+	{"braille_dots_12478", Key_braille_dots_12478, -1, true},
+	{"braille_dots_3478", Key_braille_dots_3478, -1, true},
+	{"braille_dots_13478", Key_braille_dots_13478, -1, true},
+	{"braille_dots_23478", Key_braille_dots_23478, -1, true},
+	{"braille_dots_123478", Key_braille_dots_123478, -1, true},
+	{"braille_dots_578", Key_braille_dots_578, -1, true},
+	{"braille_dots_1578", Key_braille_dots_1578, -1, true},
+	{"braille_dots_2578", Key_braille_dots_2578, -1, true},
+	{"braille_dots_12578", Key_braille_dots_12578, -1, true},
+	{"braille_dots_3578", Key_braille_dots_3578, -1, true},
+	{"braille_dots_13578", Key_braille_dots_13578, -1, true},
+	{"braille_dots_23578", Key_braille_dots_23578, -1, true},
+	{"braille_dots_123578", Key_braille_dots_123578, -1, true},
+	{"braille_dots_4578", Key_braille_dots_4578, -1, true},
+	{"braille_dots_14578", Key_braille_dots_14578, -1, true},
+	{"braille_dots_24578", Key_braille_dots_24578, -1, true},
+	{"braille_dots_124578", Key_braille_dots_124578, -1, true},
+	{"braille_dots_34578", Key_braille_dots_34578, -1, true},
+	{"braille_dots_134578", Key_braille_dots_134578, -1, true},
+	{"braille_dots_234578", Key_braille_dots_234578, -1, true},
+	{"braille_dots_1234578", Key_braille_dots_1234578, -1, true},
+	{"braille_dots_678", Key_braille_dots_678, -1, true},
+	{"braille_dots_1678", Key_braille_dots_1678, -1, true},
+	{"braille_dots_2678", Key_braille_dots_2678, -1, true},
+	{"braille_dots_12678", Key_braille_dots_12678, -1, true},
+	{"braille_dots_3678", Key_braille_dots_3678, -1, true},
+	{"braille_dots_13678", Key_braille_dots_13678, -1, true},
+	{"braille_dots_23678", Key_braille_dots_23678, -1, true},
+	{"braille_dots_123678", Key_braille_dots_123678, -1, true},
+	{"braille_dots_4678", Key_braille_dots_4678, -1, true},
+	{"braille_dots_14678", Key_braille_dots_14678, -1, true},
+	{"braille_dots_24678", Key_braille_dots_24678, -1, true},
+	{"braille_dots_124678", Key_braille_dots_124678, -1, true},
+	{"braille_dots_34678", Key_braille_dots_34678, -1, true},
+	{"braille_dots_134678", Key_braille_dots_134678, -1, true},
+	{"braille_dots_234678", Key_braille_dots_234678, -1, true},
+	{"braille_dots_1234678", Key_braille_dots_1234678, -1, true},
+	{"braille_dots_5678", Key_braille_dots_5678, -1, true},
+	{"braille_dots_15678", Key_braille_dots_15678, -1, true},
+	{"braille_dots_25678", Key_braille_dots_25678, -1, true},
+	{"braille_dots_125678", Key_braille_dots_125678, -1, true},
+	{"braille_dots_35678", Key_braille_dots_35678, -1, true},
+	{"braille_dots_135678", Key_braille_dots_135678, -1, true},
+	{"braille_dots_235678", Key_braille_dots_235678, -1, true},
+	{"braille_dots_1235678", Key_braille_dots_1235678, -1, true},
+	{"braille_dots_45678", Key_braille_dots_45678, -1, true},
+	{"braille_dots_145678", Key_braille_dots_145678, -1, true},
+	{"braille_dots_245678", Key_braille_dots_245678, -1, true},
+	{"braille_dots_1245678", Key_braille_dots_1245678, -1, true},
+	{"braille_dots_345678", Key_braille_dots_345678, -1, true},
+	{"braille_dots_1345678", Key_braille_dots_1345678, -1, true},
+	{"braille_dots_2345678", Key_braille_dots_2345678, -1, true},
+	{"braille_dots_12345678", Key_braille_dots_12345678, -1, true},
+	{"Sinh_ng", Key_Sinh_ng, 0x0D82, true},
+	{"Sinh_h2", Key_Sinh_h2, 0x0D83, true},
+	{"Sinh_a", Key_Sinh_a, 0x0D85, true},
+	{"Sinh_aa", Key_Sinh_aa, 0x0D86, true},
+	{"Sinh_ae", Key_Sinh_ae, 0x0D87, true},
+	{"Sinh_aee", Key_Sinh_aee, 0x0D88, true},
+	{"Sinh_i", Key_Sinh_i, 0x0D89, true},
+	{"Sinh_ii", Key_Sinh_ii, 0x0D8A, true},
+	{"Sinh_u", Key_Sinh_u, 0x0D8B, true},
+	{"Sinh_uu", Key_Sinh_uu, 0x0D8C, true},
+	{"Sinh_ri", Key_Sinh_ri, 0x0D8D, true},
+	{"Sinh_rii", Key_Sinh_rii, 0x0D8E, true},
+	{"Sinh_lu", Key_Sinh_lu, 0x0D8F, true},
+	{"Sinh_luu", Key_Sinh_luu, 0x0D90, true},
+	{"Sinh_e", Key_Sinh_e, 0x0D91, true},
+	{"Sinh_ee", Key_Sinh_ee, 0x0D92, true},
+	{"Sinh_ai", Key_Sinh_ai, 0x0D93, true},
+	{"Sinh_o", Key_Sinh_o, 0x0D94, true},
+	{"Sinh_oo", Key_Sinh_oo, 0x0D95, true},
+	{"Sinh_au", Key_Sinh_au, 0x0D96, true},
+	{"Sinh_ka", Key_Sinh_ka, 0x0D9A, true},
+	{"Sinh_kha", Key_Sinh_kha, 0x0D9B, true},
+	{"Sinh_ga", Key_Sinh_ga, 0x0D9C, true},
+	{"Sinh_gha", Key_Sinh_gha, 0x0D9D, true},
+	{"Sinh_ng2", Key_Sinh_ng2, 0x0D9E, true},
+	{"Sinh_nga", Key_Sinh_nga, 0x0D9F, true},
+	{"Sinh_ca", Key_Sinh_ca, 0x0DA0, true},
+	{"Sinh_cha", Key_Sinh_cha, 0x0DA1, true},
+	{"Sinh_ja", Key_Sinh_ja, 0x0DA2, true},
+	{"Sinh_jha", Key_Sinh_jha, 0x0DA3, true},
+	{"Sinh_nya", Key_Sinh_nya, 0x0DA4, true},
+	{"Sinh_jnya", Key_Sinh_jnya, 0x0DA5, true},
+	{"Sinh_nja", Key_Sinh_nja, 0x0DA6, true},
+	{"Sinh_tta", Key_Sinh_tta, 0x0DA7, true},
+	{"Sinh_ttha", Key_Sinh_ttha, 0x0DA8, true},
+	{"Sinh_dda", Key_Sinh_dda, 0x0DA9, true},
+	{"Sinh_ddha", Key_Sinh_ddha, 0x0DAA, true},
+	{"Sinh_nna", Key_Sinh_nna, 0x0DAB, true},
+	{"Sinh_ndda", Key_Sinh_ndda, 0x0DAC, true},
+	{"Sinh_tha", Key_Sinh_tha, 0x0DAD, true},
+	{"Sinh_thha", Key_Sinh_thha, 0x0DAE, true},
+	{"Sinh_dha", Key_Sinh_dha, 0x0DAF, true},
+	{"Sinh_dhha", Key_Sinh_dhha, 0x0DB0, true},
+	{"Sinh_na", Key_Sinh_na, 0x0DB1, true},
+	{"Sinh_ndha", Key_Sinh_ndha, 0x0DB3, true},
+	{"Sinh_pa", Key_Sinh_pa, 0x0DB4, true},
+	{"Sinh_pha", Key_Sinh_pha, 0x0DB5, true},
+	{"Sinh_ba", Key_Sinh_ba, 0x0DB6, true},
+	{"Sinh_bha", Key_Sinh_bha, 0x0DB7, true},
+	{"Sinh_ma", Key_Sinh_ma, 0x0DB8, true},
+	{"Sinh_mba", Key_Sinh_mba, 0x0DB9, true},
+	{"Sinh_ya", Key_Sinh_ya, 0x0DBA, true},
+	{"Sinh_ra", Key_Sinh_ra, 0x0DBB, true},
+	{"Sinh_la", Key_Sinh_la, 0x0DBD, true},
+	{"Sinh_va", Key_Sinh_va, 0x0DC0, true},
+	{"Sinh_sha", Key_Sinh_sha, 0x0DC1, true},
+	{"Sinh_ssha", Key_Sinh_ssha, 0x0DC2, true},
+	{"Sinh_sa", Key_Sinh_sa, 0x0DC3, true},
+	{"Sinh_ha", Key_Sinh_ha, 0x0DC4, true},
+	{"Sinh_lla", Key_Sinh_lla, 0x0DC5, true},
+	{"Sinh_fa", Key_Sinh_fa, 0x0DC6, true},
+	{"Sinh_al", Key_Sinh_al, 0x0DCA, true},
+	{"Sinh_aa2", Key_Sinh_aa2, 0x0DCF, true},
+	{"Sinh_ae2", Key_Sinh_ae2, 0x0DD0, true},
+	{"Sinh_aee2", Key_Sinh_aee2, 0x0DD1, true},
+	{"Sinh_i2", Key_Sinh_i2, 0x0DD2, true},
+	{"Sinh_ii2", Key_Sinh_ii2, 0x0DD3, true},
+	{"Sinh_u2", Key_Sinh_u2, 0x0DD4, true},
+	{"Sinh_uu2", Key_Sinh_uu2, 0x0DD6, true},
+	{"Sinh_ru2", Key_Sinh_ru2, 0x0DD8, true},
+	{"Sinh_e2", Key_Sinh_e2, 0x0DD9, true},
+	{"Sinh_ee2", Key_Sinh_ee2, 0x0DDA, true},
+	{"Sinh_ai2", Key_Sinh_ai2, 0x0DDB, true},
+	{"Sinh_o2", Key_Sinh_o2, 0x0DDC, true},
+	{"Sinh_oo2", Key_Sinh_oo2, 0x0DDD, true},
+	{"Sinh_au2", Key_Sinh_au2, 0x0DDE, true},
+	{"Sinh_lu2", Key_Sinh_lu2, 0x0DDF, true},
+	{"Sinh_ruu2", Key_Sinh_ruu2, 0x0DF2, true},
+	{"Sinh_luu2", Key_Sinh_luu2, 0x0DF3, true},
+	{"Sinh_kunddaliya", Key_Sinh_kunddaliya, 0x0DF4, true},
+}
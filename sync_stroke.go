@@ -0,0 +1,83 @@
+package wmutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/xgb/xproto"
+)
+
+// SyncStroke is delivered for Config.SyncStrokes. the keyboard is frozen until Consume or Replay is called, or Config.SyncTimeout passes, which replays the key
+type SyncStroke struct {
+	Stroke Stroke
+	// managed window having the input focus, may be nil
+	Focus *Window
+
+	wm   *Wm
+	time xproto.Timestamp
+	once sync.Once
+	// the timer may fire before it is assigned
+	lock  sync.Mutex
+	timer *time.Timer
+}
+
+// Consume keeps the key from the focused client
+func (s *SyncStroke) Consume() {
+	s.allow(xproto.AllowAsyncKeyboard)
+}
+
+// Replay passes the key to the focused client as if it were not grabbed
+func (s *SyncStroke) Replay() {
+	s.allow(xproto.AllowReplayKeyboard)
+}
+
+func (s *SyncStroke) allow(mode byte) {
+	s.once.Do(func() {
+		s.lock.Lock()
+		if s.timer != nil {
+			s.timer.Stop()
+		}
+		s.lock.Unlock()
+		if err := s.wm.Backend.AllowEvents(mode, s.time).Check(); err != nil {
+			s.wm.logger.Error("allow events", requestAttr("AllowEvents"), errAttr(err))
+		}
+	})
+}
+
+func (w *Wm) newSyncStroke(stroke Stroke, t xproto.Timestamp, timeout time.Duration) *SyncStroke {
+	s := &SyncStroke{
+		Stroke: stroke,
+		Focus:  w.FocusedWindow(),
+		wm:     w,
+		time:   t,
+	}
+	s.lock.Lock()
+	s.timer = time.AfterFunc(timeout, func() {
+		w.logger.Warn("sync stroke timeout, replay", "stroke", stroke)
+		s.Replay()
+	})
+	s.lock.Unlock()
+	return s
+}
+
+// FocusedWindow returns the managed window containing the input focus, or nil
+func (w *Wm) FocusedWindow() *Window {
+	reply, err := w.Backend.GetInputFocus().Reply()
+	if err != nil {
+		w.logger.Error("get input focus", requestAttr("GetInputFocus"), errAttr(err))
+		return nil
+	}
+	id := reply.Focus
+	for id != xproto.WindowNone && id != xproto.InputFocusPointerRoot && id != w.DefaultRootId {
+		if win := w.Window(id); win != nil {
+			return win
+		}
+		tree, err := w.Backend.QueryTree(id).Reply()
+		if err != nil {
+			w.logger.Error("query tree", windowAttr(id), requestAttr("QueryTree"), errAttr(err))
+			return nil
+		}
+		id = tree.Parent
+	}
+	return nil
+}
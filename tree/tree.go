@@ -0,0 +1,605 @@
+package tree
+
+import (
+	"encoding/json"
+	"regexp"
+	"sync"
+
+	"github.com/reusee/wmutil"
+)
+
+type Rect = wmutil.Rect
+
+type Mode int
+
+const (
+	SplitH Mode = iota
+	SplitV
+	Tabbed
+	Stacked
+)
+
+var modeNames = []string{"splith", "splitv", "tabbed", "stacked"}
+
+func (m Mode) String() string {
+	if m >= 0 && int(m) < len(modeNames) {
+		return modeNames[m]
+	}
+	return "unknown"
+}
+
+func (m Mode) MarshalText() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+func (m *Mode) UnmarshalText(text []byte) error {
+	for i, name := range modeNames {
+		if name == string(text) {
+			*m = Mode(i)
+			return nil
+		}
+	}
+	return ef("unknown mode %s", text)
+}
+
+type Direction int
+
+const (
+	Left Direction = iota
+	Right
+	Up
+	Down
+)
+
+// horizontal directions go along SplitH containers, vertical ones along SplitV
+func (d Direction) mode() Mode {
+	if d == Left || d == Right {
+		return SplitH
+	}
+	return SplitV
+}
+
+func (d Direction) forward() bool {
+	return d == Right || d == Down
+}
+
+// Swallow matches windows for placeholder nodes, fields are regular expressions, empty fields match anything
+type Swallow struct {
+	Class    string `json:"class,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	Name     string `json:"name,omitempty"`
+
+	// compiled at first match, fields are not changed after
+	once    sync.Once
+	regexps [3]*regexp.Regexp
+	err     error
+}
+
+func (s *Swallow) Match(win *wmutil.Window) bool {
+	s.once.Do(func() {
+		for i, expr := range []string{s.Class, s.Instance, s.Name} {
+			if expr == "" {
+				continue
+			}
+			s.regexps[i], s.err = regexp.Compile(expr)
+			if s.err != nil {
+				return
+			}
+		}
+	})
+	if s.err != nil {
+		return false
+	}
+	var values [3]string
+	win.ReadLock(func() {
+		values = [3]string{win.Class, win.Instance, win.Name}
+	})
+	for i, re := range s.regexps {
+		if re != nil && !re.MatchString(values[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Node is a container if it has children, a leaf otherwise. a leaf without window is a placeholder
+type Node struct {
+	Parent   *Node          `json:"-"`
+	Children []*Node        `json:"children,omitempty"`
+	Mode     Mode           `json:"mode"`
+	Fraction float64        `json:"fraction"`
+	Window   *wmutil.Window `json:"-"`
+	Swallow  *Swallow       `json:"swallow,omitempty"`
+	// index of last focused child
+	Focus int `json:"focus"`
+}
+
+func (n *Node) IsLeaf() bool {
+	return len(n.Children) == 0 && n.Parent != nil
+}
+
+func (n *Node) index() int {
+	for i, child := range n.Parent.Children {
+		if child == n {
+			return i
+		}
+	}
+	return -1
+}
+
+func (n *Node) walk(fn func(*Node) bool) bool {
+	if !fn(n) {
+		return false
+	}
+	for _, child := range n.Children {
+		if !child.walk(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// clampFocus keeps the focus index in children
+func (n *Node) clampFocus() {
+	if n.Focus >= len(n.Children) {
+		n.Focus = len(n.Children) - 1
+	}
+	if n.Focus < 0 {
+		n.Focus = 0
+	}
+}
+
+// focusedLeaf follows the focus indexes down to a leaf
+func (n *Node) focusedLeaf() *Node {
+	for len(n.Children) > 0 {
+		n.clampFocus()
+		n = n.Children[n.Focus]
+	}
+	return n
+}
+
+func (n *Node) insert(i int, child *Node) {
+	child.Parent = n
+	n.Children = append(n.Children, nil)
+	copy(n.Children[i+1:], n.Children[i:])
+	n.Children[i] = child
+	// new child takes an even share
+	share := 1 / float64(len(n.Children))
+	for _, c := range n.Children {
+		if c != child {
+			c.Fraction *= 1 - share
+		}
+	}
+	child.Fraction = share
+	n.normalize()
+}
+
+func (n *Node) remove(child *Node) {
+	i := child.index()
+	n.Children = append(n.Children[:i], n.Children[i+1:]...)
+	child.Parent = nil
+	if n.Focus >= i && n.Focus > 0 {
+		n.Focus--
+	}
+	n.normalize()
+}
+
+func (n *Node) normalize() {
+	sum := 0.0
+	for _, child := range n.Children {
+		sum += child.Fraction
+	}
+	for _, child := range n.Children {
+		if sum <= 0 {
+			child.Fraction = 1 / float64(len(n.Children))
+		} else {
+			child.Fraction /= sum
+		}
+	}
+}
+
+type Tree struct {
+	Root *Node
+	// focused node, a leaf or a container after FocusParent
+	Focused *Node
+	// reserved height for each tab title in tabbed and stacked containers
+	TitleHeight int
+}
+
+func New() *Tree {
+	root := &Node{
+		Mode: SplitH,
+	}
+	return &Tree{
+		Root:    root,
+		Focused: root,
+	}
+}
+
+func (t *Tree) Find(win *wmutil.Window) (ret *Node) {
+	t.Root.walk(func(n *Node) bool {
+		if n.Window == win {
+			ret = n
+			return false
+		}
+		return true
+	})
+	return
+}
+
+// Insert adds the window to a matching placeholder, or next to the focused node, and focuses it
+func (t *Tree) Insert(win *wmutil.Window) *Node {
+	var node *Node
+	t.Root.walk(func(n *Node) bool {
+		if n.IsLeaf() && n.Window == nil && n.Swallow != nil && n.Swallow.Match(win) {
+			node = n
+			return false
+		}
+		return true
+	})
+	if node != nil {
+		node.Window = win
+	} else {
+		node = &Node{
+			Window: win,
+		}
+		focused := t.Focused
+		if focused == t.Root || focused == nil {
+			t.Root.insert(len(t.Root.Children), node)
+		} else {
+			focused.Parent.insert(focused.index()+1, node)
+		}
+	}
+	t.focus(node)
+	return node
+}
+
+// Remove deletes the window's node, emptied containers are removed too
+func (t *Tree) Remove(win *wmutil.Window) {
+	node := t.Find(win)
+	if node == nil {
+		return
+	}
+	parent := node.Parent
+	parent.remove(node)
+	for parent != t.Root && len(parent.Children) == 0 {
+		p := parent.Parent
+		p.remove(parent)
+		parent = p
+	}
+	t.focus(parent.focusedLeaf())
+}
+
+func (t *Tree) focus(n *Node) {
+	t.Focused = n
+	for n.Parent != nil {
+		n.Parent.Focus = n.index()
+		n = n.Parent
+	}
+}
+
+// FocusedWindow returns the window of the focused leaf, nil if focused node is a container or placeholder
+func (t *Tree) FocusedWindow() *wmutil.Window {
+	if t.Focused == nil {
+		return nil
+	}
+	return t.Focused.Window
+}
+
+func (t *Tree) Focus(win *wmutil.Window) {
+	if node := t.Find(win); node != nil {
+		t.focus(node)
+	}
+}
+
+func (t *Tree) FocusParent() {
+	if t.Focused != nil && t.Focused.Parent != nil {
+		t.Focused = t.Focused.Parent
+	}
+}
+
+func (t *Tree) FocusChild() {
+	if t.Focused != nil && len(t.Focused.Children) > 0 {
+		t.Focused.clampFocus()
+		t.Focused = t.Focused.Children[t.Focused.Focus]
+	}
+}
+
+// FocusDirection moves focus to the nearest leaf in the direction
+func (t *Tree) FocusDirection(dir Direction) {
+	n := t.Focused
+	for n != nil && n.Parent != nil {
+		p := n.Parent
+		i := n.index()
+		if p.Mode == dir.mode() || (p.Mode != SplitH && p.Mode != SplitV && dir.mode() == SplitH) {
+			if dir.forward() && i+1 < len(p.Children) {
+				t.focus(p.Children[i+1].focusedLeaf())
+				return
+			} else if !dir.forward() && i > 0 {
+				t.focus(p.Children[i-1].focusedLeaf())
+				return
+			}
+		}
+		n = p
+	}
+}
+
+// Split makes the focused node a container of mode, holding the node itself
+func (t *Tree) Split(mode Mode) {
+	n := t.Focused
+	if n == nil || n == t.Root {
+		t.Root.Mode = mode
+		return
+	}
+	if len(n.Parent.Children) == 1 {
+		n.Parent.Mode = mode
+		return
+	}
+	container := &Node{
+		Mode:     mode,
+		Fraction: n.Fraction,
+		Parent:   n.Parent,
+	}
+	n.Parent.Children[n.index()] = container
+	n.Parent = container
+	n.Fraction = 1
+	container.Children = []*Node{n}
+}
+
+// SetMode changes the mode of the focused container, or the container of the focused leaf
+func (t *Tree) SetMode(mode Mode) {
+	n := t.Focused
+	if n == nil {
+		return
+	}
+	if len(n.Children) == 0 && n.Parent != nil {
+		n = n.Parent
+	}
+	n.Mode = mode
+}
+
+// Move moves the focused node in the direction, across container borders
+func (t *Tree) Move(dir Direction) {
+	n := t.Focused
+	if n == nil || n.Parent == nil {
+		return
+	}
+	p := n.Parent
+	i := n.index()
+	if p.Mode == dir.mode() {
+		j := i - 1
+		if dir.forward() {
+			j = i + 1
+		}
+		if j >= 0 && j < len(p.Children) {
+			sibling := p.Children[j]
+			if len(sibling.Children) > 0 && (sibling.Mode != dir.mode()) {
+				// enter the sibling container
+				p.remove(n)
+				sibling.insert(len(sibling.Children), n)
+			} else {
+				p.Children[i], p.Children[j] = p.Children[j], p.Children[i]
+			}
+			t.focus(n)
+			t.cleanup(p)
+			return
+		}
+	}
+	// find an ancestor along the direction to move out into
+	child := p
+	for ancestor := p.Parent; ancestor != nil; ancestor = ancestor.Parent {
+		if ancestor.Mode == dir.mode() {
+			index := child.index()
+			if dir.forward() {
+				index++
+			}
+			p.remove(n)
+			ancestor.insert(index, n)
+			t.focus(n)
+			t.cleanup(p)
+			return
+		}
+		child = ancestor
+	}
+	// no ancestor in the orientation, change the root
+	if t.Root.Mode != dir.mode() && p == t.Root && len(p.Children) > 1 {
+		old := &Node{
+			Mode: t.Root.Mode,
+		}
+		t.Root.remove(n)
+		for len(t.Root.Children) > 0 {
+			c := t.Root.Children[0]
+			t.Root.remove(c)
+			old.insert(len(old.Children), c)
+		}
+		t.Root.Mode = dir.mode()
+		t.Root.insert(0, old)
+		index := 0
+		if dir.forward() {
+			index = 1
+		}
+		t.Root.insert(index, n)
+		t.focus(n)
+	}
+}
+
+// cleanup removes empty containers and flattens single-child containers
+func (t *Tree) cleanup(n *Node) {
+	for n != nil && n != t.Root {
+		p := n.Parent
+		if len(n.Children) == 0 {
+			p.remove(n)
+		} else if len(n.Children) == 1 && len(n.Children[0].Children) == 0 {
+			child := n.Children[0]
+			child.Fraction = n.Fraction
+			child.Parent = p
+			p.Children[n.index()] = child
+		}
+		n = p
+	}
+}
+
+// Resize grows the focused node by delta of its parent's size, taking space from siblings
+func (t *Tree) Resize(delta float64) {
+	n := t.Focused
+	if n == nil || n.Parent == nil || len(n.Parent.Children) < 2 {
+		return
+	}
+	const minFraction = 0.05
+	fraction := n.Fraction + delta
+	max := 1 - minFraction*float64(len(n.Parent.Children)-1)
+	if fraction < minFraction {
+		fraction = minFraction
+	} else if fraction > max {
+		fraction = max
+	}
+	rest := 1 - n.Fraction
+	for _, sibling := range n.Parent.Children {
+		if sibling == n {
+			continue
+		}
+		if rest > 0 {
+			sibling.Fraction = sibling.Fraction / rest * (1 - fraction)
+		}
+		if sibling.Fraction < minFraction {
+			sibling.Fraction = minFraction
+		}
+	}
+	n.Fraction = fraction
+	n.Parent.normalize()
+}
+
+type Placement struct {
+	Window *wmutil.Window
+	Rect   Rect
+	// false for windows in background tabs
+	Visible bool
+}
+
+// Arrange computes geometries of windows in the tree
+func (t *Tree) Arrange(area Rect) (ret []Placement) {
+	var arrange func(n *Node, area Rect, visible bool)
+	arrange = func(n *Node, area Rect, visible bool) {
+		if len(n.Children) == 0 {
+			if n.Window != nil {
+				ret = append(ret, Placement{
+					Window:  n.Window,
+					Rect:    area,
+					Visible: visible,
+				})
+			}
+			return
+		}
+		switch n.Mode {
+		case SplitH, SplitV:
+			offset := 0
+			length := area.Width
+			if n.Mode == SplitV {
+				length = area.Height
+			}
+			for i, child := range n.Children {
+				size := int(float64(length) * child.Fraction)
+				if i == len(n.Children)-1 {
+					size = length - offset
+				}
+				r := area
+				if n.Mode == SplitH {
+					r.X += offset
+					r.Width = size
+				} else {
+					r.Y += offset
+					r.Height = size
+				}
+				arrange(child, r, visible)
+				offset += size
+			}
+		case Tabbed, Stacked:
+			titles := t.TitleHeight
+			if n.Mode == Stacked {
+				titles *= len(n.Children)
+			}
+			r := area
+			if r.Height > titles {
+				r.Y += titles
+				r.Height -= titles
+			}
+			for i, child := range n.Children {
+				arrange(child, r, visible && i == n.Focus)
+			}
+		}
+	}
+	arrange(t.Root, area, true)
+	return
+}
+
+// Apply arranges windows in area and raises visible windows over background tabs
+func (t *Tree) Apply(area Rect) error {
+	var b wmutil.Batch
+	for _, p := range t.Arrange(area) {
+		r := p.Rect
+		border := 0
+		p.Window.ReadLock(func() {
+			border = p.Window.Border
+		})
+		width, height := r.Width-border*2, r.Height-border*2
+		if width < 1 {
+			width = 1
+		}
+		if height < 1 {
+			height = 1
+		}
+		b.SetGeometry(p.Window, r.X, r.Y, width, height)
+		if p.Visible {
+			b.Above(p.Window, nil)
+		}
+	}
+	return b.Wait()
+}
+
+// MarshalJSON saves the layout, leaves are saved as placeholders matching the class and instance of their windows
+func (t *Tree) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Root)
+}
+
+func (n *Node) MarshalJSON() ([]byte, error) {
+	type node Node
+	v := node(*n)
+	if n.Window != nil && n.Swallow == nil {
+		n.Window.ReadLock(func() {
+			v.Swallow = &Swallow{
+				Class:    "^" + regexp.QuoteMeta(n.Window.Class) + "$",
+				Instance: "^" + regexp.QuoteMeta(n.Window.Instance) + "$",
+			}
+		})
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON loads a saved layout, all leaves become placeholders
+func (t *Tree) UnmarshalJSON(data []byte) error {
+	root := new(Node)
+	if err := json.Unmarshal(data, root); err != nil {
+		return err
+	}
+	var link func(n *Node) error
+	link = func(n *Node) error {
+		for _, child := range n.Children {
+			child.Parent = n
+			if len(child.Children) == 0 && child.Swallow == nil {
+				return ef("leaf without swallow criteria")
+			}
+			if err := link(child); err != nil {
+				return err
+			}
+		}
+		n.normalize()
+		n.clampFocus()
+		return nil
+	}
+	if err := link(root); err != nil {
+		return err
+	}
+	t.Root = root
+	t.Focused = root
+	return nil
+}
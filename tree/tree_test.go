@@ -0,0 +1,121 @@
+package tree
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/reusee/wmutil"
+)
+
+func newWindow(class string) *wmutil.Window {
+	return &wmutil.Window{
+		RWMutex:  new(sync.RWMutex),
+		Class:    class,
+		Instance: class,
+	}
+}
+
+func TestTree(t *testing.T) {
+	tree := New()
+	a, b, c := newWindow("a"), newWindow("b"), newWindow("c")
+	tree.Insert(a)
+	tree.Insert(b)
+	tree.Split(SplitV)
+	tree.Insert(c)
+	// a | (b / c)
+	area := Rect{X: 0, Y: 0, Width: 100, Height: 100}
+	rects := make(map[*wmutil.Window]Rect)
+	for _, p := range tree.Arrange(area) {
+		rects[p.Window] = p.Rect
+	}
+	if rects[a] != (Rect{X: 0, Y: 0, Width: 50, Height: 100}) ||
+		rects[b] != (Rect{X: 50, Y: 0, Width: 50, Height: 50}) ||
+		rects[c] != (Rect{X: 50, Y: 50, Width: 50, Height: 50}) {
+		t.Fatalf("got %v", rects)
+	}
+
+	tree.FocusDirection(Left)
+	if tree.FocusedWindow() != a {
+		t.Fatal("focus left")
+	}
+	tree.Resize(0.25)
+	if p := tree.Arrange(area)[0]; p.Rect != (Rect{X: 0, Y: 0, Width: 75, Height: 100}) {
+		t.Fatalf("got %v", p.Rect)
+	}
+
+	// tabbed
+	tree.Focus(c)
+	tree.SetMode(Tabbed)
+	for _, p := range tree.Arrange(area) {
+		if p.Window == b && p.Visible {
+			t.Fatal("b should be in background")
+		}
+		if p.Window == c && !p.Visible {
+			t.Fatal("c should be visible")
+		}
+	}
+
+	tree.Remove(c)
+	tree.Remove(b)
+	if len(tree.Root.Children) != 1 || tree.FocusedWindow() != a {
+		t.Fatal("remove")
+	}
+}
+
+func TestMove(t *testing.T) {
+	tree := New()
+	a, b := newWindow("a"), newWindow("b")
+	tree.Insert(a)
+	tree.Insert(b)
+	tree.Move(Left)
+	if tree.Root.Children[0].Window != b {
+		t.Fatal("move left")
+	}
+	tree.Move(Down)
+	if tree.Root.Mode != SplitV || tree.Root.Children[1].Window != b {
+		t.Fatal("move down")
+	}
+}
+
+func TestSwallow(t *testing.T) {
+	tree := New()
+	tree.Insert(newWindow("term"))
+	tree.Insert(newWindow("browser"))
+	data, err := json.Marshal(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loaded := New()
+	if err := json.Unmarshal(data, loaded); err != nil {
+		t.Fatal(err)
+	}
+	browser := newWindow("browser")
+	loaded.Insert(browser)
+	if loaded.Root.Children[1].Window != browser {
+		t.Fatal("should swallow into second slot")
+	}
+	if len(loaded.Root.Children) != 2 {
+		t.Fatal("should not add node")
+	}
+	other := newWindow("other")
+	loaded.Insert(other)
+	if len(loaded.Root.Children) != 3 {
+		t.Fatal("should add node")
+	}
+}
+
+func TestLoadBadFocus(t *testing.T) {
+	loaded := New()
+	if err := json.Unmarshal([]byte(`{"focus":5,"children":[{"swallow":{"class":"a"}}]}`), loaded); err != nil {
+		t.Fatal(err)
+	}
+	loaded.FocusChild()
+	if loaded.Focused != loaded.Root.Children[0] {
+		t.Fatal("should focus the only child")
+	}
+	if s := Mode(-1).String(); s != "unknown" {
+		t.Fatalf("got %s", s)
+	}
+}
@@ -0,0 +1,7 @@
+package tree
+
+import "fmt"
+
+var (
+	ef = fmt.Errorf
+)
@@ -3,6 +3,5 @@ package wmutil
 import "fmt"
 
 var (
-	pt = fmt.Printf
 	ef = fmt.Errorf
 )
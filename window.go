@@ -1,6 +1,8 @@
 package wmutil
 
 import (
+	"strings"
+
 	"github.com/BurntSushi/xgb"
 	"github.com/BurntSushi/xgb/xproto"
 )
@@ -18,9 +20,9 @@ func (w *Window) WriteLock(fn func()) {
 }
 
 func (w *Window) SetPos(x, y int) {
-	if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+	if err := w.wm.Backend.ConfigureWindow(w.Id,
 		xproto.ConfigWindowX|xproto.ConfigWindowY, []uint32{uint32(x), uint32(y)}).Check(); err != nil {
-		w.wm.pt("ERROR: set window position: %v\n", err)
+		w.wm.logger.Error("set window position", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 	} else {
 		w.WriteLock(func() {
 			w.X, w.Y = x, y
@@ -29,9 +31,9 @@ func (w *Window) SetPos(x, y int) {
 }
 
 func (w *Window) SetSize(width, height int) {
-	if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+	if err := w.wm.Backend.ConfigureWindow(w.Id,
 		xproto.ConfigWindowWidth|xproto.ConfigWindowHeight, []uint32{uint32(width), uint32(height)}).Check(); err != nil {
-		w.wm.pt("ERROR: set window size: %v\n", err)
+		w.wm.logger.Error("set window size", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 	} else {
 		w.WriteLock(func() {
 			w.Width, w.Height = width, height
@@ -40,10 +42,10 @@ func (w *Window) SetSize(width, height int) {
 }
 
 func (w *Window) SetGeometry(x, y, width, height int) {
-	if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+	if err := w.wm.Backend.ConfigureWindow(w.Id,
 		xproto.ConfigWindowX|xproto.ConfigWindowY|xproto.ConfigWindowWidth|xproto.ConfigWindowHeight,
 		[]uint32{uint32(x), uint32(y), uint32(width), uint32(height)}).Check(); err != nil {
-		w.wm.pt("ERROR: set window geometry: %v\n", err)
+		w.wm.logger.Error("set window geometry", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 	} else {
 		w.WriteLock(func() {
 			w.X, w.Y, w.Width, w.Height = x, y, width, height
@@ -53,75 +55,75 @@ func (w *Window) SetGeometry(x, y, width, height int) {
 
 func (w *Window) Above(sibling *Window) {
 	if sibling != nil {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowSibling|xproto.ConfigWindowStackMode,
 			[]uint32{uint32(sibling.Id), uint32(xproto.StackModeAbove)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	} else {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowStackMode, []uint32{uint32(xproto.StackModeAbove)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	}
 }
 
 func (w *Window) Below(sibling *Window) {
 	if sibling != nil {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowSibling|xproto.ConfigWindowStackMode,
 			[]uint32{uint32(sibling.Id), uint32(xproto.StackModeBelow)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	} else {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowStackMode, []uint32{uint32(xproto.StackModeBelow)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	}
 }
 
 func (w *Window) TopIf(sibling *Window) {
 	if sibling != nil {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowSibling|xproto.ConfigWindowStackMode,
 			[]uint32{uint32(sibling.Id), uint32(xproto.StackModeTopIf)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	} else {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowStackMode, []uint32{uint32(xproto.StackModeTopIf)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	}
 }
 
 func (w *Window) BottomIf(sibling *Window) {
 	if sibling != nil {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowSibling|xproto.ConfigWindowStackMode,
 			[]uint32{uint32(sibling.Id), uint32(xproto.StackModeBottomIf)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	} else {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowStackMode, []uint32{uint32(xproto.StackModeBottomIf)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	}
 }
 
 func (w *Window) Opposite(sibling *Window) {
 	if sibling != nil {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowSibling|xproto.ConfigWindowStackMode,
 			[]uint32{uint32(sibling.Id), uint32(xproto.StackModeOpposite)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	} else {
-		if err := xproto.ConfigureWindowChecked(w.wm.Conn, w.Id,
+		if err := w.wm.Backend.ConfigureWindow(w.Id,
 			xproto.ConfigWindowStackMode, []uint32{uint32(xproto.StackModeOpposite)}).Check(); err != nil {
-			w.wm.pt("ERROR: set window above: %v\n", err)
+			w.wm.logger.Error("restack window", windowAttr(w.Id), requestAttr("ConfigureWindow"), errAttr(err))
 		}
 	}
 }
@@ -140,45 +142,75 @@ func (w *Window) Destroy() {
 					0, 0, 0, 0, // must be 20-bytes long
 				}),
 			}
-			if err := xproto.SendEventChecked(w.wm.Conn, false, w.Id, xproto.EventMaskNoEvent, string(msg.Bytes())).Check(); err != nil {
-				w.wm.pt("ERROR: send client message: %v\n", err)
+			if err := w.wm.Backend.SendEvent(false, w.Id, xproto.EventMaskNoEvent, string(msg.Bytes())).Check(); err != nil {
+				w.wm.logger.Error("send client message", windowAttr(w.Id), requestAttr("SendEvent"), errAttr(err))
 			}
 			return
 		}
 	}
-	if err := xproto.DestroyWindowChecked(w.wm.Conn, w.Id).Check(); err != nil {
-		w.wm.pt("ERROR: destroy window: %v\n", err)
+	if err := w.wm.Backend.DestroyWindow(w.Id).Check(); err != nil {
+		w.wm.logger.Error("destroy window", windowAttr(w.Id), requestAttr("DestroyWindow"), errAttr(err))
 	}
 }
 
 func (w *Window) WarpPointer() {
-	if err := xproto.WarpPointerChecked(w.wm.Conn, 0, w.Id, 0, 0, 0, 0, 0, 0).Check(); err != nil {
-		w.wm.pt("ERROR: warp pointer: %v\n", err)
+	if err := w.wm.Backend.WarpPointer(0, w.Id, 0, 0, 0, 0, 0, 0).Check(); err != nil {
+		w.wm.logger.Error("warp pointer", windowAttr(w.Id), requestAttr("WarpPointer"), errAttr(err))
 	}
 }
 
 func (wm *Wm) PointingWindow() *Window {
-	reply, err := xproto.QueryPointer(wm.Conn, wm.DefaultRootId).Reply()
+	reply, err := wm.Backend.QueryPointer(wm.DefaultRootId).Reply()
 	if err != nil {
-		wm.pt("ERROR: query pointer: %v\n", err)
-	}
-	win, ok := wm.Windows[reply.Child]
-	if !ok {
+		wm.logger.Error("query pointer", requestAttr("QueryPointer"), errAttr(err))
 		return nil
 	}
-	return win
+	return wm.Window(reply.Child)
+}
+
+// Window returns the managed window of id, or nil
+func (w *Wm) Window(id xproto.Window) *Window {
+	w.windowsLock.RLock()
+	defer w.windowsLock.RUnlock()
+	return w.Windows[id]
+}
+
+// AllWindows returns all managed windows, safe to call from any goroutine
+func (w *Wm) AllWindows() (ret []*Window) {
+	w.windowsLock.RLock()
+	defer w.windowsLock.RUnlock()
+	for _, win := range w.Windows {
+		ret = append(ret, win)
+	}
+	return
 }
 
 func (w *Wm) FocusPointerRoot() {
-	if err := xproto.SetInputFocusChecked(w.Conn, 0, xproto.InputFocusPointerRoot, 0).Check(); err != nil {
-		w.pt("ERROR: set focus to pointer root %v", err)
+	if err := w.Backend.SetInputFocus(0, xproto.InputFocusPointerRoot, 0).Check(); err != nil {
+		w.logger.Error("focus pointer root", requestAttr("SetInputFocus"), errAttr(err))
 	}
 }
 
-func (w *Window) GetStrsProperty(atom xproto.Atom) (ret []string) {
-	reply, err := xproto.GetProperty(w.wm.Conn, false, w.Id, atom, xproto.GetPropertyTypeAny, 0, (1<<32)-1).Reply()
+func (w *Window) propertyCookie(atom xproto.Atom) Cookie[*xproto.GetPropertyReply] {
+	return w.wm.Backend.GetProperty(false, w.Id, atom, xproto.GetPropertyTypeAny, 0, (1<<32)-1)
+}
+
+// propertyReply waits for the cookie, returns nil on error
+func (w *Window) propertyReply(atom xproto.Atom, cookie Cookie[*xproto.GetPropertyReply]) *xproto.GetPropertyReply {
+	reply, err := cookie.Reply()
 	if err != nil {
-		w.wm.pt("ERROR: get window property: %v\n", err)
+		w.wm.logger.Error("get window property", windowAttr(w.Id), atomAttr(w.wm.AtomName(atom)), requestAttr("GetProperty"), errAttr(err))
+		return nil
+	}
+	return reply
+}
+
+func (w *Window) getProperty(atom xproto.Atom) *xproto.GetPropertyReply {
+	return w.propertyReply(atom, w.propertyCookie(atom))
+}
+
+func parseStrs(reply *xproto.GetPropertyReply) (ret []string) {
+	if reply == nil {
 		return
 	}
 	start := 0
@@ -188,44 +220,111 @@ func (w *Window) GetStrsProperty(atom xproto.Atom) (ret []string) {
 			start = i + 1
 		}
 	}
-	if start < int(reply.ValueLen) {
+	if start < len(reply.Value) {
 		ret = append(ret, string(reply.Value[start:]))
 	}
 	return
 }
 
-func (w *Window) GetWindowIdProperty(atom xproto.Atom) xproto.Window {
-	reply, err := xproto.GetProperty(w.wm.Conn, false, w.Id, atom, xproto.GetPropertyTypeAny, 0, (1<<32)-1).Reply()
-	if err != nil {
-		w.wm.pt("ERROR: get window property: %v\n", err)
-		return 0
-	}
-	if len(reply.Value) == 0 {
+func parseWindowId(reply *xproto.GetPropertyReply) xproto.Window {
+	if reply == nil || len(reply.Value) < 4 {
 		return 0
 	}
 	return xproto.Window(xgb.Get32(reply.Value))
 }
 
-func (w *Window) GetAtomsProperty(atom xproto.Atom) (ret []xproto.Atom) {
-	reply, err := xproto.GetProperty(w.wm.Conn, false, w.Id, atom, xproto.GetPropertyTypeAny, 0, (1<<32)-1).Reply()
-	if err != nil {
-		w.wm.pt("ERROR: get window property: %v\n", err)
+func parseAtoms(reply *xproto.GetPropertyReply) (ret []xproto.Atom) {
+	for _, v := range parseUint32s(reply) {
+		ret = append(ret, xproto.Atom(v))
+	}
+	return
+}
+
+func parseUint32s(reply *xproto.GetPropertyReply) (ret []uint32) {
+	if reply == nil {
 		return
 	}
-	for i := uint32(0); i < reply.ValueLen; i += 4 {
-		ret = append(ret, xproto.Atom(xgb.Get32(reply.Value[i*4:])))
+	for i := 0; i+4 <= len(reply.Value); i += 4 {
+		ret = append(ret, xgb.Get32(reply.Value[i:]))
 	}
 	return
 }
 
+func (w *Window) GetStrsProperty(atom xproto.Atom) []string {
+	return parseStrs(w.getProperty(atom))
+}
+
+func (w *Window) GetWindowIdProperty(atom xproto.Atom) xproto.Window {
+	return parseWindowId(w.getProperty(atom))
+}
+
+func (w *Window) GetAtomsProperty(atom xproto.Atom) []xproto.Atom {
+	return parseAtoms(w.getProperty(atom))
+}
+
 func (w *Window) ChangeInt32sProperty(atom, what xproto.Atom, ints ...uint32) {
 	buf := make([]byte, len(ints)*4)
 	for i, integer := range ints {
 		xgb.Put32(buf[i*4:], integer)
 	}
-	err := xproto.ChangePropertyChecked(w.wm.Conn, xproto.PropModeReplace, w.Id, atom, what,
+	err := w.wm.Backend.ChangeProperty(xproto.PropModeReplace, w.Id, atom, what,
 		32, uint32(len(buf)/4), buf).Check()
 	if err != nil {
-		w.wm.pt("ERROR: change window ints property: %v\n", err)
+		w.wm.logger.Error("change window property", windowAttr(w.Id), atomAttr(w.wm.AtomName(atom)), requestAttr("ChangeProperty"), errAttr(err))
+	}
+}
+
+func (w *Window) GetUint32sProperty(atom xproto.Atom) []uint32 {
+	return parseUint32s(w.getProperty(atom))
+}
+
+// readProperties reads properties for rules, placement and clients, in one round trip
+func (w *Window) readProperties() {
+	wm := w.wm
+	cookies := make(map[xproto.Atom]Cookie[*xproto.GetPropertyReply])
+	for _, atom := range []xproto.Atom{
+		xproto.AtomWmClass,
+		xproto.AtomWmTransientFor,
+		xproto.AtomWmNormalHints,
+		wm.Atom("_NET_WM_STRUT_PARTIAL"),
+		wm.Atom("_NET_WM_STRUT"),
+		wm.Atom("WM_WINDOW_ROLE"),
+		wm.Atom("_NET_WM_WINDOW_TYPE"),
+		wm.Atom("_NET_WM_NAME"),
+		xproto.AtomWmName,
+		wm.Atom("WM_PROTOCOLS"),
+	} {
+		cookies[atom] = w.propertyCookie(atom)
+	}
+	property := func(atom xproto.Atom) *xproto.GetPropertyReply {
+		return w.propertyReply(atom, cookies[atom])
+	}
+	classInfo := parseStrs(property(xproto.AtomWmClass))
+	transientFor := parseWindowId(property(xproto.AtomWmTransientFor))
+	normalHints := parseNormalHints(parseUint32s(property(xproto.AtomWmNormalHints)))
+	strut := parseUint32s(property(wm.Atom("_NET_WM_STRUT_PARTIAL")))
+	if len(strut) == 0 {
+		strut = parseUint32s(property(wm.Atom("_NET_WM_STRUT")))
 	}
+	role := strings.Join(parseStrs(property(wm.Atom("WM_WINDOW_ROLE"))), "")
+	types := parseAtoms(property(wm.Atom("_NET_WM_WINDOW_TYPE")))
+	name := strings.Join(parseStrs(property(wm.Atom("_NET_WM_NAME"))), "")
+	if name == "" {
+		name = strings.Join(parseStrs(property(xproto.AtomWmName)), "")
+	}
+	protocols := parseAtoms(property(wm.Atom("WM_PROTOCOLS")))
+	w.WriteLock(func() {
+		if len(classInfo) > 1 {
+			w.Instance = classInfo[0]
+			w.Class = classInfo[1]
+		}
+		w.TransientFor = transientFor
+		w.IsTransient = transientFor != 0
+		w.NormalHints = normalHints
+		w.Strut = parseStrut(strut)
+		w.Role = role
+		w.Types = types
+		w.Name = name
+		w.Protocols = protocols
+	})
 }
@@ -3,27 +3,48 @@ package wmutil
 //go:generate go run gen.go
 
 import (
-	"log"
+	"context"
+	"fmt"
+	"log/slog"
 	"os"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/BurntSushi/xgb"
 	"github.com/BurntSushi/xgb/xproto"
 )
 
 type Wm struct {
+	// nil if Config.Backend is set
 	Conn          *xgb.Conn
+	Backend       Backend
 	Setup         *xproto.SetupInfo
 	DefaultScreen *xproto.ScreenInfo
 	DefaultRootId xproto.Window
 	Windows       map[xproto.Window]*Window
-	CodeToSyms    [][]uint32
-	SymToCodes    map[uint32][]byte
+	CodeToSyms    [][]Keysym
+	SymToCodes    map[Keysym][]byte
+	GrabReport    *GrabReport
+	Metrics       *Metrics
 	stringToAtom  map[string]xproto.Atom
 	atomToString  map[xproto.Atom]string
 
-	logger *log.Logger
+	logger          *slog.Logger
+	atomsLock       sync.Mutex
+	windowsLock     sync.RWMutex
+	numlockModMask  uint16
+	modifierMasks   map[xproto.Keycode]uint16
+	pendingEvents   []xgb.Event
+	coalesceLatency time.Duration
+	rawModifiers    bool
+	hasXinerama     bool
+	configLock      sync.RWMutex
+	config          *wmConfig
+	cascadeLock     sync.Mutex
+	cascadeIndex    int
+	closed          atomic.Bool
 
 	Map         chan *Window
 	Unmap       chan *Window
@@ -31,6 +52,10 @@ type Wm struct {
 	NameChanged chan *Window
 	IconChanged chan *Window
 	Resize      chan ResizeRequest
+	Sequence    chan SequenceEvent
+	Binding     chan BindingEvent
+	SyncStroke  chan *SyncStroke
+	Button      chan ButtonEvent
 }
 
 type ResizeRequest struct {
@@ -46,22 +71,52 @@ type Window struct {
 	X, Y, Width, Height, Border int
 	Mapped                      bool
 	// properties
-	Name        string
-	Icon        string
-	Instance    string
-	Class       string
-	IsTransient bool
-	Protocols   []xproto.Atom
+	Name         string
+	Icon         string
+	Instance     string
+	Class        string
+	IsTransient  bool
+	TransientFor xproto.Window
+	Protocols    []xproto.Atom
+	NormalHints  NormalHints
+	Strut        Strut
+	Role         string
+	Types        []xproto.Atom
+	// actions of matching rules
+	Rule RuleActions
+
+	mappedBefore bool
 }
 
 type Config struct {
-	Logger  *log.Logger
-	Strokes []Stroke
+	// X display like :1, DISPLAY if empty
+	Display string
+	// connection to use instead of Display, like a fake backend in tests
+	Backend Backend
+	// text to stdout at info level if nil, events not handled are logged at debug level
+	Logger          *slog.Logger
+	Strokes         []Stroke
+	Sequences       []Sequence
+	SequenceTimeout time.Duration
+	Bindings        []Binding
+	SyncStrokes     []Stroke
+	// sync strokes not consumed or replayed in time are replayed, default 500ms
+	SyncTimeout time.Duration
+	// placement policy for windows mapped the first time, windows with user-specified position are not moved
+	Placement func(*Window) Placement
+	Rules     Rules
+	Buttons   []ButtonStroke
+	// max time spent merging queued property and configure events, 0 disables merging.
+	// repeated changes of the same window and atom are then handled once, like title updates of terminals
+	CoalesceLatency time.Duration
+	// deliver strokes on Stroke with the modifier state of the event as is, lock, num lock and button masks included
+	RawModifiers bool
 }
 
+// Stroke is a key with modifiers. delivered strokes have lock, num lock and button masks cleared, unless Config.RawModifiers
 type Stroke struct {
 	Modifiers uint16
-	Sym       uint32
+	Sym       Keysym
 }
 
 type ChangeNotify struct {
@@ -75,31 +130,41 @@ func New(config *Config) (*Wm, error) {
 	}
 
 	// connect
-	conn, err := xgb.NewConn()
-	if err != nil {
-		return nil, err
+	backend := config.Backend
+	var conn *xgb.Conn
+	if backend == nil {
+		var err error
+		conn, err = xgb.NewConnDisplay(config.Display)
+		if err != nil {
+			return nil, err
+		}
+		backend = NewXgbBackend(conn)
 	}
+	metrics := newMetrics()
+	backend = &meteredBackend{backend, metrics}
 
 	// infos
-	setup := xproto.Setup(conn)
-	defaultScreen := setup.DefaultScreen(conn)
+	setup := backend.Setup()
+	defaultScreen := backend.DefaultScreen()
 	defaultRootId := defaultScreen.Root
 
 	// grab control
-	if err := xproto.ChangeWindowAttributesChecked(conn, defaultRootId, xproto.CwEventMask, []uint32{uint32(
+	if err := backend.ChangeWindowAttributes(defaultRootId, xproto.CwEventMask, []uint32{uint32(
 		xproto.EventMaskSubstructureRedirect |
 			xproto.EventMaskSubstructureNotify |
 			xproto.EventMaskPropertyChange)}).Check(); err != nil {
+		backend.Close()
 		return nil, ef("another wm is running: %v", err)
 	}
 
 	// read keyboard mapping
-	kmReply, err := xproto.GetKeyboardMapping(conn, 8, 248).Reply()
+	kmReply, err := backend.GetKeyboardMapping(8, 248).Reply()
 	if err != nil {
+		backend.Close()
 		return nil, ef("get keyboard mapping: %v", err)
 	}
-	keycodeToKeysyms := make([][]uint32, 256)
-	keysymToKeycodes := make(map[uint32][]byte)
+	keycodeToKeysyms := make([][]Keysym, 256)
+	keysymToKeycodes := make(map[Keysym][]byte)
 	for keycode := 8; keycode <= 255; keycode++ {
 		start := (keycode - 8) * int(kmReply.KeysymsPerKeycode)
 		keysyms := kmReply.Keysyms[start : start+int(kmReply.KeysymsPerKeycode)]
@@ -107,67 +172,35 @@ func New(config *Config) (*Wm, error) {
 			if sym == 0 {
 				continue
 			}
-			keysym := uint32(sym)
-			keycodeToKeysyms[keycode] = append(keycodeToKeysyms[keycode], uint32(keysym))
+			keysym := Keysym(sym)
+			keycodeToKeysyms[keycode] = append(keycodeToKeysyms[keycode], keysym)
 			keysymToKeycodes[keysym] = append(keysymToKeycodes[keysym], byte(keycode))
 		}
 	}
 
 	// get modifier mask
-	mmReply, err := xproto.GetModifierMapping(conn).Reply()
+	mmReply, err := backend.GetModifierMapping().Reply()
 	if err != nil {
+		backend.Close()
 		return nil, ef("get modifier mapping: %v", err)
 	}
-	symToModMask := func(sym uint32) uint16 {
-		code := keysymToKeycodes[sym][0]
-		index := 0
-	loop:
-		for ; index < 8; index++ {
-			start := index * int(mmReply.KeycodesPerModifier)
-			codes := mmReply.Keycodes[start : start+int(mmReply.KeycodesPerModifier)]
-			for _, c := range codes {
-				if byte(c) == code {
-					break loop
-				}
+	modifierMasks := make(map[xproto.Keycode]uint16)
+	for index := 0; index < 8; index++ {
+		start := index * int(mmReply.KeycodesPerModifier)
+		for _, code := range mmReply.Keycodes[start : start+int(mmReply.KeycodesPerModifier)] {
+			if code != 0 {
+				modifierMasks[code] |= 1 << uint(index)
 			}
 		}
-		return []uint16{
-			xproto.ModMaskShift,
-			xproto.ModMaskLock,
-			xproto.ModMaskControl,
-			xproto.ModMask1,
-			xproto.ModMask2,
-			xproto.ModMask3,
-			xproto.ModMask4,
-			xproto.ModMask5,
-		}[index]
 	}
-	numlockModMask := symToModMask(Key_Num_Lock)
-
-	// grab keys
-	if err := xproto.UngrabKeyChecked(conn, xproto.GrabAny, defaultRootId, xproto.ModMaskAny).Check(); err != nil {
-		return nil, ef("ungrab keys: %v", err)
-	}
-	ignoreModifiers := []uint16{
-		0,
-		xproto.ModMaskLock,
-		numlockModMask,
-		xproto.ModMaskLock | numlockModMask,
+	var numlockModMask uint16
+	for _, code := range keysymToKeycodes[Key_Num_Lock] {
+		numlockModMask |= modifierMasks[xproto.Keycode(code)]
 	}
-	for _, stroke := range config.Strokes {
-		for _, mod := range ignoreModifiers {
-			keycodes := keysymToKeycodes[stroke.Sym]
-			for _, code := range keycodes {
-				if err := xproto.GrabKeyChecked(conn, true, defaultRootId, stroke.Modifiers|mod,
-					xproto.Keycode(code), xproto.GrabModeAsync, xproto.GrabModeAsync).Check(); err != nil {
-					return nil, ef("grab key: %v", err)
-				}
-			}
-		}
-	}
-
 	wm := &Wm{
 		Conn:          conn,
+		Backend:       backend,
+		Metrics:       metrics,
 		Setup:         setup,
 		DefaultScreen: defaultScreen,
 		DefaultRootId: defaultRootId,
@@ -182,14 +215,32 @@ func New(config *Config) (*Wm, error) {
 		NameChanged:   make(chan *Window),
 		IconChanged:   make(chan *Window),
 		Resize:        make(chan ResizeRequest),
+		Sequence:      make(chan SequenceEvent),
+		Binding:       make(chan BindingEvent),
+		SyncStroke:    make(chan *SyncStroke),
+		Button:        make(chan ButtonEvent),
+
+		numlockModMask:  numlockModMask,
+		modifierMasks:   modifierMasks,
+		coalesceLatency: config.CoalesceLatency,
+		rawModifiers:    config.RawModifiers,
 	}
+	_, err = backend.QueryScreens().Reply()
+	wm.hasXinerama = err == nil
 	if config.Logger == nil {
-		wm.logger = log.New(os.Stdout, "==|>", log.Lmicroseconds)
+		wm.logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
 	} else {
 		wm.logger = config.Logger
 	}
+	if err := wm.InternAtoms(atomNames...); err != nil {
+		backend.Close()
+		return nil, err
+	}
+	// grab keys and buttons
+	wm.GrabReport = wm.Reconfigure(config)
 	// set supported ewmh hints
 	if err := wm.setSupported(); err != nil {
+		backend.Close()
 		return nil, err
 	}
 
@@ -198,31 +249,62 @@ func New(config *Config) (*Wm, error) {
 }
 
 func (w *Wm) Close() {
-	xproto.ChangeWindowAttributes(w.Conn, w.DefaultRootId, xproto.CwEventMask, []uint32{uint32(
-		xproto.EventMaskNoEvent)})
-	w.Conn.Close()
+	w.closed.Store(true)
+	if err := w.Backend.ChangeWindowAttributes(w.DefaultRootId, xproto.CwEventMask, []uint32{uint32(
+		xproto.EventMaskNoEvent)}).Check(); err != nil {
+		w.logger.Error("clear root event mask", requestAttr("ChangeWindowAttributes"), errAttr(err))
+	}
+	w.Backend.Close()
 }
 
-func (w *Wm) pt(format string, args ...interface{}) {
-	w.logger.Printf(format, args...)
+// cleanModifiers drops lock, numlock and button masks
+func (w *Wm) cleanModifiers(state uint16) uint16 {
+	return state &^ (xproto.ModMaskLock | w.numlockModMask) & 0xff
+}
+
+func (w *Wm) nextEvent() (xgb.Event, xgb.Error) {
+	if len(w.pendingEvents) > 0 {
+		ev := w.pendingEvents[0]
+		w.pendingEvents = w.pendingEvents[1:]
+		return ev, nil
+	}
+	ev, xerr := w.Backend.WaitForEvent()
+	if ev != nil && w.coalesceLatency > 0 && coalescable(ev) {
+		ev = w.coalesce(ev)
+	}
+	return ev, xerr
 }
 
 func (w *Wm) loop() {
+	var last string
+	var start time.Time
 	for {
-		ev, xerr := w.Conn.WaitForEvent()
+		if last != "" {
+			observe(w.Metrics.EventTime, last, time.Since(start))
+			last = ""
+		}
+		ev, xerr := w.nextEvent()
 		if ev == nil && xerr == nil {
-			w.logger.Fatal("bad conn")
+			if w.closed.Load() {
+				return
+			}
+			w.logger.Error("connection closed")
+			os.Exit(1)
 		}
 
 		if xerr != nil {
-			w.pt("ERROR: %v\n", xerr)
+			w.logger.Error("x error", errAttr(xerr))
+			w.Metrics.countError(xerr)
 		}
 
 		if ev != nil {
+			last = eventName(ev)
+			start = time.Now()
+			w.Metrics.Events.Add(last, 1)
 			switch ev := ev.(type) {
 
 			case xproto.ClientMessageEvent:
-				w.pt("client message %s\n", w.AtomName(ev.Type))
+				w.logger.Debug("client message", windowAttr(ev.Window), atomAttr(w.AtomName(ev.Type)))
 
 			case xproto.CreateNotifyEvent:
 				if ev.OverrideRedirect { // do not manage override-redirect windows
@@ -239,25 +321,20 @@ func (w *Wm) loop() {
 					Height:  int(ev.Height),
 					Border:  int(ev.BorderWidth),
 				}
-				w.Windows[win.Id] = win
+				// requests are sent before waiting for any reply
+				batch := new(Batch)
 				// set event mask
-				if err := xproto.ChangeWindowAttributesChecked(w.Conn, win.Id, xproto.CwEventMask, []uint32{uint32(
-					xproto.EventMaskPropertyChange)}).Check(); err != nil {
-					w.pt("ERROR: set window event mask: %v\n", err)
-				}
-				// get class info
-				classInfo := win.GetStrsProperty(xproto.AtomWmClass)
-				if len(classInfo) > 0 {
-					win.Instance = classInfo[0]
-					win.Class = classInfo[1]
-				}
-				// whether transient window
-				transientFor := win.GetWindowIdProperty(xproto.AtomWmTransientFor)
-				win.IsTransient = transientFor != 0
+				batch.add(win, "ChangeWindowAttributes", "set window event mask",
+					w.Backend.ChangeWindowAttributes(win.Id, xproto.CwEventMask, []uint32{uint32(
+						xproto.EventMaskPropertyChange)}), nil)
 				// change WM_STATE
-				win.ChangeInt32sProperty(w.Atom("WM_STATE"), w.Atom("WM_STATE"), 1) // icccm NormalState
-				// get protocols
-				win.Protocols = win.GetAtomsProperty(w.Atom("WM_PROTOCOLS"))
+				batch.ChangeInt32sProperty(win, w.Atom("WM_STATE"), w.Atom("WM_STATE"), 1) // icccm NormalState
+				win.readProperties()
+				batch.Wait()
+				// visible to other goroutines after filled
+				w.windowsLock.Lock()
+				w.Windows[win.Id] = win
+				w.windowsLock.Unlock()
 
 			case xproto.ConfigureRequestEvent:
 				if win, ok := w.Windows[ev.Window]; ok && win.Mapped { // managed and mapped window
@@ -270,7 +347,9 @@ func (w *Wm) loop() {
 							Width:  uint16(win.Width),
 							Height: uint16(win.Height),
 						}
-						xproto.SendEvent(w.Conn, false, win.Id, xproto.EventMaskStructureNotify, string(notifyEv.Bytes()))
+						if err := w.Backend.SendEvent(false, win.Id, xproto.EventMaskStructureNotify, string(notifyEv.Bytes())).Check(); err != nil {
+							w.logger.Error("send configure notify", windowAttr(win.Id), requestAttr("SendEvent"), errAttr(err))
+						}
 					})
 					// send fixed-sized window resize notify TODO
 					var width, height int
@@ -281,11 +360,11 @@ func (w *Wm) loop() {
 						height = int(ev.Height)
 					}
 					if width > 0 || height > 0 {
-						w.Resize <- ResizeRequest{
+						send(w, "Resize", w.Resize, ResizeRequest{
 							Width:  width,
 							Height: height,
 							Window: win,
-						}
+						})
 					}
 				} else { // configure as requested
 					var vals []uint32
@@ -311,17 +390,38 @@ func (w *Wm) loop() {
 					if xproto.ConfigWindowStackMode&flags > 0 {
 						vals = append(vals, uint32(ev.StackMode))
 					}
-					xproto.ConfigureWindow(w.Conn, ev.Window, flags, vals)
+					if err := w.Backend.ConfigureWindow(ev.Window, flags, vals).Check(); err != nil {
+						w.logger.Error("configure window as requested", windowAttr(ev.Window), requestAttr("ConfigureWindow"), errAttr(err))
+					}
 				}
 			case xproto.ConfigureNotifyEvent:
 
 			case xproto.MapRequestEvent:
-				xproto.MapWindow(w.Conn, ev.Window)
+				if win, ok := w.Windows[ev.Window]; ok && !win.mappedBefore {
+					win.mappedBefore = true
+					// clients may set properties between creating and mapping the window
+					win.readProperties()
+					config := w.currentConfig()
+					var batch Batch
+					place := true
+					if len(config.rules) > 0 {
+						place = w.applyRules(&batch, win, config.rules)
+					}
+					if place && config.placement != nil && win.NormalHints.Flags&HintUSPosition == 0 {
+						w.place(&batch, win, config.placement(win))
+					}
+					batch.Wait()
+				}
+				if err := w.Backend.MapWindow(ev.Window).Check(); err != nil {
+					w.logger.Error("map window", windowAttr(ev.Window), requestAttr("MapWindow"), errAttr(err))
+				}
 				if win, ok := w.Windows[ev.Window]; ok {
 					win.WriteLock(func() {
 						win.Mapped = true
 					})
-					w.Map <- win
+					if !win.Rule.Ignore {
+						send(w, "Map", w.Map, win)
+					}
 				}
 			case xproto.MapNotifyEvent:
 
@@ -330,18 +430,67 @@ func (w *Wm) loop() {
 					win.WriteLock(func() {
 						win.Mapped = false
 					})
-					w.Unmap <- win
+					if !win.Rule.Ignore {
+						send(w, "Unmap", w.Unmap, win)
+					}
 				}
 
 			case xproto.DestroyNotifyEvent:
+				w.windowsLock.Lock()
+				win, ok := w.Windows[ev.Window]
 				delete(w.Windows, ev.Window)
+				w.windowsLock.Unlock()
+				// layouts holding the window drop it when not mapped
+				if ok {
+					win.WriteLock(func() {
+						win.Mapped = false
+					})
+				}
 
 			case xproto.KeyPressEvent:
-				w.Stroke <- Stroke{
-					Modifiers: ev.State,
+				config := w.currentConfig()
+				// the keyboard is frozen until the sync stroke is allowed. keys pressed in a sequence come from its keyboard grab instead
+				if stroke, ok := config.syncKeys[keyGrab{ev.Detail, w.cleanModifiers(ev.State)}]; ok && !config.sequencer.active() {
+					send(w, "SyncStroke", w.SyncStroke, w.newSyncStroke(stroke, ev.Time, config.syncTimeout))
+					continue
+				}
+				if len(w.CodeToSyms[ev.Detail]) == 0 {
+					continue
+				}
+				stroke := Stroke{
+					Modifiers: w.cleanModifiers(ev.State),
 					Sym:       w.CodeToSyms[ev.Detail][0],
 				}
+				if w.feedSequence(stroke, w.modifierMasks[ev.Detail] != 0) {
+					continue
+				}
+				if w.pressBinding(stroke, ev.Detail) {
+					continue
+				}
+				if w.rawModifiers {
+					stroke.Modifiers = ev.State
+				}
+				send(w, "Stroke", w.Stroke, stroke)
 			case xproto.KeyReleaseEvent:
+				if !w.isHeldKey(ev.Detail) || w.isAutorepeat(ev) {
+					continue
+				}
+				w.releaseBinding(ev.Detail)
+
+			case xproto.ButtonPressEvent:
+				stroke := ButtonStroke{
+					Modifiers: w.cleanModifiers(ev.State),
+					Button:    byte(ev.Detail),
+				}
+				if !w.currentConfig().buttons[stroke] {
+					continue
+				}
+				send(w, "Button", w.Button, ButtonEvent{
+					ButtonStroke: stroke,
+					Window:       w.Windows[ev.Child],
+					X:            int(ev.RootX),
+					Y:            int(ev.RootY),
+				})
 
 			case xproto.PropertyNotifyEvent:
 				win, ok := w.Windows[ev.Window]
@@ -354,31 +503,44 @@ func (w *Wm) loop() {
 					win.WriteLock(func() {
 						win.Name = strings.Join(names, "")
 					})
-					w.NameChanged <- win
+					send(w, "NameChanged", w.NameChanged, win)
 				case w.Atom("_NET_WM_NAME"):
 					names := win.GetStrsProperty(ev.Atom)
 					win.WriteLock(func() {
 						win.Name = strings.Join(names, "")
 					})
-					w.NameChanged <- win
+					send(w, "NameChanged", w.NameChanged, win)
 				case xproto.AtomWmIconName:
 					names := win.GetStrsProperty(ev.Atom)
 					win.WriteLock(func() {
 						win.Icon = strings.Join(names, "")
 					})
-					w.IconChanged <- win
+					send(w, "IconChanged", w.IconChanged, win)
 				case w.Atom("_NET_WM_ICON_NAME"):
 					names := win.GetStrsProperty(ev.Atom)
 					win.WriteLock(func() {
 						win.Icon = strings.Join(names, "")
 					})
-					w.IconChanged <- win
+					send(w, "IconChanged", w.IconChanged, win)
+				case xproto.AtomWmNormalHints:
+					hints := parseNormalHints(win.GetUint32sProperty(ev.Atom))
+					win.WriteLock(func() {
+						win.NormalHints = hints
+					})
+				case w.Atom("_NET_WM_STRUT"), w.Atom("_NET_WM_STRUT_PARTIAL"):
+					strut := w.getStrut(win)
+					win.WriteLock(func() {
+						win.Strut = strut
+					})
 				default:
-					w.pt("property notify %s %v\n", w.AtomName(ev.Atom), ev)
+					// AtomName may be a round trip
+					if w.logger.Enabled(context.Background(), slog.LevelDebug) {
+						w.logger.Debug("property notify", windowAttr(ev.Window), atomAttr(w.AtomName(ev.Atom)))
+					}
 				}
 
 			default:
-				w.pt("EVENT: %T %v\n", ev, ev)
+				w.logger.Debug("event", "type", fmt.Sprintf("%T", ev), "event", ev)
 			}
 		}
 	}
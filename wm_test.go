@@ -1,6 +1,7 @@
 package wmutil
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -17,7 +18,11 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
+// TestConnect is an interactive session on the current display, run with WMUTIL_INTERACTIVE=1
 func TestConnect(t *testing.T) {
+	if os.Getenv("WMUTIL_INTERACTIVE") == "" {
+		t.Skip("interactive")
+	}
 	wm, err := New(&Config{
 		Strokes: []Stroke{
 			{xproto.ModMaskControl, Key_F},
@@ -38,7 +43,7 @@ func TestConnect(t *testing.T) {
 	for {
 		select {
 		case win := <-wm.Map:
-			pt("window %v mapped instance %s class %s\n", win, win.Instance, win.Class)
+			fmt.Printf("window %v mapped instance %s class %s\n", win, win.Instance, win.Class)
 			n := len(windows)
 			if n > 1 {
 				win.SetGeometry(n*50, n*50, 500, 100)
@@ -49,9 +54,9 @@ func TestConnect(t *testing.T) {
 			windows = append(windows, win)
 			win.WarpPointer()
 		case win := <-wm.Unmap:
-			pt("window unmap %v\n", win)
+			fmt.Printf("window unmap %v\n", win)
 		case stroke := <-wm.Stroke:
-			pt("stroke %v\n", stroke)
+			fmt.Printf("stroke %v\n", stroke)
 			switch stroke.Sym {
 			case Key_F1:
 				exec.Command("sakura").Start()
@@ -62,7 +67,7 @@ func TestConnect(t *testing.T) {
 			case Key_F4:
 				win := wm.PointingWindow()
 				if win != nil {
-					pt("destroy %v\n", win.Id)
+					fmt.Printf("destroy %v\n", win.Id)
 					win.Destroy()
 				}
 			case Key_F5:
@@ -70,11 +75,11 @@ func TestConnect(t *testing.T) {
 			}
 		case win := <-wm.NameChanged:
 			win.ReadLock(func() {
-				pt("window name: %v\n", win.Name)
+				fmt.Printf("window name: %v\n", win.Name)
 			})
 		case win := <-wm.IconChanged:
 			win.ReadLock(func() {
-				pt("window icon: %v\n", win.Icon)
+				fmt.Printf("window icon: %v\n", win.Icon)
 			})
 		case <-wm.Resize:
 		case <-testSigs:
@@ -0,0 +1,106 @@
+package wmtest
+
+import (
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/BurntSushi/xgb/xtest"
+	"github.com/reusee/wmutil"
+)
+
+// input is synthesized with the XTEST extension on the client connection, as if the user typed
+func (e *Env) initXTest() {
+	e.T.Helper()
+	if e.xtest {
+		return
+	}
+	e.check(xtest.Init(e.Conn))
+	e.xtest = true
+}
+
+func (e *Env) fakeInput(typ byte, detail byte, x, y int) {
+	e.T.Helper()
+	e.initXTest()
+	e.check(xtest.FakeInputChecked(e.Conn, typ, detail, 0, e.Root, int16(x), int16(y), 0).Check())
+}
+
+// Keycode resolves the keysym with Wm.SymToCodes
+func (e *Env) Keycode(sym wmutil.Keysym) byte {
+	e.T.Helper()
+	codes := e.Wm.SymToCodes[sym]
+	if len(codes) == 0 {
+		e.T.Fatalf("keysym %v not on keyboard", sym)
+	}
+	return codes[0]
+}
+
+func (e *Env) KeyDown(sym wmutil.Keysym) {
+	e.T.Helper()
+	e.fakeInput(xproto.KeyPress, e.Keycode(sym), 0, 0)
+	e.Sync()
+}
+
+func (e *Env) KeyUp(sym wmutil.Keysym) {
+	e.T.Helper()
+	e.fakeInput(xproto.KeyRelease, e.Keycode(sym), 0, 0)
+	e.Sync()
+}
+
+// modifierKeycodes returns a keycode for each modifier bit of mask, from the modifier mapping of the server
+func (e *Env) modifierKeycodes(mask uint16) []byte {
+	e.T.Helper()
+	reply, err := xproto.GetModifierMapping(e.Conn).Reply()
+	e.check(err)
+	n := int(reply.KeycodesPerModifier)
+	var codes []byte
+	for i := 0; i < 8; i++ {
+		if mask&(1<<i) == 0 {
+			continue
+		}
+		code := byte(0)
+		for _, c := range reply.Keycodes[i*n : (i+1)*n] {
+			if c != 0 {
+				code = byte(c)
+				break
+			}
+		}
+		if code == 0 {
+			e.T.Fatalf("no key for modifier mask %x", 1<<i)
+		}
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+// Press presses the modifier keys and the key of the stroke, then releases them in reverse order
+func (e *Env) Press(stroke wmutil.Stroke) {
+	e.T.Helper()
+	codes := append(e.modifierKeycodes(stroke.Modifiers), e.Keycode(stroke.Sym))
+	for _, code := range codes {
+		e.fakeInput(xproto.KeyPress, code, 0, 0)
+	}
+	for i := len(codes) - 1; i >= 0; i-- {
+		e.fakeInput(xproto.KeyRelease, codes[i], 0, 0)
+	}
+	e.Sync()
+}
+
+// MovePointer warps the pointer to the root coordinates
+func (e *Env) MovePointer(x, y int) {
+	e.T.Helper()
+	e.fakeInput(xproto.MotionNotify, 0, x, y)
+	e.Sync()
+}
+
+// Click presses and releases the button with modifier keys held
+func (e *Env) Click(stroke wmutil.ButtonStroke) {
+	e.T.Helper()
+	codes := e.modifierKeycodes(stroke.Modifiers)
+	for _, code := range codes {
+		e.fakeInput(xproto.KeyPress, code, 0, 0)
+	}
+	e.fakeInput(xproto.ButtonPress, stroke.Button, 0, 0)
+	e.fakeInput(xproto.ButtonRelease, stroke.Button, 0, 0)
+	for i := len(codes) - 1; i >= 0; i-- {
+		e.fakeInput(xproto.KeyRelease, codes[i], 0, 0)
+	}
+	e.Sync()
+}
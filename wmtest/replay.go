@@ -0,0 +1,100 @@
+package wmtest
+
+import (
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+	"github.com/reusee/wmutil/record"
+)
+
+// ReplayTarget returns a target replaying recordings with the client connection and XTEST
+func (e *Env) ReplayTarget() record.Target {
+	return replayTarget{e}
+}
+
+type replayTarget struct {
+	e *Env
+}
+
+func (r replayTarget) property(p *record.Property) property {
+	data := p.Data
+	if p.Atoms != nil {
+		var atoms []uint32
+		for _, name := range p.Atoms {
+			atoms = append(atoms, uint32(r.e.Atom(name)))
+		}
+		data = uint32sBytes(atoms...)
+	}
+	return property{r.e.Atom(p.Name), r.e.Atom(p.Type), p.Format, data}
+}
+
+func (r replayTarget) CreateWindow(x, y, width, height, border int, overrideRedirect bool, properties []*record.Property) xproto.Window {
+	r.e.T.Helper()
+	var props []property
+	for _, p := range properties {
+		props = append(props, r.property(p))
+	}
+	return r.e.createWindow(x, y, width, height, border, overrideRedirect, props)
+}
+
+func (r replayTarget) Map(id xproto.Window) {
+	r.e.T.Helper()
+	r.e.MapWindow(id)
+}
+
+func (r replayTarget) Unmap(id xproto.Window) {
+	r.e.T.Helper()
+	r.e.UnmapWindow(id)
+}
+
+func (r replayTarget) Destroy(id xproto.Window) {
+	r.e.T.Helper()
+	r.e.DestroyWindow(id)
+}
+
+func (r replayTarget) Configure(id xproto.Window, x, y, width, height int) {
+	r.e.T.Helper()
+	r.e.check(xproto.ConfigureWindowChecked(r.e.Conn, id,
+		xproto.ConfigWindowX|xproto.ConfigWindowY|xproto.ConfigWindowWidth|xproto.ConfigWindowHeight,
+		[]uint32{uint32(x), uint32(y), uint32(width), uint32(height)}).Check())
+}
+
+func (r replayTarget) SetProperty(id xproto.Window, p *record.Property) {
+	r.e.T.Helper()
+	prop := r.property(p)
+	r.e.changeProperty(id, prop.atom, prop.typ, prop.format, prop.data)
+	r.e.Sync()
+}
+
+// lock states are not replayed
+const lockMask = xproto.ModMaskLock | xproto.ModMask2
+
+func (r replayTarget) KeyPress(sym wmutil.Keysym, state uint16) {
+	r.e.T.Helper()
+	for _, code := range r.e.modifierKeycodes(state &^ lockMask) {
+		r.e.fakeInput(xproto.KeyPress, code, 0, 0)
+	}
+	r.e.fakeInput(xproto.KeyPress, r.e.Keycode(sym), 0, 0)
+	r.e.Sync()
+}
+
+func (r replayTarget) KeyRelease(sym wmutil.Keysym, state uint16) {
+	r.e.T.Helper()
+	r.e.fakeInput(xproto.KeyRelease, r.e.Keycode(sym), 0, 0)
+	codes := r.e.modifierKeycodes(state &^ lockMask)
+	for i := len(codes) - 1; i >= 0; i-- {
+		r.e.fakeInput(xproto.KeyRelease, codes[i], 0, 0)
+	}
+	r.e.Sync()
+}
+
+func (r replayTarget) ButtonPress(x, y int, button byte, state uint16) {
+	r.e.T.Helper()
+	r.e.MovePointer(x, y)
+	r.e.Click(wmutil.ButtonStroke{Modifiers: state &^ lockMask, Button: button})
+}
+
+// Wait only waits for the server, the wm may still be handling events
+func (r replayTarget) Wait() {
+	r.e.T.Helper()
+	r.e.Sync()
+}
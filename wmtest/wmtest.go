@@ -0,0 +1,291 @@
+package wmtest
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/xgb"
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+// Env is a Wm running on a private Xvfb, with a client connection to create windows
+type Env struct {
+	T    testing.TB
+	X    *Xvfb
+	Wm   *wmutil.Wm
+	Conn *xgb.Conn
+	Root xproto.Window
+	// for Expect and Wait helpers, default 5s
+	Timeout time.Duration
+
+	xtest bool
+}
+
+// New starts Xvfb and a Wm with config on it. both are closed at test cleanup
+func New(t testing.TB, config *wmutil.Config) *Env {
+	t.Helper()
+	x := StartXvfb(t)
+	c := new(wmutil.Config)
+	if config != nil {
+		*c = *config
+	}
+	c.Display = x.Display
+	if c.Logger == nil {
+		var w io.Writer = io.Discard
+		if testing.Verbose() {
+			w = os.Stderr
+		}
+		c.Logger = slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
+			Level: slog.LevelDebug,
+		}))
+	}
+	wm, err := wmutil.New(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(wm.Close)
+	conn, err := xgb.NewConnDisplay(x.Display)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(conn.Close)
+	return &Env{
+		T:       t,
+		X:       x,
+		Wm:      wm,
+		Conn:    conn,
+		Root:    xproto.Setup(conn).DefaultScreen(conn).Root,
+		Timeout: time.Second * 5,
+	}
+}
+
+type WindowOptions struct {
+	// default 100x100
+	X, Y, Width, Height int
+	Border              int
+	Class, Instance     string
+	Name                string
+	Role                string
+	// like DIALOG, without the _NET_WM_WINDOW_TYPE_ prefix
+	Type         string
+	TransientFor xproto.Window
+	// like WM_DELETE_WINDOW
+	Protocols        []string
+	NormalHints      *wmutil.NormalHints
+	OverrideRedirect bool
+}
+
+// CreateWindow creates an unmapped window with properties
+func (e *Env) CreateWindow(opts WindowOptions) xproto.Window {
+	e.T.Helper()
+	if opts.Width == 0 {
+		opts.Width = 100
+	}
+	if opts.Height == 0 {
+		opts.Height = 100
+	}
+	// atoms are interned first, so that the window and its properties are sent in one burst before the wm reads them
+	var props []property
+	if opts.Class != "" || opts.Instance != "" {
+		props = append(props, property{xproto.AtomWmClass, xproto.AtomString, 8,
+			[]byte(opts.Instance + "\x00" + opts.Class + "\x00")})
+	}
+	if opts.Name != "" {
+		props = append(props, property{xproto.AtomWmName, xproto.AtomString, 8, []byte(opts.Name)})
+	}
+	if opts.Role != "" {
+		props = append(props, property{e.Atom("WM_WINDOW_ROLE"), xproto.AtomString, 8, []byte(opts.Role)})
+	}
+	if opts.Type != "" {
+		props = append(props, property{e.Atom("_NET_WM_WINDOW_TYPE"), xproto.AtomAtom, 32,
+			uint32sBytes(uint32(e.Atom("_NET_WM_WINDOW_TYPE_" + opts.Type)))})
+	}
+	if opts.TransientFor != 0 {
+		props = append(props, property{xproto.AtomWmTransientFor, xproto.AtomWindow, 32,
+			uint32sBytes(uint32(opts.TransientFor))})
+	}
+	if len(opts.Protocols) > 0 {
+		var atoms []uint32
+		for _, name := range opts.Protocols {
+			atoms = append(atoms, uint32(e.Atom(name)))
+		}
+		props = append(props, property{e.Atom("WM_PROTOCOLS"), xproto.AtomAtom, 32, uint32sBytes(atoms...)})
+	}
+	if h := opts.NormalHints; h != nil {
+		props = append(props, property{xproto.AtomWmNormalHints, xproto.AtomWmSizeHints, 32, uint32sBytes(
+			h.Flags, 0, 0, 0, 0,
+			uint32(h.MinWidth), uint32(h.MinHeight),
+			uint32(h.MaxWidth), uint32(h.MaxHeight),
+			uint32(h.WidthInc), uint32(h.HeightInc),
+			uint32(h.MinAspectNum), uint32(h.MinAspectDen),
+			uint32(h.MaxAspectNum), uint32(h.MaxAspectDen),
+			uint32(h.BaseWidth), uint32(h.BaseHeight),
+			uint32(h.WinGravity),
+		)})
+	}
+
+	return e.createWindow(opts.X, opts.Y, opts.Width, opts.Height, opts.Border, opts.OverrideRedirect, props)
+}
+
+type property struct {
+	atom, typ xproto.Atom
+	format    byte
+	data      []byte
+}
+
+func (e *Env) createWindow(x, y, width, height, border int, overrideRedirect bool, props []property) xproto.Window {
+	e.T.Helper()
+	id, err := xproto.NewWindowId(e.Conn)
+	if err != nil {
+		e.T.Fatal(err)
+	}
+	var mask uint32
+	var values []uint32
+	if overrideRedirect {
+		mask |= xproto.CwOverrideRedirect
+		values = append(values, 1)
+	}
+	xproto.CreateWindow(e.Conn, 0, id, e.Root,
+		int16(x), int16(y), uint16(width), uint16(height), uint16(border),
+		xproto.WindowClassInputOutput, 0, mask, values)
+	for _, p := range props {
+		e.changeProperty(id, p.atom, p.typ, p.format, p.data)
+	}
+	e.Sync()
+	return id
+}
+
+func (e *Env) changeProperty(id xproto.Window, property, typ xproto.Atom, format byte, data []byte) {
+	xproto.ChangeProperty(e.Conn, xproto.PropModeReplace, id, property, typ, format,
+		uint32(len(data)/int(format/8)), data)
+}
+
+func uint32sBytes(values ...uint32) []byte {
+	buf := make([]byte, len(values)*4)
+	for i, v := range values {
+		xgb.Put32(buf[i*4:], v)
+	}
+	return buf
+}
+
+// Atom interns the atom on the client connection
+func (e *Env) Atom(name string) xproto.Atom {
+	e.T.Helper()
+	reply, err := xproto.InternAtom(e.Conn, false, uint16(len(name)), name).Reply()
+	if err != nil {
+		e.T.Fatal(err)
+	}
+	return reply.Atom
+}
+
+func (e *Env) check(err error) {
+	e.T.Helper()
+	if err != nil {
+		e.T.Fatal(err)
+	}
+}
+
+func (e *Env) MapWindow(id xproto.Window) {
+	e.T.Helper()
+	e.check(xproto.MapWindowChecked(e.Conn, id).Check())
+}
+
+func (e *Env) UnmapWindow(id xproto.Window) {
+	e.T.Helper()
+	e.check(xproto.UnmapWindowChecked(e.Conn, id).Check())
+}
+
+func (e *Env) DestroyWindow(id xproto.Window) {
+	e.T.Helper()
+	e.check(xproto.DestroyWindowChecked(e.Conn, id).Check())
+}
+
+// SetName changes _NET_WM_NAME
+func (e *Env) SetName(id xproto.Window, name string) {
+	e.T.Helper()
+	e.changeProperty(id, e.Atom("_NET_WM_NAME"), e.Atom("UTF8_STRING"), 8, []byte(name))
+	e.Sync()
+}
+
+// Sync waits for the server to process all requests sent by the client connection
+func (e *Env) Sync() {
+	e.T.Helper()
+	_, err := xproto.GetInputFocus(e.Conn).Reply()
+	e.check(err)
+}
+
+// Geometry queries the geometry of the window from the server
+func (e *Env) Geometry(id xproto.Window) wmutil.Rect {
+	e.T.Helper()
+	reply, err := xproto.GetGeometry(e.Conn, xproto.Drawable(id)).Reply()
+	e.check(err)
+	return wmutil.Rect{
+		X:      int(reply.X),
+		Y:      int(reply.Y),
+		Width:  int(reply.Width),
+		Height: int(reply.Height),
+	}
+}
+
+// WaitGeometry polls the window geometry until it equals want
+func (e *Env) WaitGeometry(id xproto.Window, want wmutil.Rect) {
+	e.T.Helper()
+	deadline := time.Now().Add(e.Timeout)
+	for {
+		got := e.Geometry(id)
+		if got == want {
+			return
+		}
+		if time.Now().After(deadline) {
+			e.T.Fatalf("window 0x%x geometry %+v, want %+v", id, got, want)
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
+
+// Receive returns the next value of ch, fails the test after e.Timeout
+func Receive[T any](e *Env, ch <-chan T) T {
+	e.T.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(e.Timeout):
+		var zero T
+		e.T.Fatalf("no %T received in %v", zero, e.Timeout)
+		return zero
+	}
+}
+
+// ExpectNone fails the test if ch receives in d
+func ExpectNone[T any](e *Env, ch <-chan T, d time.Duration) {
+	e.T.Helper()
+	select {
+	case v := <-ch:
+		e.T.Fatalf("unexpected %+v", v)
+	case <-time.After(d):
+	}
+}
+
+// ExpectMap receives from Wm.Map and checks the window id
+func (e *Env) ExpectMap(id xproto.Window) *wmutil.Window {
+	e.T.Helper()
+	win := Receive(e, e.Wm.Map)
+	if win.Id != id {
+		e.T.Fatalf("window 0x%x mapped, want 0x%x", win.Id, id)
+	}
+	return win
+}
+
+// ExpectUnmap receives from Wm.Unmap and checks the window id
+func (e *Env) ExpectUnmap(id xproto.Window) *wmutil.Window {
+	e.T.Helper()
+	win := Receive(e, e.Wm.Unmap)
+	if win.Id != id {
+		e.T.Fatalf("window 0x%x unmapped, want 0x%x", win.Id, id)
+	}
+	return win
+}
@@ -0,0 +1,100 @@
+package wmtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/xgb/xproto"
+	"github.com/reusee/wmutil"
+)
+
+func TestMapAndConfigure(t *testing.T) {
+	e := New(t, &wmutil.Config{
+		Placement: func(*wmutil.Window) wmutil.Placement {
+			return wmutil.PlaceCenter
+		},
+	})
+	parent := e.CreateWindow(WindowOptions{
+		Class:    "XTerm",
+		Instance: "xterm",
+		Name:     "shell",
+		Width:    200,
+		Height:   100,
+	})
+	e.MapWindow(parent)
+	win := e.ExpectMap(parent)
+	win.ReadLock(func() {
+		if win.Class != "XTerm" || win.Instance != "xterm" || win.Name != "shell" || win.IsTransient {
+			t.Fatalf("got %+v", win)
+		}
+	})
+	// centered in 1280x800
+	e.WaitGeometry(parent, wmutil.Rect{X: 540, Y: 350, Width: 200, Height: 100})
+
+	win.SetGeometry(10, 20, 300, 400)
+	e.WaitGeometry(parent, wmutil.Rect{X: 10, Y: 20, Width: 300, Height: 400})
+
+	dialog := e.CreateWindow(WindowOptions{
+		TransientFor: parent,
+		Type:         "DIALOG",
+		Protocols:    []string{"WM_DELETE_WINDOW"},
+	})
+	e.MapWindow(dialog)
+	// fields are written by the wm loop, read them under the lock after the event
+	w := e.ExpectMap(dialog)
+	w.ReadLock(func() {
+		if !w.IsTransient || w.TransientFor != parent || len(w.Protocols) != 1 {
+			t.Fatalf("got %+v", w)
+		}
+	})
+
+	e.SetName(parent, "vim")
+	w = Receive(e, e.Wm.NameChanged)
+	w.ReadLock(func() {
+		if w.Id != parent || w.Name != "vim" {
+			t.Fatalf("got %+v", w)
+		}
+	})
+
+	e.UnmapWindow(dialog)
+	e.ExpectUnmap(dialog)
+}
+
+func TestInput(t *testing.T) {
+	strokes := []wmutil.Stroke{
+		{Modifiers: xproto.ModMask4, Sym: wmutil.Key_F1},
+		{Modifiers: xproto.ModMaskControl | xproto.ModMaskShift, Sym: wmutil.Key_Return},
+	}
+	button := wmutil.ButtonStroke{Modifiers: xproto.ModMask4, Button: 1}
+	e := New(t, &wmutil.Config{
+		Strokes: strokes,
+		Buttons: []wmutil.ButtonStroke{button},
+	})
+	if r := e.Wm.GrabReport; len(r.Grabbed) != 2 || len(r.ButtonConflicts) != 0 {
+		t.Fatalf("got %+v", r)
+	}
+	for _, stroke := range strokes {
+		e.Press(stroke)
+		if got := Receive(e, e.Wm.Stroke); got != stroke {
+			t.Fatalf("got %v, want %v", got, stroke)
+		}
+	}
+	// caps lock is not part of the stroke
+	e.KeyDown(wmutil.Key_Caps_Lock)
+	e.KeyUp(wmutil.Key_Caps_Lock)
+	e.Press(strokes[0])
+	if got := Receive(e, e.Wm.Stroke); got != strokes[0] {
+		t.Fatalf("got %v", got)
+	}
+	e.KeyDown(wmutil.Key_Caps_Lock)
+	e.KeyUp(wmutil.Key_Caps_Lock)
+	// not bound
+	e.Press(wmutil.Stroke{Sym: wmutil.Key_F1})
+	ExpectNone(e, e.Wm.Stroke, time.Millisecond*100)
+
+	e.MovePointer(30, 40)
+	e.Click(button)
+	if ev := Receive(e, e.Wm.Button); ev.ButtonStroke != button || ev.X != 30 || ev.Y != 40 {
+		t.Fatalf("got %+v", ev)
+	}
+}
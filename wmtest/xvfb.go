@@ -0,0 +1,74 @@
+package wmtest
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Xvfb is a virtual X server
+type Xvfb struct {
+	// like :5
+	Display string
+	cmd     *exec.Cmd
+}
+
+// Screen is the geometry of started servers
+var Screen = "1280x800x24"
+
+// StartXvfb starts Xvfb on a free display, the test is skipped if Xvfb is not installed. the server is killed at test cleanup
+func StartXvfb(t testing.TB) *Xvfb {
+	t.Helper()
+	path, err := exec.LookPath("Xvfb")
+	if err != nil {
+		t.Skip("Xvfb not found")
+	}
+	// the server picks a free display and writes its number to the pipe
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	cmd := exec.Command(path, "-displayfd", "3", "-screen", "0", Screen, "-nolisten", "tcp", "-noreset")
+	cmd.ExtraFiles = []*os.File{w}
+	if err := cmd.Start(); err != nil {
+		w.Close()
+		t.Fatal(err)
+	}
+	w.Close()
+	x := &Xvfb{
+		cmd: cmd,
+	}
+	t.Cleanup(x.Stop)
+
+	lines := make(chan string, 1)
+	go func() {
+		line, _ := bufio.NewReader(r).ReadString('\n')
+		lines <- strings.TrimSpace(line)
+	}()
+	select {
+	case line := <-lines:
+		if line == "" {
+			t.Fatal("Xvfb exited before ready")
+		}
+		x.Display = ":" + line
+	case <-time.After(time.Second * 10):
+		t.Fatal("Xvfb not ready in 10s")
+	}
+	return x
+}
+
+func (x *Xvfb) Stop() {
+	if x.cmd.Process != nil {
+		x.cmd.Process.Kill()
+		x.cmd.Wait()
+	}
+}
+
+func (x *Xvfb) String() string {
+	return fmt.Sprintf("Xvfb %s", x.Display)
+}